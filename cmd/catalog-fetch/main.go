@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/ocrosby/astronomy/pkg/catalog/fetch"
+	"os"
+)
+
+func main() {
+	source := flag.String("source", "hipparcos", "catalog to download: hipparcos or gaia-dr3")
+	magnitude := flag.Float64("mag", 6.5, "faintest magnitude to include")
+	cacheDir := flag.String("dir", ".", "directory for the cached binary catalog")
+	flag.Parse()
+
+	var s fetch.Source
+	switch *source {
+	case "hipparcos":
+		s = fetch.Hipparcos
+	case "gaia-dr3":
+		s = fetch.GaiaDR3
+	default:
+		fmt.Fprintf(os.Stderr, "unknown source %q\n", *source)
+		os.Exit(2)
+	}
+
+	f := fetch.NewFetcher(s, *magnitude, *cacheDir)
+	if err := f.Download(context.Background()); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	c, err := f.Open(context.Background())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer c.Close()
+	fmt.Printf("wrote %d stars to %s\n", c.Len(), f.CachePath())
+}
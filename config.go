@@ -0,0 +1,145 @@
+package astronomy
+
+import (
+	"context"
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/solar"
+	"sync"
+	"time"
+)
+
+// DeltaTFunc returns in seconds the offset of TT from the UT instants given to the library.
+// Strictly that is ΔT = TT − UT1. The default, astrotime.TTMinusUT, takes UTC for UT1 from 1972,
+// which it follows to within 0.9 s, and the Espenak–Meeus polynomials of astrotime.DeltaT
+// before; LeapSecondDeltaT's TT − UTC is a constant 42.184 s before 1972 and suits only later
+// dates.
+type DeltaTFunc func(t time.Time) float64
+
+// DeltaTSource is a ΔT model with the name and version that provenance records for it. Two
+// sources that give different values must differ in name or version, or a pinned provenance
+// cannot tell them apart.
+type DeltaTSource struct {
+	Name        string
+	Version     string
+	LeapSeconds bool // Func reads the leap-second table, whose version provenance records too
+	Func        DeltaTFunc
+}
+
+// DefaultDeltaT is the default ΔT source, astrotime.TTMinusUT
+var DefaultDeltaT = DeltaTSource{Name: "astrotime.TTMinusUT", Version: "1", LeapSeconds: true,
+	Func: astrotime.TTMinusUT}
+
+// LeapSecondDeltaT is TT − UTC from the leap-second table alone, astrotime.TTMinusUTC
+var LeapSecondDeltaT = DeltaTSource{Name: "astrotime.TTMinusUTC", Version: "1", LeapSeconds: true,
+	Func: astrotime.TTMinusUTC}
+
+// Config holds the defaults shared by formatting and computation. It is a plain value: pass it
+// explicitly, attach it to a context with WithConfig, or install it process-wide with
+// SetGlobal, which also makes its formatting, atmosphere and sunrise settings the defaults of
+// the angles, observer and solar packages.
+type Config struct {
+	Epoch         float64            // default Julian epoch of catalog positions
+	AngleFormat   angles.AngleFormat // default angle representation
+	Precision     int                // decimal places when formatting angles
+	Pressure      float64            // atmospheric pressure in millibars for refraction
+	Temperature   float64            // air temperature in degrees Celsius for refraction
+	SunriseZenith float64            // zenith angle of the Sun at sunrise and sunset in degrees
+	DeltaT        DeltaTSource       // source of TT − UT, see DeltaTFunc; a nil Func is the default
+	Model         coordinates.Model  // precession-nutation model of the computations
+
+	pinned map[string]string // fingerprints by algorithm in reproducibility mode, see Pin
+}
+
+// DefaultConfig returns the library's built-in defaults
+func DefaultConfig() Config {
+	return Config{
+		Epoch:         julian.J2000Year,
+		AngleFormat:   angles.Dd,
+		Precision:     angles.DefaultPrecision,
+		Pressure:      coordinates.StandardPressure,
+		Temperature:   coordinates.StandardTemperature,
+		SunriseZenith: solar.SunriseAngle,
+		DeltaT:        DefaultDeltaT,
+		Model:         coordinates.Classical,
+	}
+}
+
+var (
+	globalMu     sync.RWMutex
+	globalConfig = DefaultConfig()
+)
+
+// Global returns a copy of the process-wide configuration
+func Global() Config {
+	globalMu.RLock()
+	defer globalMu.RUnlock()
+	return globalConfig
+}
+
+// SetGlobal replaces the process-wide configuration and installs it in the packages that read
+// it: the angle format and precision of angles.NewAngle and angles.NewFormatter, the atmosphere
+// of observer.NewObserver and the refraction of observers without one, and the sunrise zenith
+// of the solar package. It is safe for concurrent use.
+func SetGlobal(c Config) {
+	globalMu.Lock()
+	defer globalMu.Unlock()
+	globalConfig = c
+	angles.SetDefaults(c.AngleFormat, c.Precision)
+	observer.SetDefaultAtmosphere(c.Pressure, c.Temperature)
+	solar.SetSunriseZenith(c.SunriseZenith)
+}
+
+// configKey is the context key under which a Config is stored
+type configKey struct{}
+
+// WithConfig returns a context carrying c
+func WithConfig(ctx context.Context, c Config) context.Context {
+	return context.WithValue(ctx, configKey{}, c)
+}
+
+// FromContext returns the configuration carried by ctx, or the process-wide default
+func FromContext(ctx context.Context) Config {
+	if c, ok := ctx.Value(configKey{}).(Config); ok {
+		return c
+	}
+	return Global()
+}
+
+// FormatAngle formats an angle in degrees with the configured format and precision
+func (c Config) FormatAngle(degrees float64) string {
+	return angles.NewFormatter(degrees, angles.WithFormat(c.AngleFormat), angles.WithPrecision(c.Precision)).String()
+}
+
+// EpochJD returns the Julian date of the default epoch
+func (c Config) EpochJD() float64 {
+	return julian.FromEpoch(c.Epoch)
+}
+
+// Refraction returns the refraction in degrees for a true altitude, scaled from the standard
+// atmosphere to the configured pressure and temperature (Meeus 16)
+func (c Config) Refraction(altitude float64) float64 {
+	return coordinates.RefractionAt(altitude, c.Pressure, c.Temperature)
+}
+
+// SunriseHourAngle returns the Sun's hour angle in degrees at sunrise for a latitude in degrees
+// and a declination in radians, using the configured sunrise zenith
+func (c Config) SunriseHourAngle(lat, decl float64) float64 {
+	return solar.ZenithHourAngle(lat, decl, c.SunriseZenith)
+}
+
+// TT returns the Julian date in Terrestrial Time of a UT instant using the configured ΔT
+func (c Config) TT(t time.Time) float64 {
+	return julian.FromTime(t) + c.deltaT().Func(t)/julian.SecondsPerDay
+}
+
+// deltaT returns the configured ΔT source, DefaultDeltaT when it has no function
+func (c Config) deltaT() DeltaTSource {
+	if c.DeltaT.Func == nil {
+		return DefaultDeltaT
+	}
+	return c.DeltaT
+}
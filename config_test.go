@@ -0,0 +1,112 @@
+package astronomy
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/solar"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	AfterEach(func() {
+		SetGlobal(DefaultConfig())
+	})
+
+	It("should default to the library's constants", func() {
+		c := DefaultConfig()
+		Expect(c.Precision).To(Equal(angles.DefaultPrecision))
+		Expect(c.SunriseZenith).To(Equal(solar.SunriseAngle))
+		Expect(c.EpochJD()).To(Equal(2451545.0))
+		Expect(c.FormatAngle(12.3456)).To(Equal(angles.NewFormatter(12.3456).String()))
+	})
+
+	It("should format angles with the configured format and precision", func() {
+		c := DefaultConfig()
+		c.AngleFormat = angles.DMMSSs
+		c.Precision = 1
+		Expect(c.FormatAngle(12.5)).To(Equal(angles.NewFormatter(12.5).Format(angles.DMMSSs).Precision(1).String()))
+	})
+
+	It("should scale refraction with pressure and temperature", func() {
+		c := DefaultConfig()
+		Expect(c.Refraction(10)).To(BeNumerically("~", coordinates.Refraction(10), 1e-15))
+		c.Pressure = 505
+		Expect(c.Refraction(10)).To(BeNumerically("~", coordinates.Refraction(10)/2, 1e-15))
+	})
+
+	It("should use the configured sunrise zenith", func() {
+		c := DefaultConfig()
+		Expect(c.SunriseHourAngle(40, 0)).To(Equal(solar.SunriseSunsetHourAngle(40, 0)))
+		c.SunriseZenith = 90
+		Expect(c.SunriseHourAngle(0, 0)).To(BeNumerically("~", 90, 1e-9))
+	})
+
+	It("should apply the configured Delta T source", func() {
+		c := DefaultConfig()
+		c.DeltaT = DeltaTSource{Name: "one day", Func: func(time.Time) float64 { return 86400 }}
+		t := time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)
+		Expect(c.TT(t)).To(BeNumerically("~", 2451546.0, 1e-9))
+	})
+
+	It("should default to the historical ΔT before the leap-second era", func() {
+		c := DefaultConfig()
+		t := time.Date(1800, 1, 1, 0, 0, 0, 0, time.UTC)
+		Expect((c.TT(t) - julian.FromTime(t)) * julian.SecondsPerDay).To(BeNumerically("~", astrotime.DeltaT(t), 1e-3))
+		Expect(astrotime.DeltaT(t)).To(BeNumerically("~", 13.7, 0.5))
+	})
+
+	It("should install the process-wide configuration in the packages that read it", func() {
+		c := DefaultConfig()
+		c.AngleFormat = angles.DMMSSs
+		c.Precision = 1
+		c.Pressure, c.Temperature = 700, -5
+		c.SunriseZenith = 96 // civil twilight
+		SetGlobal(c)
+
+		Expect(angles.NewFormatter(12.5).String()).To(Equal(c.FormatAngle(12.5)))
+		Expect(angles.NewAngle(12.5).AngleFormat()).To(Equal(angles.DMMSSs))
+		obs := observer.NewObserver(40, -105)
+		Expect(obs.Pressure).To(Equal(700.0))
+		Expect(obs.Temperature).To(Equal(-5.0))
+		pressure, _ := observer.Observer{}.Conditions()
+		Expect(pressure).To(Equal(700.0))
+		Expect(solar.SunriseSunsetHourAngle(40, 0)).To(Equal(c.SunriseHourAngle(40, 0)))
+		Expect(solar.SunriseAltitude(obs)).To(BeNumerically("<", -5.5))
+
+		SetGlobal(DefaultConfig())
+		Expect(angles.NewFormatter(12.5).String()).To(Equal("12.50"))
+		Expect(observer.NewObserver(40, -105).Pressure).To(Equal(coordinates.StandardPressure))
+		Expect(solar.SunriseZenith()).To(Equal(solar.SunriseAngle))
+	})
+
+	It("should carry a configuration in a context", func() {
+		c := DefaultConfig()
+		c.Precision = 5
+		Expect(FromContext(WithConfig(context.Background(), c)).Precision).To(Equal(5))
+		Expect(FromContext(context.Background()).Precision).To(Equal(angles.DefaultPrecision))
+	})
+
+	It("should replace the process-wide default safely", func() {
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(p int) {
+				defer wg.Done()
+				c := Global()
+				c.Precision = p
+				SetGlobal(c)
+				_ = Global().Precision
+			}(i)
+		}
+		wg.Wait()
+		Expect(Global().Precision).To(BeNumerically("<", 8))
+	})
+})
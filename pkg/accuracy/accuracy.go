@@ -0,0 +1,57 @@
+// Package accuracy describes the expected error of the library's algorithms, so applications can
+// choose between them at run time and show users how far to trust a result.
+package accuracy
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Accuracy is the expected maximum error of an algorithm over the years it is meant for
+type Accuracy struct {
+	Name      string  // algorithm name
+	Angle     float64 // expected maximum error of computed directions in arcseconds
+	FirstYear int     // first year for which the figure holds
+	LastYear  int     // last year for which the figure holds
+	Source    string  // the theory or reference the figure comes from
+}
+
+// Covers reports whether the figure holds for a year
+func (a Accuracy) Covers(year int) bool {
+	return year >= a.FirstYear && year <= a.LastYear
+}
+
+// Within reports whether the algorithm is expected to be good to the given arcseconds in a year
+func (a Accuracy) Within(arcseconds float64, year int) bool {
+	return a.Covers(year) && a.Angle <= arcseconds
+}
+
+// String returns a short description such as `Meeus: 36" (1800-2200)`
+func (a Accuracy) String() string {
+	return fmt.Sprintf("%s: %s (%d-%d)", a.Name, Format(a.Angle), a.FirstYear, a.LastYear)
+}
+
+// Format returns an angular error in arcseconds in the most readable unit: milliarcseconds below
+// one arcsecond, arcminutes from one arcminute and degrees from one degree
+func Format(arcseconds float64) string {
+	switch {
+	case arcseconds < 1:
+		return strconv.FormatFloat(arcseconds*1000, 'g', 3, 64) + " mas"
+	case arcseconds < 60:
+		return strconv.FormatFloat(arcseconds, 'g', 3, 64) + "\""
+	case arcseconds < 3600:
+		return strconv.FormatFloat(arcseconds/60, 'g', 3, 64) + "'"
+	}
+	return strconv.FormatFloat(arcseconds/3600, 'g', 3, 64) + "°"
+}
+
+// Select returns the index of the first candidate good to the given arcseconds in a year, so
+// callers list algorithms in order of preference (cheapest first, say), or -1 when none is
+func Select(arcseconds float64, year int, candidates ...Accuracy) int {
+	for i, c := range candidates {
+		if c.Within(arcseconds, year) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,13 @@
+package accuracy_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAccuracy(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Accuracy Suite")
+}
@@ -0,0 +1,40 @@
+package accuracy
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Accuracy", func() {
+	coarse := Accuracy{Name: "coarse", Angle: 1800, FirstYear: 1901, LastYear: 2099}
+	fine := Accuracy{Name: "fine", Angle: 36, FirstYear: 1800, LastYear: 2200}
+
+	It("should test coverage and requirements", func() {
+		Expect(coarse.Covers(2099)).To(BeTrue())
+		Expect(coarse.Covers(2100)).To(BeFalse())
+		Expect(fine.Within(60, 2024)).To(BeTrue())
+		Expect(fine.Within(10, 2024)).To(BeFalse())
+	})
+
+	It("should select the first adequate candidate", func() {
+		Expect(Select(3600, 2024, coarse, fine)).To(Equal(0))
+		Expect(Select(60, 2024, coarse, fine)).To(Equal(1))
+		Expect(Select(3600, 1850, coarse, fine)).To(Equal(1))
+		Expect(Select(1, 2024, coarse, fine)).To(Equal(-1))
+	})
+
+	DescribeTable("Format picks a readable unit",
+		func(arcseconds float64, expected string) {
+			Expect(Format(arcseconds)).To(Equal(expected))
+		},
+		Entry("milliarcseconds", 0.001, "1 mas"),
+		Entry("arcseconds", 36.0, "36\""),
+		Entry("arcminutes", 600.0, "10'"),
+		Entry("half a degree", 1800.0, "30'"),
+		Entry("degrees", 7200.0, "2°"),
+	)
+
+	It("should describe itself", func() {
+		Expect(fine.String()).To(Equal(`fine: 36" (1800-2200)`))
+	})
+})
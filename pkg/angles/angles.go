@@ -1,11 +1,15 @@
 package angles
 
 import (
+	"bytes"
 	"fmt"
 	"github.com/ocrosby/astronomy/pkg/constants"
+	"io"
 	"math"
 	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 // SOLID Principle Interfaces
@@ -26,6 +30,12 @@ type FluentAngleFormatter interface {
 	Precision(precision int) FluentAngleFormatter
 	// Width sets the minimum field width
 	Width(width int) FluentAngleFormatter
+	// Sign sets whether positive values carry a leading '+'
+	Sign(always bool) FluentAngleFormatter
+	// ZeroPad sets whether whole units, minutes and seconds are padded to two digits
+	ZeroPad(pad bool) FluentAngleFormatter
+	// Symbols sets whether the units are marked with symbols rather than spaced
+	Symbols(symbols bool) FluentAngleFormatter
 	// String returns the formatted representation
 	String() string
 }
@@ -75,14 +85,16 @@ type ExtensibleAngleFormatter struct {
 
 // Angle parsing and formatting constants
 const (
-	MaxMinutes       = 60
-	MaxSeconds       = 60.0
-	DefaultPrecision = 2
-	DefaultWidth     = 0
-	SecondsPerMinute = 60.0
-	MinutesPerDegree = 60.0
-	SecondsPerDegree = 3600.0
-	ValidParseChars  = "0123456789.-+ \tabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	MaxMinutes               = 60
+	MaxSeconds               = 60.0
+	DefaultPrecision         = 2
+	DefaultWidth             = 0
+	SecondsPerMinute         = 60.0
+	MinutesPerDegree         = 60.0
+	SecondsPerDegree         = 3600.0
+	DegreesPerHour           = 15.0
+	MilliarcsecondsPerDegree = 3600e3
+	ValidParseChars          = "0123456789.-+ \tabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 )
 
 // AngleFormat represents different angle representation formats
@@ -94,11 +106,50 @@ const (
 	DMMm                      // degrees and minutes of arc in decimal representation
 	DMMSS                     // degrees, minutes of arc and whole seconds of arc
 	DMMSSs                    // degrees, minutes, and seconds of arc in decimal representation
+	Hh                        // decimal hours
+	HMM                       // hours and whole minutes of time
+	HMMSS                     // hours, minutes and whole seconds of time
+	HMMSSs                    // hours, minutes and seconds of time in decimal representation
+	Arcsec                    // seconds of arc in decimal representation
+	Mas                       // milliarcseconds in decimal representation
 )
 
+// angleFormats describes the formats in declaration order: the name String gives and the
+// decimals of the last component in symbol notation when no precision is asked for
+var angleFormats = [...]struct {
+	name            string
+	symbolPrecision int
+}{
+	{"Dd", 5}, {"DMM", 0}, {"DMMm", 3}, {"DMMSS", 0}, {"DMMSSs", 3}, {"Hh", 5},
+	{"HMM", 0}, {"HMMSS", 0}, {"HMMSSs", 3}, {"Arcsec", 4}, {"Mas", 3},
+}
+
 // String returns the string representation of AngleFormat
 func (af AngleFormat) String() string {
-	return [...]string{"Dd", "DMM", "DMMm", "DMMSS", "DMMSSs"}[af]
+	return angleFormats[af].name
+}
+
+// ParseAngleFormat returns the format named as String gives it, in any letter case, so that
+// formats can come from flags and configuration files: "dmmss" gives DMMSS
+func ParseAngleFormat(name string) (AngleFormat, error) {
+	trimmed := strings.TrimSpace(name)
+	for i, candidate := range angleFormats {
+		if strings.EqualFold(trimmed, candidate.name) {
+			return AngleFormat(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown angle format '%s'", name)
+}
+
+// isHours reports whether the format expresses the angle in hours of 15°
+func (af AngleFormat) isHours() bool {
+	return af >= Hh && af <= HMMSSs
+}
+
+// symbolPrecision returns the number of decimals the last component gets in symbol notation
+// when no precision is asked for
+func (af AngleFormat) symbolPrecision() int {
+	return angleFormats[af].symbolPrecision
 }
 
 // Angle represents a sexagesimal angle output
@@ -107,25 +158,52 @@ type Angle struct {
 	format AngleFormat
 }
 
-// NewAngle creates a new Angle instance with default format Dd
-func NewAngle(alpha float64, format ...AngleFormat) *Angle {
-	f := Dd // default format
-	if len(format) > 0 {
-		f = format[0]
-	}
-	return &Angle{
-		alpha:  alpha,
-		format: f,
+// AngleOption configures an Angle
+type AngleOption func(*Angle)
+
+// WithAngleFormat sets the format the angle is written in (default from Defaults)
+func WithAngleFormat(format AngleFormat) AngleOption {
+	return func(a *Angle) { a.format = format }
+}
+
+// NewAngle creates a new Angle instance in the default format, Dd unless SetDefaults changed it
+func NewAngle(alpha float64, options ...AngleOption) *Angle {
+	format, _ := Defaults()
+	a := &Angle{alpha: alpha, format: format}
+	for _, apply := range options {
+		apply(a)
 	}
+	return a
+}
+
+// NewAngleFromDMS creates an Angle from degrees, minutes and seconds of arc, with the sign taken
+// from the first nonzero component as in Ddd
+func NewAngleFromDMS(degrees, minutes int, seconds float64, options ...AngleOption) *Angle {
+	return NewAngle(Ddd(degrees, minutes, seconds), options...)
+}
+
+// NewAngleFromArcseconds creates an Angle from seconds of arc
+func NewAngleFromArcseconds(arcseconds float64, options ...AngleOption) *Angle {
+	return NewAngle(arcseconds/SecondsPerDegree, options...)
 }
 
-// Set sets the angle format, defaulting to Dd
-func (a *Angle) Set(format ...AngleFormat) {
-	f := Dd // default format
-	if len(format) > 0 {
-		f = format[0]
+// NewAngleFromMilliarcseconds creates an Angle from milliarcseconds, the unit of parallaxes and
+// proper motions in modern catalogs
+func NewAngleFromMilliarcseconds(milliarcseconds float64, options ...AngleOption) *Angle {
+	return NewAngle(milliarcseconds/MilliarcsecondsPerDegree, options...)
+}
+
+// NewAngleFromHours creates an Angle from hours of right ascension or hour angle
+func NewAngleFromHours(hours float64, options ...AngleOption) *Angle {
+	return NewAngle(hours*DegreesPerHour, options...)
+}
+
+// Set resets the angle to the default format and applies the options
+func (a *Angle) Set(options ...AngleOption) {
+	a.format, _ = Defaults()
+	for _, apply := range options {
+		apply(a)
 	}
-	a.format = f
 }
 
 // Alpha returns the angle value in decimal degrees
@@ -143,26 +221,61 @@ func (a *Angle) Radians() float64 {
 	return DegreesToRadians(a.alpha)
 }
 
-// Format returns the current angle format
-func (a *Angle) Format() AngleFormat {
+// Hours returns the angle value in hours (15° per hour)
+func (a *Angle) Hours() float64 {
+	return a.alpha / DegreesPerHour
+}
+
+// Arcminutes returns the angle value in minutes of arc
+func (a *Angle) Arcminutes() float64 {
+	return a.alpha * MinutesPerDegree
+}
+
+// Arcseconds returns the angle value in seconds of arc
+func (a *Angle) Arcseconds() float64 {
+	return a.alpha * SecondsPerDegree
+}
+
+// Milliarcseconds returns the angle value in milliarcseconds
+func (a *Angle) Milliarcseconds() float64 {
+	return a.alpha * MilliarcsecondsPerDegree
+}
+
+// AngleFormat returns the current angle format. It was named Format before Angle implemented
+// fmt.Formatter.
+func (a *Angle) AngleFormat() AngleFormat {
 	return a.format
 }
 
 // String creates a string representation from an Angle reference
 func (a *Angle) String() string {
-	return formatAngle(a.alpha, a.format, 3, 0, true)
+	return formatAngle(a.alpha, a.format, -1, 0, true)
+}
+
+// AppendFormat appends the same text as String to dst without allocating when dst has room
+func (a *Angle) AppendFormat(dst []byte) []byte {
+	return appendAngle(dst, a.alpha, a.format, -1, 0, true)
+}
+
+// AppendFormat appends an angle in degrees to dst in the given format and precision, as the
+// fluent formatter would print it, without allocating when dst has room
+func AppendFormat(dst []byte, degrees float64, format AngleFormat, precision int) []byte {
+	return appendAngle(dst, degrees, format, precision, 0, false)
 }
 
 // DisplayOptions holds formatting display options
 type DisplayOptions struct {
 	Precision int
 	Width     int
+	Sign      bool // writes '+' before values that are not negative
+	ZeroPad   bool // pads whole units, minutes and seconds to two digits, as 05°03'07"
 }
 
-// NewDisplayOptions creates default display options
+// NewDisplayOptions creates default display options, with the precision from Defaults
 func NewDisplayOptions() *DisplayOptions {
+	_, precision := Defaults()
 	return &DisplayOptions{
-		Precision: DefaultPrecision,
+		Precision: precision,
 		Width:     DefaultWidth,
 	}
 }
@@ -173,15 +286,62 @@ type ConcreteAngleFormatter struct {
 	value   AngleValue
 	format  AngleFormat
 	display *DisplayOptions
+	compass CompassPoints // appends the nearest compass point when nonzero
+	symbols bool          // marks the units as 12°20'44" rather than spacing them as 12 20 44
+}
+
+// FormatterOption configures a ConcreteAngleFormatter at construction
+type FormatterOption func(*ConcreteAngleFormatter)
+
+// WithFormat selects the angle format
+func WithFormat(format AngleFormat) FormatterOption {
+	return func(f *ConcreteAngleFormatter) { f.format = format }
+}
+
+// WithPrecision sets the decimal precision
+func WithPrecision(precision int) FormatterOption {
+	return func(f *ConcreteAngleFormatter) { f.display.Precision = precision }
+}
+
+// WithWidth sets the field width
+func WithWidth(width int) FormatterOption {
+	return func(f *ConcreteAngleFormatter) { f.display.Width = width }
+}
+
+// WithSign writes a leading '+' on values that are not negative, as catalogs write declinations
+func WithSign() FormatterOption {
+	return func(f *ConcreteAngleFormatter) { f.display.Sign = true }
+}
+
+// WithZeroPad pads whole units, minutes and seconds to two digits for fixed-width columns
+func WithZeroPad() FormatterOption {
+	return func(f *ConcreteAngleFormatter) { f.display.ZeroPad = true }
+}
+
+// WithSymbols marks the units with symbols, as 12°20'44" and 12h04m05s, in place of spaces
+func WithSymbols() FormatterOption {
+	return func(f *ConcreteAngleFormatter) { f.symbols = true }
+}
+
+// WithCompassPoint appends the name of the nearest point of a compass rose after the angle, as
+// in "247.50 WSW", for displaying azimuths
+func WithCompassPoint(points CompassPoints) FormatterOption {
+	return func(f *ConcreteAngleFormatter) { f.compass = points }
 }
 
-// NewFormatter creates a new ConcreteAngleFormatter with the given angle value
-func NewFormatter(alpha float64) *ConcreteAngleFormatter {
-	return &ConcreteAngleFormatter{
-		value:   NewAngle(alpha, Dd),
-		format:  Dd,
+// NewFormatter creates a new ConcreteAngleFormatter with the given angle value, in the format and
+// precision of Defaults unless options say otherwise
+func NewFormatter(alpha float64, options ...FormatterOption) *ConcreteAngleFormatter {
+	format, _ := Defaults()
+	f := &ConcreteAngleFormatter{
+		value:   NewAngle(alpha),
+		format:  format,
 		display: NewDisplayOptions(),
 	}
+	for _, option := range options {
+		option(f)
+	}
+	return f
 }
 
 // Format sets the angle format and returns the formatter for chaining
@@ -202,9 +362,55 @@ func (f *ConcreteAngleFormatter) Width(width int) FluentAngleFormatter {
 	return f
 }
 
+// Sign sets whether values that are not negative are written with a leading '+' and returns the
+// formatter for chaining
+func (f *ConcreteAngleFormatter) Sign(always bool) FluentAngleFormatter {
+	f.display.Sign = always
+	return f
+}
+
+// ZeroPad sets whether whole units, minutes and seconds are padded to two digits and returns the
+// formatter for chaining. Together with Sign it gives the fixed-width +05 03 07 of catalogs.
+func (f *ConcreteAngleFormatter) ZeroPad(pad bool) FluentAngleFormatter {
+	f.display.ZeroPad = pad
+	return f
+}
+
+// Symbols sets whether the units are marked with symbols, as 12°20'44", rather than spaced, as
+// 12 20 44, and returns the formatter for chaining. Together with Sign and ZeroPad it gives
+// the +05°03'07" of catalogs.
+func (f *ConcreteAngleFormatter) Symbols(symbols bool) FluentAngleFormatter {
+	f.symbols = symbols
+	return f
+}
+
 // String formats the angle according to the configured settings
 func (f *ConcreteAngleFormatter) String() string {
-	return formatAngle(f.value.Degrees(), f.format, f.display.Precision, f.display.Width, false)
+	var buf [64]byte
+	return string(f.AppendFormat(buf[:0]))
+}
+
+// AppendFormat appends the same text as String to dst without allocating when dst has room
+func (f *ConcreteAngleFormatter) AppendFormat(dst []byte) []byte {
+	start := len(dst)
+	display := *f.display
+	display.Width = 0 // after the compass point
+	dst = appendDisplayedAngle(dst, f.value.Degrees(), f.format, f.symbols, display)
+	if f.compass != 0 {
+		dst = append(dst, ' ')
+		dst = AppendCompassPoint(dst, f.value.Degrees(), f.compass)
+	}
+	for n := utf8.RuneCount(dst[start:]); n < f.display.Width; n++ {
+		dst = append(dst, ' ')
+	}
+	return dst
+}
+
+// WriteTo writes the same text as String to w, implementing io.WriterTo
+func (f *ConcreteAngleFormatter) WriteTo(w io.Writer) (int64, error) {
+	var buf [64]byte
+	n, err := w.Write(f.AppendFormat(buf[:0]))
+	return int64(n), err
 }
 
 // DegreesToRadians converts degrees to radians
@@ -219,7 +425,21 @@ func RadiansToDegrees(radians float64) float64 {
 
 // NormalizeDegrees normalizes degrees to the range [0, 360)
 func NormalizeDegrees(degrees float64) float64 {
-	return degrees - 360.0*math.Floor(degrees/360.0)
+	normalized := degrees - 360.0*math.Floor(degrees/360.0)
+	if normalized >= 360.0 {
+		// a tiny negative input rounds up to a full turn
+		return 0
+	}
+	return normalized
+}
+
+// WrapSigned normalizes degrees to the range (-180, 180]
+func WrapSigned(degrees float64) float64 {
+	normalized := NormalizeDegrees(degrees)
+	if normalized > 180.0 {
+		normalized -= 360.0
+	}
+	return normalized
 }
 
 // Ddd converts degrees, minutes, seconds to decimal degrees
@@ -235,31 +455,48 @@ func Ddd(degrees, minutes int, seconds float64) float64 {
 	return result
 }
 
-// DMS converts decimal degrees to degrees, minutes, seconds using pointers
-func DMS(decimalDegrees float64, degrees *int, minutes *int, seconds *float64) {
+// SplitDMS splits decimal degrees into whole degrees, whole minutes and seconds. The sign goes
+// on the first non-zero component, so -0.08334 gives 0, -5, 0.024.
+func SplitDMS(decimalDegrees float64) (degrees, minutes int, seconds float64) {
 	negative := decimalDegrees < 0
 	if negative {
 		decimalDegrees = -decimalDegrees
 	}
 
-	*degrees = int(decimalDegrees)
-	remainder := (decimalDegrees - float64(*degrees)) * MinutesPerDegree
-	*minutes = int(remainder)
-	*seconds = (remainder - float64(*minutes)) * SecondsPerMinute
+	degrees = int(decimalDegrees)
+	remainder := (decimalDegrees - float64(degrees)) * MinutesPerDegree
+	minutes = int(remainder)
+	seconds = (remainder - float64(minutes)) * SecondsPerMinute
 
 	if negative {
-		if *degrees != 0 {
-			*degrees = -*degrees
-		} else if *minutes != 0 {
-			*minutes = -*minutes
+		if degrees != 0 {
+			degrees = -degrees
+		} else if minutes != 0 {
+			minutes = -minutes
 		} else {
-			*seconds = -*seconds
+			seconds = -seconds
 		}
 	}
+	return degrees, minutes, seconds
+}
+
+// DMS converts decimal degrees to degrees, minutes, seconds using pointers
+//
+// Deprecated: use SplitDMS, which returns the components.
+func DMS(decimalDegrees float64, degrees *int, minutes *int, seconds *float64) {
+	*degrees, *minutes, *seconds = SplitDMS(decimalDegrees)
 }
 
-// ParseAngle parses a string in fluent output format and returns an Angle
-func ParseAngle(input string) (*Angle, error) {
+// ParseAngle parses a string in fluent output format and returns an Angle. It also accepts
+// catalog notation: degree, arcminute and arcsecond marks ("12°34'56.7\""), colon separators
+// ("12:34:56", read as degrees) and a hemisphere suffix giving the sign ("73°59'W"). The
+// options WithPacked and WithPackedHours read the digits of compact catalog notation instead.
+func ParseAngle(input string, opts ...ParseOption) (*Angle, error) {
+	var o parseOptions
+	for _, apply := range opts {
+		apply(&o)
+	}
+
 	// Validate input
 	if input == "" {
 		return nil, fmt.Errorf("empty input string")
@@ -272,6 +509,92 @@ func ParseAngle(input string) (*Angle, error) {
 		return nil, fmt.Errorf("input contains only whitespace")
 	}
 
+	if o.packed {
+		return parsePacked(input, o.hours, originalInput)
+	}
+
+	// Small angles carry their unit, as in "768.07 mas" or "0.7681\""
+	if angle, ok, err := parseSmallUnits(input, originalInput); ok {
+		return angle, err
+	}
+
+	// Catalog notation: symbols or colons between components and a hemisphere letter for the
+	// sign, as in "45°30'N" or "12:34:56". Hour formats keep their letters for parseHMSFormat.
+	negate := false
+	if !strings.ContainsAny(input, "hH") {
+		var err error
+		if input, negate, err = trimHemisphere(input, originalInput); err != nil {
+			return nil, err
+		}
+		input = sexagesimalSymbols.Replace(input)
+	}
+
+	angle, err := parseComponents(input, originalInput)
+	if err != nil {
+		return nil, err
+	}
+	if negate {
+		angle.alpha = -angle.alpha
+	}
+	return angle, nil
+}
+
+// parseSmallUnits parses a single number of milliarcseconds ending in "mas" or of arcseconds
+// ending in a second mark, reporting whether the input had one of those forms
+func parseSmallUnits(input, originalInput string) (*Angle, bool, error) {
+	var number string
+	var format AngleFormat
+	switch {
+	case strings.HasSuffix(input, "mas"):
+		number, format = strings.TrimSpace(strings.TrimSuffix(input, "mas")), Mas
+	case strings.HasSuffix(input, "\"") || strings.HasSuffix(input, "″"):
+		number = strings.TrimSpace(strings.TrimSuffix(strings.TrimSuffix(input, "\""), "″"))
+		if strings.ContainsAny(number, "°º'′\"″: \t") {
+			return nil, false, nil // the seconds of a sexagesimal angle
+		}
+		format = Arcsec
+	default:
+		return nil, false, nil
+	}
+	value, err := parseFloatComponent(number, format.String(), originalInput)
+	if err != nil {
+		return nil, true, err
+	}
+	if format == Mas {
+		return NewAngleFromMilliarcseconds(value, WithAngleFormat(Mas)), true, nil
+	}
+	return NewAngleFromArcseconds(value, WithAngleFormat(Arcsec)), true, nil
+}
+
+// sexagesimalSymbols turns the degree, minute and second marks and colon separators into spaces
+var sexagesimalSymbols = strings.NewReplacer(
+	"°", " ", "º", " ", "'", " ", "′", " ", "\"", " ", "″", " ", ":", " ",
+)
+
+// trimHemisphere removes a trailing N, S, E or W, reporting whether it makes the angle negative
+// (south or west). A hemisphere letter together with a sign is rejected as ambiguous.
+func trimHemisphere(input, originalInput string) (string, bool, error) {
+	last, size := utf8.DecodeLastRuneInString(input)
+	if !strings.ContainsRune("NSEWnsew", last) {
+		return input, false, nil
+	}
+	rest := strings.TrimSpace(input[:len(input)-size])
+	if rest == "" {
+		return input, false, nil
+	}
+	// the letter must follow a number or a mark, so words such as "NaN" are left alone
+	if previous, _ := utf8.DecodeLastRuneInString(input[:len(input)-size]); unicode.IsLetter(previous) {
+		return input, false, nil
+	}
+	if strings.ContainsAny(rest, "+-") {
+		return "", false, fmt.Errorf("invalid angle: both a sign and a hemisphere in '%s'", originalInput)
+	}
+	negative := last == 'S' || last == 's' || last == 'W' || last == 'w'
+	return rest, negative, nil
+}
+
+// parseComponents parses space-separated components in the fluent output formats
+func parseComponents(input, originalInput string) (*Angle, error) {
 	// Check for invalid characters that would indicate a malformed angle
 	// Allow letters for special values like "inf", "nan", etc.
 	for _, char := range input {
@@ -293,18 +616,19 @@ func ParseAngle(input string) (*Angle, error) {
 		}
 	}
 
+	// Hour formats carry unit letters, as in "12h 34m 56.7s" or "12h34m56.7s"
+	if strings.ContainsAny(input, "hH") {
+		return parseHMSFormat(input, originalInput)
+	}
+
 	switch len(parts) {
 	case 1:
 		// Dd format - single decimal number
 		return parseDdFormat(parts[0], originalInput)
 
-	case 2:
-		// DMM or DMMm format - degrees and minutes
-		return parseDMMFormat(parts[0], parts[1], originalInput)
-
-	case 3:
-		// DMMSS or DMMSSs format - degrees, minutes, and seconds
-		return parseDMMSSFormat(parts[0], parts[1], parts[2], originalInput)
+	case 2, 3:
+		// DMM or DMMm format - degrees and minutes; DMMSS or DMMSSs with seconds
+		return parseDMSFormat(parts, originalInput)
 
 	default:
 		return nil, fmt.Errorf("invalid format: expected 1-3 space-separated components, got %d in input '%s'", len(parts), originalInput)
@@ -318,107 +642,59 @@ func parseDdFormat(degreeStr, originalInput string) (*Angle, error) {
 		return nil, err
 	}
 
-	return NewAngle(value, Dd), nil
+	return NewAngle(value), nil
 }
 
-// parseDMMFormat handles parsing of degrees and minutes formats
-func parseDMMFormat(degreeStr, minuteStr, originalInput string) (*Angle, error) {
-	// Parse degrees
-	degrees, err := parseIntegerComponent(degreeStr, "degrees", originalInput)
+// parseDMSFormat handles parsing of degrees with minutes and optional seconds, the format
+// following from the number of components and whether the last has decimals
+func parseDMSFormat(parts []string, originalInput string) (*Angle, error) {
+	value, decimal, err := parseSexagesimalParts(parts, "degrees", originalInput)
 	if err != nil {
 		return nil, err
 	}
-
-	// Check if minutes contains decimal point
-	if strings.Contains(minuteStr, ".") {
-		// DMMm format
-		minutes, err := parseFloatComponent(minuteStr, "minutes", originalInput)
-		if err != nil {
-			return nil, err
-		}
-
-		// Validate minutes range
-		if err := validateMinutesFloat(minutes, originalInput); err != nil {
-			return nil, err
-		}
-
-		decimalDegrees := Ddd(degrees, int(minutes), (minutes-float64(int(minutes)))*SecondsPerMinute)
-		return NewAngle(decimalDegrees, DMMm), nil
-	} else {
-		// DMM format
-		minutes, err := parseIntegerComponent(minuteStr, "minutes", originalInput)
-		if err != nil {
-			return nil, err
-		}
-
-		// Validate minutes range
-		if err := validateMinutesInt(minutes, originalInput); err != nil {
-			return nil, err
-		}
-
-		decimalDegrees := Ddd(degrees, minutes, 0.0)
-		return NewAngle(decimalDegrees, DMM), nil
+	format := DMM
+	switch {
+	case len(parts) == 3 && decimal:
+		format = DMMSSs
+	case len(parts) == 3:
+		format = DMMSS
+	case decimal:
+		format = DMMm
 	}
+	return NewAngle(value.Value(), WithAngleFormat(format)), nil
 }
 
-// parseDMMSSFormat handles parsing of degrees, minutes, and seconds formats
-func parseDMMSSFormat(degreeStr, minuteStr, secondStr, originalInput string) (*Angle, error) {
-	// Parse degrees
-	degrees, err := parseIntegerComponent(degreeStr, "degrees", originalInput)
-	if err != nil {
-		return nil, err
-	}
-
-	// Parse minutes
-	minutes, err := parseIntegerComponent(minuteStr, "minutes", originalInput)
+// parseHMSFormat handles parsing of the hour formats, whose components end in h, m and s
+func parseHMSFormat(input, originalInput string) (*Angle, error) {
+	parts, err := hourParts(input, originalInput)
 	if err != nil {
 		return nil, err
 	}
 
-	// Validate minutes range
-	if err := validateMinutesInt(minutes, originalInput); err != nil {
-		return nil, err
-	}
-
-	// Handle special case where degrees is "-0" indicating small negative angle
-	isNegativeZero := strings.HasPrefix(degreeStr, "-") && degrees == 0
-
-	// Check if seconds contains decimal point
-	if strings.Contains(secondStr, ".") {
-		// DMMSSs format
-		seconds, err := parseFloatComponent(secondStr, "seconds", originalInput)
-		if err != nil {
-			return nil, err
-		}
-
-		// Validate seconds range
-		if err := validateSecondsFloat(seconds, originalInput); err != nil {
-			return nil, err
-		}
-
-		decimalDegrees := Ddd(degrees, minutes, seconds)
-		if isNegativeZero {
-			decimalDegrees = -decimalDegrees
-		}
-		return NewAngle(decimalDegrees, DMMSSs), nil
-	} else {
-		// DMMSS format
-		seconds, err := parseIntegerComponent(secondStr, "seconds", originalInput)
+	if len(parts) == 1 {
+		hours, err := parseFloatComponent(parts[0], "decimal hours", originalInput)
 		if err != nil {
 			return nil, err
 		}
+		return NewAngleFromHours(hours, WithAngleFormat(Hh)), nil
+	}
+	return parseHMSParts(parts, originalInput)
+}
 
-		// Validate seconds range
-		if err := validateSecondsInt(seconds, originalInput); err != nil {
-			return nil, err
-		}
-
-		decimalDegrees := Ddd(degrees, minutes, float64(seconds))
-		if isNegativeZero {
-			decimalDegrees = -decimalDegrees
-		}
-		return NewAngle(decimalDegrees, DMMSS), nil
+// parseHMSParts handles parsing of hours with minutes and optional seconds, the format
+// following from the number of components and whether the last has decimals
+func parseHMSParts(parts []string, originalInput string) (*Angle, error) {
+	value, decimal, err := parseSexagesimalParts(parts, "hours", originalInput)
+	if err != nil {
+		return nil, err
 	}
+	format := HMM
+	if len(parts) == 3 && decimal {
+		format = HMMSSs
+	} else if len(parts) == 3 {
+		format = HMMSS
+	}
+	return NewAngleFromHours(value.Value(), WithAngleFormat(format)), nil
 }
 
 // Common validation patterns for parsing
@@ -541,11 +817,7 @@ func NewDMSCalculator() DMSCalculator {
 
 // ConvertToDMS converts decimal degrees to degrees/minutes/seconds
 func (d *StandardDMSCalculator) ConvertToDMS(decimalDegrees float64) (int, int, float64) {
-	var degrees int
-	var minutes int
-	var seconds float64
-	DMS(decimalDegrees, &degrees, &minutes, &seconds)
-	return degrees, minutes, seconds
+	return SplitDMS(decimalDegrees)
 }
 
 // ConvertFromDMS converts degrees/minutes/seconds to decimal degrees
@@ -576,12 +848,12 @@ func NewDMMSSFormatStrategy(calc DMSCalculator) FormatStrategy {
 // Format implements FormatStrategy for DMMSS format
 func (s *DMMSSFormatStrategy) Format(value float64, precision int) string {
 	degrees, minutes, seconds := s.calculator.ConvertToDMS(value)
-	components := getDMSComponents(value)
-
-	if components.isNegativeZero {
-		return fmt.Sprintf("%d %d %.*f", degrees, minutes, precision, seconds)
+	components := Sexagesimal{Sign: 1, Degrees: absInt(degrees), Minutes: absInt(minutes), Seconds: math.Abs(seconds)}
+	if value < 0 {
+		components.Sign = -1
 	}
-	return fmt.Sprintf("%d %d %.*f", degrees, int(math.Abs(float64(minutes))), precision, math.Abs(seconds))
+	rounded := roundSexagesimal(components.Value(), DMMSSs, precision)
+	return string(rounded.appendTo(nil, DMMSSs, precision, false, false, false))
 }
 
 // NewExtensibleFormatter creates a formatter with custom strategy
@@ -661,106 +933,114 @@ func validateSecondsFloat(seconds float64, originalInput string) error {
 	return validateSeconds(seconds, originalInput)
 }
 
-// DMSComponents holds the components of a DMS angle with formatting context
-type DMSComponents struct {
-	degrees        int
-	minutes        int
-	seconds        float64
-	isNegativeZero bool
+// formatAngle provides unified formatting logic for both Angle and AngleFormatter
+func formatAngle(alpha float64, format AngleFormat, precision int, width int, useSymbols bool) string {
+	var buf [64]byte
+	return string(appendAngle(buf[:0], alpha, format, precision, width, useSymbols))
 }
 
-// getDMSComponents extracts DMS components and handles negative zero case
-func getDMSComponents(alpha float64) DMSComponents {
-	var degrees int
-	var minutes int
-	var seconds float64
-
-	DMS(alpha, &degrees, &minutes, &seconds)
-
-	return DMSComponents{
-		degrees:        degrees,
-		minutes:        minutes,
-		seconds:        seconds,
-		isNegativeZero: alpha < 0 && degrees == 0,
-	}
+// appendAngle appends the formatted angle to dst without intermediate allocations. With
+// symbols a negative precision selects the format's usual one.
+func appendAngle(dst []byte, alpha float64, format AngleFormat, precision int, width int, useSymbols bool) []byte {
+	return appendDisplayedAngle(dst, alpha, format, useSymbols, DisplayOptions{Precision: precision, Width: width})
 }
 
-// formatAngle provides unified formatting logic for both Angle and AngleFormatter
-func formatAngle(alpha float64, format AngleFormat, precision int, width int, useSymbols bool) string {
-	components := getDMSComponents(alpha)
-	var result string
-
+// appendDisplayedAngle is appendAngle laid out by the display options: with ZeroPad the whole
+// units, minutes and seconds are padded to two digits, seconds and milliseconds of arc
+// excepted, and with Sign a '+' marks values that are not negative. The sign is decided on the
+// rounded value in every format, so a negative value that rounds to zero loses its minus.
+func appendDisplayedAngle(dst []byte, alpha float64, format AngleFormat, useSymbols bool, display DisplayOptions) []byte {
+	start := len(dst)
+	precision := display.Precision
+	if useSymbols && precision < 0 {
+		precision = format.symbolPrecision()
+	}
 	switch format {
-	case Dd:
-		if useSymbols {
-			result = fmt.Sprintf("%.5f°", alpha)
-		} else {
-			result = fmt.Sprintf("%.*f", precision, alpha)
-		}
-	case DMM:
-		if useSymbols {
-			if components.isNegativeZero {
-				result = fmt.Sprintf("%d°%02d'", components.degrees, components.minutes)
-			} else {
-				result = fmt.Sprintf("%d°%02d'", components.degrees, int(math.Abs(float64(components.minutes))))
-			}
-		} else {
-			if components.isNegativeZero {
-				result = fmt.Sprintf("%d %d", components.degrees, components.minutes)
-			} else {
-				result = fmt.Sprintf("%d %d", components.degrees, int(math.Abs(float64(components.minutes))))
-			}
-		}
-	case DMMm:
-		minutesDecimal := math.Abs(float64(components.minutes)) + math.Abs(components.seconds)/SecondsPerMinute
-		if components.isNegativeZero {
-			minutesDecimal = -minutesDecimal
-		}
-		if useSymbols {
-			result = fmt.Sprintf("%d°%.3f'", components.degrees, minutesDecimal)
-		} else {
-			result = fmt.Sprintf("%d %.*f", components.degrees, precision, minutesDecimal)
-		}
-	case DMMSS:
-		if useSymbols {
-			if components.isNegativeZero {
-				result = fmt.Sprintf("%d°%02d'%02d\"", components.degrees, components.minutes, int(components.seconds))
-			} else {
-				result = fmt.Sprintf("%d°%02d'%02d\"", components.degrees, int(math.Abs(float64(components.minutes))), int(math.Abs(components.seconds)))
-			}
-		} else {
-			if components.isNegativeZero {
-				result = fmt.Sprintf("%d %d %d", components.degrees, components.minutes, int(components.seconds))
-			} else {
-				result = fmt.Sprintf("%d %d %d", components.degrees, int(math.Abs(float64(components.minutes))), int(math.Abs(components.seconds)))
-			}
-		}
-	case DMMSSs:
-		if useSymbols {
-			if components.isNegativeZero {
-				result = fmt.Sprintf("%d°%02d'%.3f\"", components.degrees, components.minutes, components.seconds)
-			} else {
-				result = fmt.Sprintf("%d°%02d'%.3f\"", components.degrees, int(math.Abs(float64(components.minutes))), math.Abs(components.seconds))
-			}
-		} else {
-			if components.isNegativeZero {
-				result = fmt.Sprintf("%d %d %.*f", components.degrees, components.minutes, precision, components.seconds)
-			} else {
-				result = fmt.Sprintf("%d %d %.*f", components.degrees, int(math.Abs(float64(components.minutes))), precision, math.Abs(components.seconds))
-			}
-		}
+	case DMM, DMMm, DMMSS, DMMSSs:
+		dst = roundSexagesimal(alpha, format, precision).appendTo(dst, format, precision, useSymbols, display.ZeroPad, display.Sign)
+	case HMM, HMMSS, HMMSSs:
+		hours := roundSexagesimal(alpha/DegreesPerHour, format, precision)
+		dst = hours.appendTo(dst, format, precision, useSymbols, display.ZeroPad, display.Sign)
+	case Hh:
+		dst = appendSignedFloat(dst, alpha/DegreesPerHour, precision, display.ZeroPad, display.Sign)
+		dst = append(dst, 'h')
+	case Arcsec:
+		dst = appendSignedFloat(dst, alpha*SecondsPerDegree, precision, false, display.Sign)
+		dst = append(dst, '"')
+	case Mas:
+		dst = appendSignedFloat(dst, alpha*MilliarcsecondsPerDegree, precision, false, display.Sign)
+		dst = append(dst, " mas"...)
 	default:
+		dst = appendSignedFloat(dst, alpha, precision, display.ZeroPad, display.Sign)
 		if useSymbols {
-			result = fmt.Sprintf("%.5f°", alpha)
-		} else {
-			result = fmt.Sprintf("%.*f", precision, alpha)
+			dst = append(dst, "°"...)
 		}
 	}
 
 	// Apply width formatting with left justification
-	if width > 0 {
-		result = fmt.Sprintf("%-*s", width, result)
+	for n := utf8.RuneCount(dst[start:]); n < display.Width; n++ {
+		dst = append(dst, ' ')
+	}
+	return dst
+}
+
+// appendSignedFloat appends v as appendFloat does, with the sign decided on the digits written:
+// a value that rounds to zero loses its minus, and with sign a '+' marks the others
+func appendSignedFloat(dst []byte, v float64, precision int, zeroPad, sign bool) []byte {
+	start := len(dst)
+	dst = appendFloat(dst, v, precision, zeroPad)
+	negative := dst[start] == '-'
+	if negative && !bytes.ContainsAny(dst[start:], "123456789Inf") {
+		dst = append(dst[:start], dst[start+1:]...)
+		negative = false
 	}
+	if sign && !negative && dst[start] != '+' {
+		dst = insertByte(dst, start, '+')
+	}
+	return dst
+}
 
-	return result
+// appendFloat appends v with precision decimals, with its whole part padded to two digits when
+// zeroPad is set: 5.5 becomes 05.50
+func appendFloat(dst []byte, v float64, precision int, zeroPad bool) []byte {
+	start := len(dst)
+	dst = strconv.AppendFloat(dst, v, 'f', precision, 64)
+	if !zeroPad {
+		return dst
+	}
+	if dst[start] == '-' {
+		start++
+	}
+	end := start
+	for end < len(dst) && dst[end] >= '0' && dst[end] <= '9' {
+		end++
+	}
+	if end-start == 1 {
+		dst = insertByte(dst, start, '0')
+	}
+	return dst
+}
+
+// insertByte inserts c into dst at index i
+func insertByte(dst []byte, i int, c byte) []byte {
+	dst = append(dst, 0)
+	copy(dst[i+1:], dst[i:])
+	dst[i] = c
+	return dst
+}
+
+// appendTwoDigits appends n zero-padded to two characters, matching %02d
+func appendTwoDigits(dst []byte, n int) []byte {
+	if n >= 0 && n < 10 {
+		dst = append(dst, '0')
+	}
+	return strconv.AppendInt(dst, int64(n), 10)
+}
+
+// absInt returns the absolute value of n
+func absInt(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
 }
@@ -3,6 +3,7 @@ package angles
 import (
 	"math"
 	"strings"
+	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -19,6 +20,12 @@ var _ = Describe("Angles", func() {
 			Entry("DMMm format", DMMm, "DMMm"),
 			Entry("DMMSS format", DMMSS, "DMMSS"),
 			Entry("DMMSSs format", DMMSSs, "DMMSSs"),
+			Entry("Hh format", Hh, "Hh"),
+			Entry("HMM format", HMM, "HMM"),
+			Entry("HMMSS format", HMMSS, "HMMSS"),
+			Entry("HMMSSs format", HMMSSs, "HMMSSs"),
+			Entry("Arcsec format", Arcsec, "Arcsec"),
+			Entry("Mas format", Mas, "Mas"),
 		)
 
 		It("should have correct iota values", func() {
@@ -38,21 +45,39 @@ var _ = Describe("Angles", func() {
 			})
 
 			It("should create angle with specified format", func() {
-				angle := NewAngle(15.5, DMMSS)
+				angle := NewAngle(15.5, WithAngleFormat(DMMSS))
 				Expect(angle.String()).To(Equal("15°30'00\""))
 			})
 		})
 
+		Describe("unit constructors and accessors", func() {
+			It("should build angles from sexagesimal and small units", func() {
+				Expect(NewAngleFromDMS(0, -30, 0).Degrees()).To(Equal(-0.5))
+				Expect(NewAngleFromDMS(15, 30, 0, WithAngleFormat(DMMSS)).String()).To(Equal("15°30'00\""))
+				Expect(NewAngleFromArcseconds(0.7687).Degrees()).To(BeNumerically("~", 0.7687/3600, 1e-18))
+				Expect(NewAngleFromMilliarcseconds(768.0665).Arcseconds()).To(BeNumerically("~", 0.7680665, 1e-15))
+				Expect(NewAngleFromHours(10.1395).Degrees()).To(BeNumerically("~", 152.0925, 1e-12))
+			})
+
+			It("should express angles in hours, arcminutes, arcseconds and milliarcseconds", func() {
+				angle := NewAngle(1.5)
+				Expect(angle.Hours()).To(Equal(0.1))
+				Expect(angle.Arcminutes()).To(Equal(90.0))
+				Expect(angle.Arcseconds()).To(Equal(5400.0))
+				Expect(angle.Milliarcseconds()).To(Equal(5.4e6))
+			})
+		})
+
 		Describe("Set", func() {
 			It("should set format to default Dd", func() {
-				angle := NewAngle(15.5, DMMSS)
+				angle := NewAngle(15.5, WithAngleFormat(DMMSS))
 				angle.Set()
 				Expect(angle.String()).To(Equal("15.50000°"))
 			})
 
 			It("should set format to specified value", func() {
 				angle := NewAngle(15.5)
-				angle.Set(DMM)
+				angle.Set(WithAngleFormat(DMM))
 				Expect(angle.String()).To(Equal("15°30'"))
 			})
 		})
@@ -60,7 +85,7 @@ var _ = Describe("Angles", func() {
 		Describe("String", func() {
 			DescribeTable("formats angles correctly",
 				func(alpha float64, format AngleFormat, expected string) {
-					angle := NewAngle(alpha, format)
+					angle := NewAngle(alpha, WithAngleFormat(format))
 					Expect(angle.String()).To(Equal(expected))
 				},
 				Entry("Dd format", 15.5, Dd, "15.50000°"),
@@ -73,6 +98,13 @@ var _ = Describe("Angles", func() {
 				Entry("negative DMMm", -8.15278, DMMm, "-8°9.167'"),
 				Entry("negative DMMSS", -8.15278, DMMSS, "-8°09'10\""),
 				Entry("negative DMMSSs", -8.15278, DMMSSs, "-8°09'10.008\""),
+				Entry("Hh format", 188.625, Hh, "12.57500h"),
+				Entry("HMM format", 188.625, HMM, "12h34m"),
+				Entry("HMMSS format", 188.7362, HMMSS, "12h34m56s"),
+				Entry("HMMSSs format", 188.73625, HMMSSs, "12h34m56.700s"),
+				Entry("negative HMMSS", -7.5, HMMSS, "-0h30m00s"),
+				Entry("Arcsec format", 0.7687/3600, Arcsec, "0.7687\""),
+				Entry("Mas format", 768.0665/3.6e6, Mas, "768.067 mas"),
 			)
 		})
 	})
@@ -147,6 +179,29 @@ var _ = Describe("Angles", func() {
 		)
 	})
 
+	Describe("SplitDMS", func() {
+		DescribeTable("returns the same components as DMS",
+			func(decimal float64) {
+				var degrees, minutes int
+				var seconds float64
+				DMS(decimal, &degrees, &minutes, &seconds)
+				d, m, s := SplitDMS(decimal)
+				Expect([]any{d, m, s}).To(Equal([]any{degrees, minutes, seconds}))
+			},
+			Entry("15.5", 15.5),
+			Entry("-8.15278", -8.15278),
+			Entry("-0.08334", -0.08334),
+			Entry("-0.000001", -0.000001),
+		)
+
+		It("should sign the first non-zero component", func() {
+			d, m, s := SplitDMS(-0.08334)
+			Expect(d).To(Equal(0))
+			Expect(m).To(Equal(-5))
+			Expect(s).To(BeNumerically("~", 0.024, 0.001))
+		})
+	})
+
 	Describe("AngleFormatter", func() {
 		Describe("fluent interface with 12.3456", func() {
 			It("should format as 12.35 (Dd with precision 2)", func() {
@@ -189,25 +244,175 @@ var _ = Describe("Angles", func() {
 			})
 		})
 
+		Describe("sign and zero padding", func() {
+			It("should write a catalog declination as '+05 03 07'", func() {
+				result := NewFormatter(5.052).Format(DMMSS).Sign(true).ZeroPad(true).String()
+				Expect(result).To(Equal("+05 03 07"))
+			})
+
+			It("should keep the minus of negative values", func() {
+				result := NewFormatter(-5.052).Format(DMMSS).Sign(true).ZeroPad(true).String()
+				Expect(result).To(Equal("-05 03 07"))
+			})
+
+			It("should pad the whole part of decimal fields", func() {
+				Expect(NewFormatter(5.052).Format(DMMSSs).Precision(1).ZeroPad(true).String()).To(Equal("05 03 07.2"))
+				Expect(NewFormatter(5.052).Format(DMMm).Precision(1).ZeroPad(true).String()).To(Equal("05 03.1"))
+				Expect(NewFormatter(5.5).Format(Dd).Precision(2).ZeroPad(true).String()).To(Equal("05.50"))
+				Expect(NewFormatter(-5.5).Format(Dd).Precision(2).ZeroPad(true).String()).To(Equal("-05.50"))
+			})
+
+			It("should pad hours", func() {
+				result := NewFormatter(NewAngleFromHours(5.052).Degrees(), WithFormat(HMMSS), WithZeroPad()).String()
+				Expect(result).To(Equal("05h 03m 07s"))
+			})
+
+			It("should write a catalog declination in symbols as '+05°03'07\"'", func() {
+				result := NewFormatter(5.052).Format(DMMSS).Symbols(true).Sign(true).ZeroPad(true).String()
+				Expect(result).To(Equal(`+05°03'07"`))
+				result = NewFormatter(5.052, WithFormat(DMMSSs), WithPrecision(1), WithSymbols(), WithSign()).String()
+				Expect(result).To(Equal(`+5°03'7.2"`))
+				result = NewFormatter(NewAngleFromHours(5.052).Degrees(), WithFormat(HMMSS), WithSymbols(), WithZeroPad()).String()
+				Expect(result).To(Equal("05h03m07s"))
+			})
+
+			DescribeTable("should give '+' to a negative value that rounds to zero in every format",
+				func(format AngleFormat, expected string) {
+					result := NewFormatter(-1e-9, WithFormat(format), WithSign(), WithZeroPad()).String()
+					Expect(result).To(Equal(expected))
+					Expect(NewFormatter(-1e-9, WithFormat(format), WithZeroPad()).String()).To(Equal(expected[1:]))
+				},
+				Entry("DMMSS", DMMSS, "+00 00 00"),
+				Entry("Dd", Dd, "+00.00"),
+				Entry("Hh", Hh, "+00.00h"),
+				Entry("Arcsec", Arcsec, `+0.00"`),
+				Entry("Mas", Mas, "+0.00 mas"),
+			)
+
+			It("should keep the minus of a negative value that does not round to zero", func() {
+				Expect(NewFormatter(-0.006, WithFormat(Dd), WithSign()).String()).To(Equal("-0.01"))
+				Expect(NewFormatter(-0.006, WithFormat(Arcsec), WithSign()).String()).To(Equal(`-21.60"`))
+			})
+
+			It("should count the sign within the width", func() {
+				result := NewFormatter(5.052, WithFormat(DMMSS), WithSign(), WithZeroPad(), WithWidth(11)).String()
+				Expect(result).To(Equal("+05 03 07  "))
+			})
+
+			It("should leave output unchanged when switched off", func() {
+				result := NewFormatter(5.052).Format(DMMSS).Sign(false).ZeroPad(false).String()
+				Expect(result).To(Equal("5 3 7"))
+			})
+		})
+
+		Describe("functional options", func() {
+			It("should configure the formatter at construction", func() {
+				result := NewFormatter(12.3456, WithFormat(DMMSSs), WithPrecision(1), WithWidth(14)).String()
+				Expect(result).To(Equal("12 20 44.2    "))
+			})
+
+			It("should leave the fluent setters working after options", func() {
+				result := NewFormatter(12.3456, WithPrecision(3)).Format(DMMm).String()
+				Expect(result).To(Equal("12 20.736"))
+			})
+		})
+
+		Describe("AppendFormat", func() {
+			DescribeTable("should append the same text as String",
+				func(alpha float64, format AngleFormat) {
+					formatter := NewFormatter(alpha, WithFormat(format), WithPrecision(2), WithWidth(16))
+					Expect(string(formatter.AppendFormat([]byte("x=")))).To(Equal("x=" + formatter.String()))
+					angle := NewAngle(alpha, WithAngleFormat(format))
+					Expect(string(angle.AppendFormat(nil))).To(Equal(angle.String()))
+					Expect(string(AppendFormat(nil, alpha, format, 2))).To(Equal(NewFormatter(alpha, WithFormat(format)).String()))
+				},
+				Entry("Dd", 12.3456, Dd),
+				Entry("DMM", -0.3456, DMM),
+				Entry("DMMm", -12.3456, DMMm),
+				Entry("DMMSS", 0.0125, DMMSS),
+				Entry("DMMSSs", -0.0125, DMMSSs),
+			)
+
+			It("should not allocate when the buffer has room", func() {
+				buf := make([]byte, 0, 64)
+				formatter := NewFormatter(-123.456789, WithFormat(DMMSSs))
+				allocs := testing.AllocsPerRun(100, func() {
+					buf = formatter.AppendFormat(buf[:0])
+					buf = AppendFormat(buf[:0], 42.5, DMMm, 3)
+				})
+				Expect(allocs).To(BeZero())
+			})
+		})
+
+		Describe("small-angle formats", func() {
+			It("should format parallaxes and proper motions in arcseconds and milliarcseconds", func() {
+				parallax := NewAngleFromMilliarcseconds(768.0665)
+				Expect(NewFormatter(parallax.Degrees()).Format(Mas).Precision(2).String()).To(Equal("768.07 mas"))
+				Expect(NewFormatter(parallax.Degrees()).Format(Arcsec).Precision(3).String()).To(Equal("0.768\""))
+				Expect(string(AppendFormat(nil, -parallax.Degrees(), Mas, 1))).To(Equal("-768.1 mas"))
+			})
+		})
+
+		Describe("hour formats", func() {
+			It("should format right ascension in hours, minutes and seconds of time", func() {
+				Expect(NewFormatter(188.73625).Format(HMMSSs).Precision(1).String()).To(Equal("12h 34m 56.7s"))
+				Expect(NewFormatter(188.7362).Format(HMMSS).String()).To(Equal("12h 34m 56s"))
+				Expect(NewFormatter(188.625).Format(HMM).String()).To(Equal("12h 34m"))
+				Expect(NewFormatter(188.625).Format(Hh).Precision(3).String()).To(Equal("12.575h"))
+			})
+
+			It("should format negative hour angles", func() {
+				Expect(NewFormatter(-33.75).Format(HMMSS).String()).To(Equal("-2h 15m 0s"))
+				Expect(NewFormatter(-7.5).Format(HMM).String()).To(Equal("-0h 30m"))
+			})
+
+			It("should append the same text as String", func() {
+				formatter := NewFormatter(-188.73625, WithFormat(HMMSSs), WithWidth(20))
+				Expect(string(formatter.AppendFormat(nil))).To(Equal(formatter.String()))
+				Expect(formatter.String()).To(HaveLen(20))
+			})
+		})
+
 		Describe("negative angle handling", func() {
 			It("should handle negative angles in DMM format", func() {
 				result := NewFormatter(-0.3456).Format(DMM).String()
-				Expect(result).To(Equal("0 -20"))
+				Expect(result).To(Equal("-0 20"))
 			})
 
 			It("should handle negative angles in DMMm format", func() {
 				result := NewFormatter(-0.3456).Format(DMMm).Precision(2).String()
-				Expect(result).To(Equal("0 -20.74"))
+				Expect(result).To(Equal("-0 20.74"))
 			})
 
 			It("should handle negative angles in DMMSS format", func() {
 				result := NewFormatter(-0.3456).Format(DMMSS).String()
-				Expect(result).To(Equal("0 -20 44"))
+				Expect(result).To(Equal("-0 20 44"))
 			})
 
 			It("should handle negative angles in DMMSSs format", func() {
 				result := NewFormatter(-0.3456).Format(DMMSSs).Precision(2).String()
-				Expect(result).To(Equal("0 -20 44.16"))
+				Expect(result).To(Equal("-0 20 44.16"))
+			})
+		})
+
+		Describe("carrying rounded fields", func() {
+			DescribeTable("should carry seconds and minutes that round to 60",
+				func(alpha float64, format AngleFormat, precision int, expected string) {
+					Expect(NewFormatter(alpha).Format(format).Precision(precision).String()).To(Equal(expected))
+				},
+				Entry("DMMSSs", 29.99999, DMMSSs, 1, "30 0 0.0"),
+				Entry("DMMSSs into minutes", 12.5166666, DMMSSs, 1, "12 31 0.0"),
+				Entry("DMMm", 29.99999, DMMm, 2, "30 0.00"),
+				Entry("negative DMMSSs", -29.99999, DMMSSs, 1, "-30 0 0.0"),
+				Entry("negative zero carrying into degrees", -0.99999, DMMSSs, 1, "-1 0 0.0"),
+				Entry("HMMSSs", 179.99999, HMMSSs, 1, "12h 0m 0.0s"),
+				Entry("whole seconds still truncate", 29.99999, DMMSS, 0, "29 59 59"),
+				Entry("whole minutes still truncate", 29.99999, DMM, 0, "29 59"),
+			)
+
+			It("should carry in symbol notation", func() {
+				Expect(NewAngle(29.9999999, WithAngleFormat(DMMSSs)).String()).To(Equal("30°00'0.000\""))
+				Expect(NewAngle(-29.9999999, WithAngleFormat(DMMm)).String()).To(Equal("-30°0.000'"))
 			})
 		})
 
@@ -259,6 +464,118 @@ var _ = Describe("Angles", func() {
 			})
 		})
 
+		Describe("hour formats", func() {
+			DescribeTable("should parse hours into degrees",
+				func(input string, degrees float64, format AngleFormat) {
+					angle, err := ParseAngle(input)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(angle.Degrees()).To(BeNumerically("~", degrees, 1e-9))
+					Expect(angle.AngleFormat()).To(Equal(format))
+				},
+				Entry("Hh", "12.575h", 188.625, Hh),
+				Entry("HMM", "12h 34m", 188.5, HMM),
+				Entry("HMMSS", "12h 34m 56s", 188.73333333333, HMMSS),
+				Entry("HMMSSs", "12h 34m 56.7s", 188.73625, HMMSSs),
+				Entry("compact", "12h34m56.7s", 188.73625, HMMSSs),
+				Entry("upper case", "12H 34M 56.7S", 188.73625, HMMSSs),
+				Entry("negative", "-2h 2m 0s", -30.5, HMMSS),
+				Entry("small negative", "0h -30m", -7.5, HMM),
+				Entry("negative zero hours", "-0h 30m 0.0s", -7.5, HMMSSs),
+			)
+
+			It("should round-trip through the formatter", func() {
+				for _, alpha := range []float64{188.73625, -0.3456, 359.99} {
+					for _, symbols := range []bool{false, true} {
+						text := NewFormatter(alpha).Format(HMMSSs).Precision(4).String()
+						if symbols {
+							text = NewAngle(alpha, WithAngleFormat(HMMSSs)).String()
+						}
+						angle, err := ParseAngle(text)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(angle.Degrees()).To(BeNumerically("~", alpha, 1e-5))
+					}
+				}
+			})
+
+			It("should reject malformed hour components", func() {
+				for _, input := range []string{"12h 34s", "12h 34m 56s 7s", "12m", "h", "12h 61m", "12h 34m 60.5s", "12.5h 3m"} {
+					_, err := ParseAngle(input)
+					Expect(err).To(HaveOccurred(), input)
+				}
+			})
+		})
+
+		Describe("small-angle units", func() {
+			DescribeTable("should parse milliarcseconds and arcseconds",
+				func(input string, arcseconds float64, format AngleFormat) {
+					angle, err := ParseAngle(input)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(angle.Arcseconds()).To(BeNumerically("~", arcseconds, 1e-12))
+					Expect(angle.AngleFormat()).To(Equal(format))
+				},
+				Entry("milliarcseconds", "768.07 mas", 0.76807, Mas),
+				Entry("milliarcseconds without a space", "-3775.4mas", -3.7754, Mas),
+				Entry("arcseconds", `0.7681"`, 0.7681, Arcsec),
+				Entry("arcseconds with a double prime", "10.3″", 10.3, Arcsec),
+				Entry("sexagesimal seconds stay sexagesimal", `0 0 10.3"`, 10.3, DMMSSs),
+			)
+
+			It("should round-trip the formatter", func() {
+				text := NewFormatter(-3.7754 / 3600).Format(Mas).Precision(1).String()
+				angle, err := ParseAngle(text)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(angle.Milliarcseconds()).To(BeNumerically("~", -3775.4, 1e-9))
+			})
+
+			It("should reject a unit without a number", func() {
+				_, err := ParseAngle("mas")
+				Expect(err).To(HaveOccurred())
+				_, err = ParseAngle("1.2.3 mas")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Describe("catalog notation", func() {
+			DescribeTable("should parse symbols, colons and hemispheres",
+				func(input string, degrees float64, format AngleFormat) {
+					angle, err := ParseAngle(input)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(angle.Degrees()).To(BeNumerically("~", degrees, 1e-9))
+					Expect(angle.AngleFormat()).To(Equal(format))
+				},
+				Entry("arcsecond marks", `12°34'56.7"`, 12+34/60.0+56.7/3600, DMMSSs),
+				Entry("prime marks", "12°34′56″", 12+34/60.0+56/3600.0, DMMSS),
+				Entry("degree mark only", "15.5°", 15.5, Dd),
+				Entry("colons", "12:34:56", 12+34/60.0+56/3600.0, DMMSS),
+				Entry("negative colons", "-12:30", -12.5, DMM),
+				Entry("north", "45°30'N", 45.5, DMM),
+				Entry("west", "73°59'W", -(73+59/60.0), DMM),
+				Entry("south with a space", "33 52 S", -(33+52/60.0), DMM),
+				Entry("east in lower case", "151.2e", 151.2, Dd),
+				Entry("south of a symbol-formatted angle", `8°09'10.008"S`, -(8+9/60.0+10.008/3600), DMMSSs),
+			)
+
+			It("should round-trip Angle.String", func() {
+				for _, format := range []AngleFormat{Dd, DMM, DMMSS, DMMSSs} {
+					text := NewAngle(-8.15278, WithAngleFormat(format)).String()
+					angle, err := ParseAngle(text)
+					Expect(err).NotTo(HaveOccurred(), text)
+					Expect(angle.AngleFormat()).To(Equal(format))
+					Expect(angle.Degrees()).To(BeNumerically("~", -8.15278, 1.0/60))
+				}
+			})
+
+			It("should reject a sign with a hemisphere", func() {
+				_, err := ParseAngle("-45°30'N")
+				Expect(err).To(MatchError(ContainSubstring("both a sign and a hemisphere")))
+			})
+
+			It("should reject a bare hemisphere letter", func() {
+				_, err := ParseAngle("N")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
 		Describe("negative angles", func() {
 			It("should parse negative Dd format", func() {
 				angle, err := ParseAngle("-12.35")
@@ -302,7 +619,7 @@ var _ = Describe("Angles", func() {
 
 			It("should round-trip negative angles with precision loss", func() {
 				original := -0.3456
-				formatted := NewFormatter(original).Format(DMM).String() // "0 -20"
+				formatted := NewFormatter(original).Format(DMM).String() // "-0 20"
 				parsed, err := ParseAngle(formatted)
 
 				Expect(err).To(BeNil())
@@ -0,0 +1,27 @@
+package angles
+
+// Add returns a new angle equal to a + b in the format of a, without wrapping
+func (a *Angle) Add(b AngleValue) *Angle {
+	return NewAngle(a.alpha+b.Degrees(), WithAngleFormat(a.format))
+}
+
+// Subtract returns a new angle equal to a - b in the format of a, without wrapping; use
+// ShortestDifference for the smaller rotation between two directions
+func (a *Angle) Subtract(b AngleValue) *Angle {
+	return NewAngle(a.alpha-b.Degrees(), WithAngleFormat(a.format))
+}
+
+// Multiply returns a new angle scaled by k in the format of a, without wrapping
+func (a *Angle) Multiply(k float64) *Angle {
+	return NewAngle(a.alpha*k, WithAngleFormat(a.format))
+}
+
+// Wrap returns a new angle reduced to [0, 360), suited to azimuths and right ascensions
+func (a *Angle) Wrap() *Angle {
+	return NewAngle(NormalizeDegrees(a.alpha), WithAngleFormat(a.format))
+}
+
+// WrapSigned returns a new angle reduced to (-180, 180], suited to longitudes and hour angles
+func (a *Angle) WrapSigned() *Angle {
+	return NewAngle(WrapSigned(a.alpha), WithAngleFormat(a.format))
+}
@@ -0,0 +1,36 @@
+package angles
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Angle arithmetic", func() {
+	It("should add, subtract and scale while keeping the format", func() {
+		a := NewAngle(350, WithAngleFormat(DMMSS))
+		sum := a.Add(NewAngle(20))
+		Expect(sum.Degrees()).To(Equal(370.0))
+		Expect(sum.AngleFormat()).To(Equal(DMMSS))
+		Expect(a.Subtract(NewAngleFromHours(1)).Degrees()).To(Equal(335.0))
+		Expect(a.Multiply(-0.5).Degrees()).To(Equal(-175.0))
+		Expect(a.Degrees()).To(Equal(350.0))
+	})
+
+	It("should wrap across north", func() {
+		Expect(NewAngle(350).Add(NewAngle(20)).Wrap().Degrees()).To(BeNumerically("~", 10, 1e-12))
+		Expect(NewAngle(10).Subtract(NewAngle(20)).Wrap().Degrees()).To(BeNumerically("~", 350, 1e-12))
+		Expect(NewAngle(10).Subtract(NewAngle(20)).WrapSigned().Degrees()).To(BeNumerically("~", -10, 1e-12))
+		Expect(NewAngle(-1e-17).Wrap().Degrees()).To(Equal(0.0))
+	})
+
+	DescribeTable("WrapSigned",
+		func(degrees, expected float64) {
+			Expect(WrapSigned(degrees)).To(BeNumerically("~", expected, 1e-12))
+		},
+		Entry("positive", 190.0, -170.0),
+		Entry("half turn", 180.0, 180.0),
+		Entry("negative half turn", -180.0, 180.0),
+		Entry("several turns", -725.0, -5.0),
+		Entry("tiny negative", -1e-17, -1e-17),
+	)
+})
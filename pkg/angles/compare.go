@@ -0,0 +1,34 @@
+package angles
+
+// ShortestDifference returns a - b in degrees wrapped into (-180, 180]: the smallest rotation
+// taking b onto a, positive counterclockwise (increasing angle). Opposite directions give +180.
+func ShortestDifference(a, b float64) float64 {
+	return WrapSigned(a - b)
+}
+
+// IsBetween reports whether angle lies on the arc running counterclockwise from low to high,
+// ends included, so IsBetween(5, 350, 20) is true and IsBetween(5, 20, 350) is false
+func IsBetween(angle, low, high float64) bool {
+	return NewInterval(low, high).Contains(angle)
+}
+
+// IsWithin reports whether angle lies within tolerance degrees of center either way round,
+// e.g. IsWithin(azimuth, 355, 10) for azimuths from 345° through north to 5°
+func IsWithin(angle, center, tolerance float64) bool {
+	d := ShortestDifference(angle, center)
+	return d <= tolerance && d >= -tolerance
+}
+
+// Compare orders two directions by the shorter way round the circle: -1 when a lies clockwise
+// of b (behind it), 0 when they are equal, and +1 when a lies counterclockwise (ahead).
+// Opposite directions compare as +1, matching ShortestDifference. The relation is not
+// transitive, so it suits comparing nearby angles rather than sorting.
+func Compare(a, b float64) int {
+	switch d := ShortestDifference(a, b); {
+	case d < 0:
+		return -1
+	case d > 0:
+		return 1
+	}
+	return 0
+}
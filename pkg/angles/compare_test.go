@@ -0,0 +1,43 @@
+package angles
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Wrap-around comparison", func() {
+	DescribeTable("ShortestDifference",
+		func(a, b, expected float64) {
+			Expect(ShortestDifference(a, b)).To(BeNumerically("~", expected, 1e-12))
+		},
+		Entry("across north", 5.0, 355.0, 10.0),
+		Entry("back across north", 355.0, 5.0, -10.0),
+		Entry("without wrapping", 100.0, 40.0, 60.0),
+		Entry("unnormalized inputs", 725.0, -5.0, 10.0),
+		Entry("equal", 42.0, 402.0, 0.0),
+		Entry("opposite is +180", 0.0, 180.0, 180.0),
+		Entry("opposite the other way is +180", 180.0, 0.0, 180.0),
+	)
+
+	It("should test arcs counterclockwise from low to high", func() {
+		Expect(IsBetween(5, 350, 20)).To(BeTrue())
+		Expect(IsBetween(5, 20, 350)).To(BeFalse())
+		Expect(IsBetween(350, 350, 20)).To(BeTrue())
+		Expect(IsBetween(-10, 340, 355)).To(BeTrue())
+	})
+
+	It("should test a tolerance either side of a center", func() {
+		Expect(IsWithin(3, 355, 10)).To(BeTrue())
+		Expect(IsWithin(345, 355, 10)).To(BeTrue())
+		Expect(IsWithin(6, 355, 10)).To(BeFalse())
+		Expect(IsWithin(340, 355, 10)).To(BeFalse())
+	})
+
+	It("should order directions by the shorter way round", func() {
+		Expect(Compare(5, 355)).To(Equal(1))
+		Expect(Compare(355, 5)).To(Equal(-1))
+		Expect(Compare(10, 370)).To(Equal(0))
+		Expect(Compare(0, 180)).To(Equal(1))
+		Expect(Compare(180, 0)).To(Equal(1))
+	})
+})
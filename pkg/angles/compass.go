@@ -0,0 +1,68 @@
+package angles
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// CompassPoints is the number of named directions on a compass rose
+type CompassPoints int
+
+const (
+	FourPoints      CompassPoints = 4  // cardinal points
+	EightPoints     CompassPoints = 8  // cardinal and intercardinal points
+	SixteenPoints   CompassPoints = 16 // adds the secondary intercardinals such as WSW
+	ThirtyTwoPoints CompassPoints = 32 // adds the by-points such as NbE
+)
+
+// compassPointStep is the spacing of the 32-point rose in degrees
+const compassPointStep = FullCircleDegrees / float64(ThirtyTwoPoints)
+
+// compassNames are the 32 points clockwise from north; coarser roses use every second, fourth
+// or eighth name
+var compassNames = [ThirtyTwoPoints]string{
+	"N", "NbE", "NNE", "NEbN", "NE", "NEbE", "ENE", "EbN",
+	"E", "EbS", "ESE", "SEbE", "SE", "SEbS", "SSE", "SbE",
+	"S", "SbW", "SSW", "SWbS", "SW", "SWbW", "WSW", "WbS",
+	"W", "WbN", "WNW", "NWbW", "NW", "NWbN", "NNW", "NbW",
+}
+
+// String returns the size of the rose, such as "16-point"
+func (p CompassPoints) String() string {
+	return fmt.Sprintf("%d-point", int(p))
+}
+
+// valid reports whether the rose is one of the four supported sizes
+func (p CompassPoints) valid() bool {
+	return p == FourPoints || p == EightPoints || p == SixteenPoints || p == ThirtyTwoPoints
+}
+
+// CompassPoint returns the name of the point of a rose nearest an azimuth in degrees measured
+// clockwise from north, e.g. "WSW" for 247.5° on the 16-point rose. Unsupported rose sizes
+// fall back to 16 points.
+func CompassPoint(azimuth float64, points CompassPoints) string {
+	if !points.valid() {
+		points = SixteenPoints
+	}
+	sector := FullCircleDegrees / float64(points)
+	i := int(math.Floor(NormalizeDegrees(azimuth)/sector+0.5)) % int(points)
+	return compassNames[i*int(ThirtyTwoPoints/points)]
+}
+
+// ParseCompassPoint returns the azimuth in degrees of a named point of the 32-point rose, in
+// any letter case, so "wsw" gives 247.5
+func ParseCompassPoint(name string) (float64, error) {
+	trimmed := strings.TrimSpace(name)
+	for i, point := range compassNames {
+		if strings.EqualFold(trimmed, point) {
+			return float64(i) * compassPointStep, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown compass point '%s'", name)
+}
+
+// AppendCompassPoint appends the name of the nearest compass point to dst without allocating
+func AppendCompassPoint(dst []byte, azimuth float64, points CompassPoints) []byte {
+	return append(dst, CompassPoint(azimuth, points)...)
+}
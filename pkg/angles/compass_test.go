@@ -0,0 +1,57 @@
+package angles
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Compass points", func() {
+	DescribeTable("CompassPoint",
+		func(azimuth float64, points CompassPoints, expected string) {
+			Expect(CompassPoint(azimuth, points)).To(Equal(expected))
+		},
+		Entry("north", 0.0, SixteenPoints, "N"),
+		Entry("just west of north", 355.0, SixteenPoints, "N"),
+		Entry("WSW", 247.5, SixteenPoints, "WSW"),
+		Entry("nearest 16-point", 62.0, SixteenPoints, "ENE"),
+		Entry("32-point by-point", 11.25, ThirtyTwoPoints, "NbE"),
+		Entry("32-point NWbW", 303.75, ThirtyTwoPoints, "NWbW"),
+		Entry("8-point", 130.0, EightPoints, "SE"),
+		Entry("4-point", 260.0, FourPoints, "W"),
+		Entry("unnormalized", -90.0, FourPoints, "W"),
+		Entry("unsupported rose", 247.5, CompassPoints(12), "WSW"),
+	)
+
+	It("should parse names back into azimuths", func() {
+		for i, name := range compassNames {
+			azimuth, err := ParseCompassPoint(name)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(azimuth).To(Equal(float64(i) * 11.25))
+			Expect(CompassPoint(azimuth, ThirtyTwoPoints)).To(Equal(name))
+		}
+		azimuth, err := ParseCompassPoint(" wsw ")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(azimuth).To(Equal(247.5))
+		_, err = ParseCompassPoint("NNNE")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should name the rose", func() {
+		Expect(SixteenPoints.String()).To(Equal("16-point"))
+	})
+
+	It("should append the point to formatted azimuths", func() {
+		formatter := NewFormatter(247.5, WithCompassPoint(SixteenPoints), WithWidth(12))
+		Expect(formatter.String()).To(Equal("247.50 WSW  "))
+		Expect(string(formatter.AppendFormat([]byte("az ")))).To(Equal("az 247.50 WSW  "))
+		Expect(NewFormatter(64.5, WithFormat(DMM), WithCompassPoint(ThirtyTwoPoints)).String()).To(Equal("64 30 ENE"))
+
+		buf := make([]byte, 0, 32)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = formatter.AppendFormat(buf[:0])
+		})
+		Expect(allocs).To(BeZero())
+	})
+})
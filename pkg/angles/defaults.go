@@ -0,0 +1,30 @@
+package angles
+
+import (
+	"sync"
+)
+
+// defaults holds the format new angles and formatters start from and the precision of new
+// formatters
+var defaults = struct {
+	sync.RWMutex
+	format    AngleFormat
+	precision int
+}{format: Dd, precision: DefaultPrecision}
+
+// SetDefaults changes the format NewAngle and NewFormatter start from and the precision of
+// NewFormatter, for programs that configure formatting once, as astronomy.SetGlobal does. It is
+// safe for concurrent use.
+func SetDefaults(format AngleFormat, precision int) {
+	defaults.Lock()
+	defer defaults.Unlock()
+	defaults.format, defaults.precision = format, precision
+}
+
+// Defaults returns the format and precision new angles and formatters start from, Dd and
+// DefaultPrecision unless SetDefaults changed them
+func Defaults() (format AngleFormat, precision int) {
+	defaults.RLock()
+	defer defaults.RUnlock()
+	return defaults.format, defaults.precision
+}
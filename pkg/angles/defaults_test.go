@@ -0,0 +1,21 @@
+package angles
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Defaults", func() {
+	It("should start new angles and formatters from the defaults", func() {
+		Expect(NewFormatter(12.3456).String()).To(Equal("12.35"))
+		SetDefaults(DMMSSs, 1)
+		DeferCleanup(SetDefaults, Dd, DefaultPrecision)
+
+		Expect(NewFormatter(12.3456).String()).To(Equal("12 20 44.2"))
+		Expect(NewAngle(12.3456).AngleFormat()).To(Equal(DMMSSs))
+		Expect(NewFormatter(12.3456, WithFormat(Dd)).String()).To(Equal("12.3"))
+		format, precision := Defaults()
+		Expect(format).To(Equal(DMMSSs))
+		Expect(precision).To(Equal(1))
+	})
+})
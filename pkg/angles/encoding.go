@@ -0,0 +1,109 @@
+package angles
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+)
+
+// Serialization selects how angles are written by their text and JSON marshalers
+type Serialization int32
+
+const (
+	SerializeDegrees   Serialization = iota // decimal degrees, exact, as a JSON number
+	SerializeFormatted                      // the angle's own format as String gives it, as a JSON string
+)
+
+// String returns the name of the serialization
+func (s Serialization) String() string {
+	return [...]string{"SerializeDegrees", "SerializeFormatted"}[s]
+}
+
+// serialization is the package-wide choice, read on every marshal
+var serialization atomic.Int32
+
+// SetSerialization chooses how angles are marshaled from now on, process-wide. Decimal degrees
+// round-trip exactly; formatted output is easier to read and edit but keeps only the
+// precision of String, a millisecond of arc in DMMSSs. Unmarshaling accepts both forms
+// whatever the setting.
+func SetSerialization(s Serialization) {
+	serialization.Store(int32(s))
+}
+
+// CurrentSerialization returns the serialization in effect
+func CurrentSerialization() Serialization {
+	return Serialization(serialization.Load())
+}
+
+// MarshalText encodes the angle as decimal degrees in the shortest exact form, or in its format
+// under SerializeFormatted
+func (a Angle) MarshalText() ([]byte, error) {
+	if CurrentSerialization() == SerializeFormatted {
+		return a.AppendFormat(nil), nil
+	}
+	return strconv.AppendFloat(nil, a.alpha, 'g', -1, 64), nil
+}
+
+// UnmarshalText decodes any form ParseAngle accepts, taking its format from the text
+func (a *Angle) UnmarshalText(text []byte) error {
+	parsed, err := ParseAngle(string(text))
+	if err != nil {
+		return fmt.Errorf("invalid Angle text: %v", err)
+	}
+	*a = *parsed
+	return nil
+}
+
+// MarshalJSON encodes the angle as a number of decimal degrees, or as a string in its format
+// under SerializeFormatted
+func (a Angle) MarshalJSON() ([]byte, error) {
+	if CurrentSerialization() == SerializeFormatted {
+		return json.Marshal(a.String())
+	}
+	return json.Marshal(a.alpha)
+}
+
+// UnmarshalJSON decodes a number of decimal degrees or a string in any form ParseAngle accepts
+func (a *Angle) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '"' {
+		var text string
+		if err := json.Unmarshal(trimmed, &text); err != nil {
+			return fmt.Errorf("invalid Angle JSON: %v", err)
+		}
+		return a.UnmarshalText([]byte(text))
+	}
+	var degrees float64
+	if err := json.Unmarshal(trimmed, &degrees); err != nil {
+		return fmt.Errorf("invalid Angle JSON: %v", err)
+	}
+	*a = Angle{alpha: degrees, format: Dd}
+	return nil
+}
+
+// MarshalText encodes the formatter's output, so formatted angles can be written directly by
+// encoders that honour encoding.TextMarshaler
+func (f *ConcreteAngleFormatter) MarshalText() ([]byte, error) {
+	return f.AppendFormat(nil), nil
+}
+
+// MarshalText encodes the format's name, so formats can be written to configuration files and
+// used with flag.TextVar
+func (af AngleFormat) MarshalText() ([]byte, error) {
+	if af < 0 || int(af) >= len(angleFormats) {
+		return nil, fmt.Errorf("invalid AngleFormat %d", int(af))
+	}
+	return []byte(af.String()), nil
+}
+
+// UnmarshalText decodes a format name in any letter case
+func (af *AngleFormat) UnmarshalText(text []byte) error {
+	format, err := ParseAngleFormat(string(text))
+	if err != nil {
+		return fmt.Errorf("invalid AngleFormat text: %v", err)
+	}
+	*af = format
+	return nil
+}
@@ -0,0 +1,141 @@
+package angles
+
+import (
+	"encoding/json"
+	"flag"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Angle encoding", func() {
+	type site struct {
+		Name     string `json:"name"`
+		Latitude *Angle `json:"latitude"`
+	}
+
+	AfterEach(func() {
+		SetSerialization(SerializeDegrees)
+	})
+
+	It("should marshal decimal degrees exactly by default", func() {
+		Expect(CurrentSerialization()).To(Equal(SerializeDegrees))
+		data, err := json.Marshal(site{Name: "Mauna Kea", Latitude: NewAngle(19.8207, WithAngleFormat(DMMSS))})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(Equal(`{"name":"Mauna Kea","latitude":19.8207}`))
+
+		text, err := NewAngle(-0.1).MarshalText()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(text)).To(Equal("-0.1"))
+	})
+
+	It("should marshal the angle's format when asked", func() {
+		SetSerialization(SerializeFormatted)
+		Expect(CurrentSerialization().String()).To(Equal("SerializeFormatted"))
+		data, err := json.Marshal(site{Latitude: NewAngle(19.8207, WithAngleFormat(DMMSS))})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(Equal(`{"name":"","latitude":"19°49'14\""}`))
+
+		text, err := NewAngleFromHours(12.5, WithAngleFormat(HMM)).MarshalText()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(text)).To(Equal("12h30m"))
+	})
+
+	It("should unmarshal numbers and formatted strings", func() {
+		var s site
+		Expect(json.Unmarshal([]byte(`{"latitude": -33.8675}`), &s)).To(Succeed())
+		Expect(s.Latitude.Degrees()).To(Equal(-33.8675))
+		Expect(s.Latitude.AngleFormat()).To(Equal(Dd))
+
+		Expect(json.Unmarshal([]byte(`{"latitude": "33°52'03\"S"}`), &s)).To(Succeed())
+		Expect(s.Latitude.Degrees()).To(BeNumerically("~", -33.8675, 1e-9))
+		Expect(s.Latitude.AngleFormat()).To(Equal(DMMSS))
+
+		var a Angle
+		Expect(a.UnmarshalText([]byte("12h 30m"))).To(Succeed())
+		Expect(a.Degrees()).To(Equal(187.5))
+	})
+
+	It("should round-trip in both modes", func() {
+		for _, mode := range []Serialization{SerializeDegrees, SerializeFormatted} {
+			SetSerialization(mode)
+			original := NewAngle(-8.15278, WithAngleFormat(DMMSSs))
+			data, err := json.Marshal(original)
+			Expect(err).NotTo(HaveOccurred())
+			var decoded Angle
+			Expect(json.Unmarshal(data, &decoded)).To(Succeed())
+			Expect(decoded.Degrees()).To(BeNumerically("~", original.Degrees(), 1e-6), mode.String())
+		}
+	})
+
+	It("should round-trip an angle held by value", func() {
+		type target struct {
+			Dec Angle
+		}
+		data, err := json.Marshal(target{Dec: *NewAngle(-16.7161)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(Equal(`{"Dec":-16.7161}`))
+
+		for _, mode := range []Serialization{SerializeDegrees, SerializeFormatted} {
+			SetSerialization(mode)
+			original := target{Dec: *NewAngle(-16.7161, WithAngleFormat(DMMSSs))}
+			data, err := json.Marshal(original)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).NotTo(Equal(`{"Dec":{}}`))
+			var decoded target
+			Expect(json.Unmarshal(data, &decoded)).To(Succeed())
+			Expect(decoded.Dec.Degrees()).To(BeNumerically("~", -16.7161, 1e-6), mode.String())
+		}
+	})
+
+	It("should reject invalid input", func() {
+		var a Angle
+		Expect(a.UnmarshalJSON([]byte(`true`))).To(MatchError(ContainSubstring("invalid Angle JSON")))
+		Expect(a.UnmarshalJSON([]byte(`"12@34"`))).To(MatchError(ContainSubstring("invalid Angle text")))
+	})
+
+	It("should marshal formatter output as text", func() {
+		text, err := NewFormatter(247.5, WithCompassPoint(SixteenPoints)).MarshalText()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(text)).To(Equal("247.50 WSW"))
+	})
+})
+
+var _ = Describe("AngleFormat text", func() {
+	It("should parse every format name in any letter case", func() {
+		for _, format := range []AngleFormat{Dd, DMM, DMMm, DMMSS, DMMSSs, Hh, HMM, HMMSS, HMMSSs, Arcsec, Mas} {
+			parsed, err := ParseAngleFormat(format.String())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed).To(Equal(format))
+		}
+		Expect(ParseAngleFormat(" dmmss ")).To(Equal(DMMSS))
+		Expect(ParseAngleFormat("DMMSSS")).To(Equal(DMMSSs))
+		Expect(ParseAngleFormat("mas")).To(Equal(Mas))
+		_, err := ParseAngleFormat("DMS")
+		Expect(err).To(MatchError("unknown angle format 'DMS'"))
+	})
+
+	It("should work as a command-line flag", func() {
+		format := Dd
+		flags := flag.NewFlagSet("test", flag.ContinueOnError)
+		flags.TextVar(&format, "format", Dd, "angle format")
+		Expect(flags.Parse([]string{"-format", "hmmsss"})).To(Succeed())
+		Expect(format).To(Equal(HMMSSs))
+		Expect(flags.Lookup("format").DefValue).To(Equal("Dd"))
+	})
+
+	It("should round-trip through JSON and reject unknown names", func() {
+		data, err := json.Marshal(map[string]AngleFormat{"format": DMMm})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(Equal(`{"format":"DMMm"}`))
+
+		var decoded map[string]AngleFormat
+		Expect(json.Unmarshal(data, &decoded)).To(Succeed())
+		Expect(decoded["format"]).To(Equal(DMMm))
+
+		var format AngleFormat
+		Expect(format.UnmarshalText([]byte("radians"))).To(MatchError(ContainSubstring("invalid AngleFormat text")))
+		_, err = AngleFormat(99).MarshalText()
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,117 @@
+package angles
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	// ErrRightAscensionRange is returned for a right ascension outside [0h, 24h)
+	ErrRightAscensionRange = errors.New("angles: right ascension outside [0h, 24h)")
+	// ErrDeclinationRange is returned for a declination outside [-90°, +90°]
+	ErrDeclinationRange = errors.New("angles: declination outside [-90°, +90°]")
+)
+
+// RightAscension is an angle in [0h, 24h) that formats in hours, minutes and seconds of time.
+// Its distinct type keeps it from being passed where a Declination is expected.
+type RightAscension struct {
+	angle Angle
+}
+
+// NewRightAscension creates a right ascension from degrees in [0, 360)
+func NewRightAscension(degrees float64) (RightAscension, error) {
+	if math.IsNaN(degrees) || degrees < 0 || degrees >= FullCircleDegrees {
+		return RightAscension{}, fmt.Errorf("%w: %g°", ErrRightAscensionRange, degrees)
+	}
+	return RightAscension{angle: Angle{alpha: degrees, format: HMMSSs}}, nil
+}
+
+// NewRightAscensionHours creates a right ascension from hours in [0, 24)
+func NewRightAscensionHours(hours float64) (RightAscension, error) {
+	return NewRightAscension(hours * DegreesPerHour)
+}
+
+// NewRightAscensionHMS creates a right ascension from hours, minutes and seconds of time
+func NewRightAscensionHMS(hours, minutes int, seconds float64) (RightAscension, error) {
+	return NewRightAscensionHours(Ddd(hours, minutes, seconds))
+}
+
+// Degrees returns the right ascension in degrees
+func (ra RightAscension) Degrees() float64 {
+	return ra.angle.alpha
+}
+
+// Radians returns the right ascension in radians
+func (ra RightAscension) Radians() float64 {
+	return DegreesToRadians(ra.angle.alpha)
+}
+
+// Hours returns the right ascension in hours
+func (ra RightAscension) Hours() float64 {
+	return ra.angle.alpha / DegreesPerHour
+}
+
+// Angle returns the right ascension as an Angle in HMMSSs format
+func (ra RightAscension) Angle() *Angle {
+	return NewAngle(ra.angle.alpha, WithAngleFormat(ra.angle.format))
+}
+
+// String formats the right ascension as "12h34m56.700s"; one that rounds up to 24h is written
+// as 0h
+func (ra RightAscension) String() string {
+	var buf [32]byte
+	return string(appendRightAscension(buf[:0], ra.angle.alpha, -1))
+}
+
+// appendRightAscension appends degrees as hours in [0h, 24h) in symbol notation, with a
+// negative precision selecting the usual one. The circle is closed after rounding, so a value
+// just short of 360° is written as 0h rather than 24h.
+func appendRightAscension(dst []byte, degrees float64, precision int) []byte {
+	if precision < 0 {
+		precision = HMMSSs.symbolPrecision()
+	}
+	s := roundSexagesimal(NormalizeDegrees(degrees)/DegreesPerHour, HMMSSs, precision)
+	s.Degrees %= int(FullCircleDegrees / DegreesPerHour)
+	return s.appendTo(dst, HMMSSs, precision, true, false, false)
+}
+
+// Declination is an angle in [-90°, +90°] that formats in degrees, minutes and seconds of arc.
+// Its distinct type keeps it from being passed where a RightAscension is expected.
+type Declination struct {
+	angle Angle
+}
+
+// NewDeclination creates a declination from degrees in [-90, 90]
+func NewDeclination(degrees float64) (Declination, error) {
+	if math.IsNaN(degrees) || degrees < -90 || degrees > 90 {
+		return Declination{}, fmt.Errorf("%w: %g°", ErrDeclinationRange, degrees)
+	}
+	return Declination{angle: Angle{alpha: degrees, format: DMMSSs}}, nil
+}
+
+// NewDeclinationDMS creates a declination from degrees, minutes and seconds of arc, with the
+// sign taken from the first nonzero component as in Ddd
+func NewDeclinationDMS(degrees, minutes int, seconds float64) (Declination, error) {
+	return NewDeclination(Ddd(degrees, minutes, seconds))
+}
+
+// Degrees returns the declination in degrees
+func (d Declination) Degrees() float64 {
+	return d.angle.alpha
+}
+
+// Radians returns the declination in radians
+func (d Declination) Radians() float64 {
+	return DegreesToRadians(d.angle.alpha)
+}
+
+// Angle returns the declination as an Angle in DMMSSs format
+func (d Declination) Angle() *Angle {
+	return NewAngle(d.angle.alpha, WithAngleFormat(d.angle.format))
+}
+
+// String formats the declination as "-8°09'10.008\""
+func (d Declination) String() string {
+	return d.angle.String()
+}
@@ -0,0 +1,76 @@
+package angles
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Equatorial angle types", func() {
+	It("should build right ascensions in hours", func() {
+		ra, err := NewRightAscensionHMS(12, 34, 56.7)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ra.Hours()).To(BeNumerically("~", 12.58241667, 1e-8))
+		Expect(ra.Degrees()).To(BeNumerically("~", 188.73625, 1e-9))
+		Expect(ra.Radians()).To(BeNumerically("~", 188.73625*math.Pi/180, 1e-12))
+		Expect(ra.String()).To(Equal("12h34m56.700s"))
+		Expect(ra.Angle().AngleFormat()).To(Equal(HMMSSs))
+
+		zero, err := NewRightAscension(0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(zero.Hours()).To(Equal(0.0))
+	})
+
+	DescribeTable("should refuse right ascensions outside [0h, 24h)",
+		func(degrees float64) {
+			_, err := NewRightAscension(degrees)
+			Expect(err).To(MatchError(ErrRightAscensionRange))
+		},
+		Entry("negative", -0.001),
+		Entry("full circle", 360.0),
+		Entry("NaN", math.NaN()),
+	)
+
+	It("should write a right ascension that rounds up to 24h as 0h", func() {
+		ra, err := NewRightAscensionHMS(23, 59, 59.9999)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ra.String()).To(Equal("0h00m0.000s"))
+	})
+
+	It("should refuse 24 hours", func() {
+		_, err := NewRightAscensionHours(24)
+		Expect(err).To(MatchError(ErrRightAscensionRange))
+	})
+
+	It("should build declinations in degrees", func() {
+		dec, err := NewDeclinationDMS(-8, 9, 10.008)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dec.Degrees()).To(BeNumerically("~", -8.15278, 1e-9))
+		Expect(dec.String()).To(Equal("-8°09'10.008\""))
+		Expect(dec.Angle().AngleFormat()).To(Equal(DMMSSs))
+		Expect(dec.Radians()).To(BeNumerically("<", 0))
+
+		pole, err := NewDeclination(90)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pole.Degrees()).To(Equal(90.0))
+	})
+
+	DescribeTable("should refuse declinations outside [-90°, +90°]",
+		func(degrees float64) {
+			_, err := NewDeclination(degrees)
+			Expect(err).To(MatchError(ErrDeclinationRange))
+		},
+		Entry("beyond the north pole", 90.0001),
+		Entry("beyond the south pole", -91.0),
+		Entry("NaN", math.NaN()),
+	)
+
+	It("should satisfy AngleValue", func() {
+		ra, _ := NewRightAscensionHours(6)
+		dec, _ := NewDeclination(-30)
+		values := []AngleValue{ra, dec}
+		Expect(values[0].Degrees()).To(Equal(90.0))
+		Expect(values[1].Degrees()).To(Equal(-30.0))
+	})
+})
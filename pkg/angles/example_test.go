@@ -0,0 +1,42 @@
+package angles_test
+
+import (
+	"fmt"
+
+	"github.com/ocrosby/astronomy/pkg/angles"
+)
+
+func ExampleParseAngle() {
+	for _, input := range []string{"12 20 44.16", `45°30'N`, "73°59'W", "12h 34m 56.7s", "768.07 mas"} {
+		angle, err := angles.ParseAngle(input)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		fmt.Printf("%-14s %10.5f° %s\n", input, angle.Degrees(), angle.AngleFormat())
+	}
+	// Output:
+	// 12 20 44.16      12.34560° DMMSSs
+	// 45°30'N          45.50000° DMM
+	// 73°59'W         -73.98333° DMM
+	// 12h 34m 56.7s   188.73625° HMMSSs
+	// 768.07 mas        0.00021° Mas
+}
+
+func ExampleNewFormatter() {
+	fmt.Println(angles.NewFormatter(12.3456).Format(angles.DMMSSs).Precision(2).String())
+	fmt.Println(angles.NewFormatter(188.73625).Format(angles.HMMSSs).Precision(1).String())
+	fmt.Println(angles.NewFormatter(247.5, angles.WithCompassPoint(angles.SixteenPoints)).String())
+	// Output:
+	// 12 20 44.16
+	// 12h 34m 56.7s
+	// 247.50 WSW
+}
+
+func ExampleShortestDifference() {
+	fmt.Println(angles.ShortestDifference(5, 355))
+	fmt.Println(angles.NewAngle(350).Add(angles.NewAngle(20)).Wrap().Degrees())
+	// Output:
+	// 10
+	// 10
+}
@@ -0,0 +1,56 @@
+package angles
+
+import (
+	"testing"
+)
+
+var sink []byte
+
+func BenchmarkAngleString(b *testing.B) {
+	a := NewAngle(-123.456789, WithAngleFormat(DMMSSs))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = a.String()
+	}
+}
+
+func BenchmarkAngleAppendFormat(b *testing.B) {
+	a := NewAngle(-123.456789, WithAngleFormat(DMMSSs))
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sink = a.AppendFormat(buf[:0])
+	}
+}
+
+func BenchmarkFormatterString(b *testing.B) {
+	f := NewFormatter(123.456789, WithFormat(DMMSSs), WithPrecision(2))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = f.String()
+	}
+}
+
+func BenchmarkFormatterAppendFormat(b *testing.B) {
+	f := NewFormatter(123.456789, WithFormat(DMMSSs), WithPrecision(2))
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sink = f.AppendFormat(buf[:0])
+	}
+}
+
+func BenchmarkAppendFormat(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sink = AppendFormat(buf[:0], float64(i%360)+0.123456, DMMSSs, 2)
+	}
+}
+
+func BenchmarkParseAngle(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseAngle("-12 34 56.78")
+	}
+}
@@ -0,0 +1,143 @@
+package angles
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// ErrLatitudeRange is returned for a latitude outside [-90°, +90°]
+	ErrLatitudeRange = errors.New("angles: latitude outside [-90°, +90°]")
+	// ErrLongitudeRange is returned for a longitude outside [-180°, +180°]
+	ErrLongitudeRange = errors.New("angles: longitude outside [-180°, +180°]")
+)
+
+// Latitude is a geographic latitude in [-90°, +90°], positive north, that formats with a
+// hemisphere letter
+type Latitude struct {
+	degrees float64
+}
+
+// NewLatitude creates a latitude from degrees in [-90, 90], positive north
+func NewLatitude(degrees float64) (Latitude, error) {
+	if math.IsNaN(degrees) || degrees < -90 || degrees > 90 {
+		return Latitude{}, fmt.Errorf("%w: %g°", ErrLatitudeRange, degrees)
+	}
+	return Latitude{degrees: degrees}, nil
+}
+
+// ParseLatitude parses the forms GPS receivers and maps print, such as "40.7128", "40.7128° N",
+// "40°42'46\"N", "N 40 42.767" or "-33:52:04", rejecting an east or west hemisphere
+func ParseLatitude(input string) (Latitude, error) {
+	degrees, err := parseGeographic(input, "NS")
+	if err != nil {
+		return Latitude{}, err
+	}
+	return NewLatitude(degrees)
+}
+
+// Degrees returns the latitude in degrees, positive north
+func (l Latitude) Degrees() float64 {
+	return l.degrees
+}
+
+// Radians returns the latitude in radians, positive north
+func (l Latitude) Radians() float64 {
+	return DegreesToRadians(l.degrees)
+}
+
+// Hemisphere returns 'N' or 'S'; the equator counts as north
+func (l Latitude) Hemisphere() byte {
+	if l.degrees < 0 {
+		return 'S'
+	}
+	return 'N'
+}
+
+// String formats the latitude as "40°42'46.080\"N"
+func (l Latitude) String() string {
+	return formatGeographic(l.degrees, "NS")
+}
+
+// Longitude is a geographic longitude in [-180°, +180°], positive east, that formats with a
+// hemisphere letter
+type Longitude struct {
+	degrees float64
+}
+
+// NewLongitude creates a longitude from degrees in [-180, 180], positive east
+func NewLongitude(degrees float64) (Longitude, error) {
+	if math.IsNaN(degrees) || degrees < -180 || degrees > 180 {
+		return Longitude{}, fmt.Errorf("%w: %g°", ErrLongitudeRange, degrees)
+	}
+	return Longitude{degrees: degrees}, nil
+}
+
+// ParseLongitude parses the same forms as ParseLatitude with an east or west hemisphere, such
+// as "74°00'22\"W" or "W 74 0.367"
+func ParseLongitude(input string) (Longitude, error) {
+	degrees, err := parseGeographic(input, "EW")
+	if err != nil {
+		return Longitude{}, err
+	}
+	return NewLongitude(degrees)
+}
+
+// Degrees returns the longitude in degrees, positive east
+func (l Longitude) Degrees() float64 {
+	return l.degrees
+}
+
+// Radians returns the longitude in radians, positive east
+func (l Longitude) Radians() float64 {
+	return DegreesToRadians(l.degrees)
+}
+
+// Hemisphere returns 'E' or 'W'; the prime meridian counts as east
+func (l Longitude) Hemisphere() byte {
+	if l.degrees < 0 {
+		return 'W'
+	}
+	return 'E'
+}
+
+// String formats the longitude as "74°00'21.600\"W"
+func (l Longitude) String() string {
+	return formatGeographic(l.degrees, "EW")
+}
+
+// formatGeographic formats the magnitude of an angle in degrees, minutes and seconds of arc
+// followed by the first of a pair of hemisphere letters, or the second when the value is still
+// negative after rounding, so a value that rounds to zero is on the equator or prime meridian
+func formatGeographic(degrees float64, hemispheres string) string {
+	s := roundSexagesimal(degrees, DMMSSs, 3)
+	hemisphere := hemispheres[0]
+	if s.Negative() {
+		hemisphere, s.Sign = hemispheres[1], 1
+	}
+	var buf [32]byte
+	dst := s.appendTo(buf[:0], DMMSSs, 3, true, false, false)
+	return string(append(dst, hemisphere))
+}
+
+// parseGeographic parses an angle whose hemisphere letter, if any, may lead or trail and must
+// be one of the allowed pair
+func parseGeographic(input, allowed string) (float64, error) {
+	trimmed := strings.TrimSpace(input)
+	if first, size := utf8.DecodeRuneInString(trimmed); strings.ContainsRune("NSEWnsew", first) && size < len(trimmed) {
+		// a leading hemisphere, as in "N 40 42.767", is moved to the end for ParseAngle
+		trimmed = strings.TrimSpace(trimmed[size:]) + string(first)
+	}
+	if last, _ := utf8.DecodeLastRuneInString(trimmed); strings.ContainsRune("NSEWnsew", last) &&
+		!strings.ContainsRune(allowed+strings.ToLower(allowed), last) {
+		return 0, fmt.Errorf("invalid hemisphere '%c' in '%s': expected %c or %c", last, input, allowed[0], allowed[1])
+	}
+	angle, err := ParseAngle(trimmed)
+	if err != nil {
+		return 0, err
+	}
+	return angle.Degrees(), nil
+}
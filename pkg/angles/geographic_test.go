@@ -0,0 +1,82 @@
+package angles
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Geographic angle types", func() {
+	It("should validate latitudes", func() {
+		lat, err := NewLatitude(-33.8675)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lat.Degrees()).To(Equal(-33.8675))
+		Expect(lat.Radians()).To(BeNumerically("~", -33.8675*math.Pi/180, 1e-15))
+		Expect(lat.Hemisphere()).To(Equal(byte('S')))
+		Expect(lat.String()).To(Equal("33°52'3.000\"S"))
+
+		for _, bad := range []float64{200, -90.5, math.NaN()} {
+			_, err := NewLatitude(bad)
+			Expect(err).To(MatchError(ErrLatitudeRange))
+		}
+	})
+
+	It("should validate longitudes", func() {
+		lon, err := NewLongitude(-74.006)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lon.Hemisphere()).To(Equal(byte('W')))
+		Expect(lon.String()).To(Equal("74°00'21.600\"W"))
+		zero, _ := NewLongitude(0)
+		Expect(zero.Hemisphere()).To(Equal(byte('E')))
+
+		for _, bad := range []float64{180.01, -200, math.Inf(1)} {
+			_, err := NewLongitude(bad)
+			Expect(err).To(MatchError(ErrLongitudeRange))
+		}
+	})
+
+	It("should take the hemisphere from the rounded value", func() {
+		lat, _ := NewLatitude(-1e-10)
+		Expect(lat.String()).To(Equal("0°00'0.000\"N"))
+		lon, _ := NewLongitude(-1e-10)
+		Expect(lon.String()).To(Equal("0°00'0.000\"E"))
+		south, _ := NewLatitude(-0.0000005)
+		Expect(south.String()).To(Equal("0°00'0.002\"S"))
+	})
+
+	DescribeTable("should parse GPS latitude forms",
+		func(input string, expected float64) {
+			lat, err := ParseLatitude(input)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lat.Degrees()).To(BeNumerically("~", expected, 1e-6))
+		},
+		Entry("signed decimal", "-33.8675", -33.8675),
+		Entry("decimal with symbol and hemisphere", "40.7128° N", 40.7128),
+		Entry("sexagesimal", `40°42'46"N`, 40+42/60.0+46/3600.0),
+		Entry("leading hemisphere with decimal minutes", "S 33 52.05", -(33+52.05/60)),
+		Entry("colons", "-33:52:03", -(33+52/60.0+3/3600.0)),
+	)
+
+	DescribeTable("should parse GPS longitude forms",
+		func(input string, expected float64) {
+			lon, err := ParseLongitude(input)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lon.Degrees()).To(BeNumerically("~", expected, 1e-6))
+		},
+		Entry("west", `74°00'22"W`, -(74+22/3600.0)),
+		Entry("leading east", "E151.2093", 151.2093),
+		Entry("round trip", "74°00'21.600\"W", -74.006),
+	)
+
+	It("should reject wrong hemispheres and out-of-range values", func() {
+		_, err := ParseLatitude("45 30 E")
+		Expect(err).To(MatchError(ContainSubstring("invalid hemisphere")))
+		_, err = ParseLongitude("45°N")
+		Expect(err).To(MatchError(ContainSubstring("invalid hemisphere")))
+		_, err = ParseLatitude("200")
+		Expect(err).To(MatchError(ErrLatitudeRange))
+		_, err = ParseLongitude("abc")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,35 @@
+package angles
+
+import (
+	"fmt"
+	"sort"
+)
+
+// LerpAngle returns the direction a fraction t of the way from a to b in degrees along the
+// shorter arc between them, in [0, 360): LerpAngle(350, 10, 0.5) is 0, not 180. Values of t
+// outside [0, 1] extrapolate along the same arc; opposite directions turn counterclockwise
+// from a.
+func LerpAngle(a, b, t float64) float64 {
+	return NormalizeDegrees(a + t*ShortestDifference(b, a))
+}
+
+// InterpolateAngles returns the direction at x from directions sampled at increasing xs,
+// interpolating linearly along the shorter arc between the two samples either side, so a track
+// of azimuths crossing north is followed through 0°. Consecutive samples must therefore be
+// less than 180° apart.
+func InterpolateAngles(xs, angles []float64, x float64) (float64, error) {
+	if len(xs) != len(angles) {
+		return 0, fmt.Errorf("%d abscissae but %d angles", len(xs), len(angles))
+	}
+	if len(xs) == 0 || x < xs[0] || x > xs[len(xs)-1] {
+		return 0, fmt.Errorf("%v is outside the sampled range", x)
+	}
+	i := sort.SearchFloat64s(xs, x)
+	if xs[i] == x {
+		return NormalizeDegrees(angles[i]), nil
+	}
+	if xs[i-1] >= xs[i] {
+		return 0, fmt.Errorf("abscissae are not increasing at index %d", i)
+	}
+	return LerpAngle(angles[i-1], angles[i], (x-xs[i-1])/(xs[i]-xs[i-1])), nil
+}
@@ -0,0 +1,39 @@
+package angles
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Angle interpolation", func() {
+	DescribeTable("LerpAngle",
+		func(a, b, t, expected float64) {
+			Expect(LerpAngle(a, b, t)).To(BeNumerically("~", expected, 1e-9))
+		},
+		Entry("across north", 350.0, 10.0, 0.5, 0.0),
+		Entry("across north backwards", 10.0, 350.0, 0.25, 5.0),
+		Entry("without wrapping", 90.0, 180.0, 0.5, 135.0),
+		Entry("at the start", 350.0, 10.0, 0.0, 350.0),
+		Entry("at the end", 350.0, 10.0, 1.0, 10.0),
+		Entry("extrapolating", 350.0, 10.0, 2.0, 30.0),
+		Entry("negative inputs", -10.0, 10.0, 0.5, 0.0),
+	)
+
+	It("should follow a sampled azimuth track through north", func() {
+		xs := []float64{0, 1, 2, 3}
+		azimuths := []float64{340, 355, 10, 25}
+		Expect(InterpolateAngles(xs, azimuths, 1.5)).To(BeNumerically("~", 2.5, 1e-9))
+		Expect(InterpolateAngles(xs, azimuths, 0.5)).To(BeNumerically("~", 347.5, 1e-9))
+		Expect(InterpolateAngles(xs, azimuths, 3)).To(BeNumerically("~", 25, 1e-9))
+		Expect(InterpolateAngles(xs, azimuths, 0)).To(BeNumerically("~", 340, 1e-9))
+	})
+
+	It("should reject bad samples", func() {
+		_, err := InterpolateAngles([]float64{0, 1}, []float64{0}, 0.5)
+		Expect(err).To(HaveOccurred())
+		_, err = InterpolateAngles([]float64{0, 1}, []float64{0, 10}, 1.5)
+		Expect(err).To(MatchError(ContainSubstring("outside")))
+		_, err = InterpolateAngles(nil, nil, 0)
+		Expect(err).To(HaveOccurred())
+	})
+})
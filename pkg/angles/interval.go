@@ -0,0 +1,108 @@
+package angles
+
+import (
+	"fmt"
+	"math"
+)
+
+// FullCircleDegrees is the width of an interval covering every direction
+const FullCircleDegrees = 360.0
+
+// Interval is an arc of the circle running counterclockwise (increasing angle) from a start
+// angle to an end angle, so that the interval from 350° to 20° covers 30° across north
+type Interval struct {
+	start float64
+	width float64
+}
+
+// NewInterval creates the arc from start to end in degrees; equal angles give a single point
+func NewInterval(start, end float64) Interval {
+	start = NormalizeDegrees(start)
+	return Interval{start: start, width: NormalizeDegrees(end - start)}
+}
+
+// NewIntervalWidth creates the arc starting at start with the given width in degrees, clamped to
+// [0, 360]
+func NewIntervalWidth(start, width float64) Interval {
+	return Interval{start: NormalizeDegrees(start), width: math.Max(0, math.Min(width, FullCircleDegrees))}
+}
+
+// FullCircle returns the interval containing every angle
+func FullCircle() Interval {
+	return Interval{width: FullCircleDegrees}
+}
+
+// Start returns the starting angle in [0, 360)
+func (i Interval) Start() float64 {
+	return i.start
+}
+
+// End returns the ending angle in [0, 360)
+func (i Interval) End() float64 {
+	return NormalizeDegrees(i.start + i.width)
+}
+
+// Width returns the angular extent in degrees
+func (i Interval) Width() float64 {
+	return i.width
+}
+
+// Midpoint returns the angle halfway along the interval
+func (i Interval) Midpoint() float64 {
+	return NormalizeDegrees(i.start + i.width/2)
+}
+
+// IsFull reports whether the interval covers the whole circle
+func (i Interval) IsFull() bool {
+	return i.width >= FullCircleDegrees
+}
+
+// Contains reports whether the angle lies within the interval, including its ends
+func (i Interval) Contains(angle float64) bool {
+	return i.IsFull() || NormalizeDegrees(angle-i.start) <= i.width
+}
+
+// Overlaps reports whether the intervals share at least one angle
+func (i Interval) Overlaps(other Interval) bool {
+	return i.Contains(other.start) || other.Contains(i.start)
+}
+
+// Intersect returns the arcs common to both intervals: none, one, or two when each interval
+// wraps around the ends of the other
+func (i Interval) Intersect(other Interval) []Interval {
+	switch {
+	case i.IsFull():
+		return []Interval{other}
+	case other.IsFull():
+		return []Interval{i}
+	}
+
+	var parts []Interval
+	if d := NormalizeDegrees(other.start - i.start); d <= i.width {
+		parts = append(parts, Interval{start: other.start, width: math.Min(other.width, i.width-d)})
+	}
+	if d := NormalizeDegrees(i.start - other.start); d <= other.width && d > 0 {
+		parts = append(parts, Interval{start: i.start, width: math.Min(i.width, other.width-d)})
+	}
+	return parts
+}
+
+// Union returns the arcs covered by either interval: one merged interval when they overlap or
+// touch, otherwise both intervals
+func (i Interval) Union(other Interval) []Interval {
+	if d := NormalizeDegrees(other.start - i.start); d <= i.width {
+		return []Interval{NewIntervalWidth(i.start, math.Max(i.width, d+other.width))}
+	}
+	if d := NormalizeDegrees(i.start - other.start); d <= other.width {
+		return []Interval{NewIntervalWidth(other.start, math.Max(other.width, d+i.width))}
+	}
+	return []Interval{i, other}
+}
+
+// String returns the interval as "[start°, end°]"
+func (i Interval) String() string {
+	if i.IsFull() {
+		return "[0°, 360°]"
+	}
+	return fmt.Sprintf("[%g°, %g°]", i.start, i.End())
+}
@@ -0,0 +1,93 @@
+package angles
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Interval", func() {
+	slit := NewInterval(350, 20)
+
+	It("should normalize its ends and measure its width", func() {
+		i := NewInterval(-10, 380)
+		Expect(i.Start()).To(Equal(350.0))
+		Expect(i.End()).To(Equal(20.0))
+		Expect(i.Width()).To(Equal(30.0))
+		Expect(slit.Midpoint()).To(Equal(5.0))
+	})
+
+	DescribeTable("Contains handles wrap-around",
+		func(angle float64, expected bool) {
+			Expect(slit.Contains(angle)).To(Equal(expected))
+		},
+		Entry("inside before north", 355.0, true),
+		Entry("north", 0.0, true),
+		Entry("inside after north", 10.0, true),
+		Entry("start", 350.0, true),
+		Entry("end", 20.0, true),
+		Entry("outside", 180.0, false),
+		Entry("negative angle inside", -5.0, true),
+		Entry("just past the end", 20.5, false),
+	)
+
+	It("should treat the full circle as containing everything", func() {
+		Expect(FullCircle().Contains(123)).To(BeTrue())
+		Expect(NewIntervalWidth(10, 400).IsFull()).To(BeTrue())
+		Expect(FullCircle().String()).To(Equal("[0°, 360°]"))
+	})
+
+	Describe("Intersect", func() {
+		It("should return a single overlap", func() {
+			parts := slit.Intersect(NewInterval(10, 90))
+			Expect(parts).To(Equal([]Interval{NewInterval(10, 20)}))
+		})
+
+		It("should return nothing for disjoint intervals", func() {
+			Expect(slit.Intersect(NewInterval(90, 180))).To(BeEmpty())
+		})
+
+		It("should return two arcs when both intervals wrap", func() {
+			parts := slit.Intersect(NewInterval(10, 355))
+			Expect(parts).To(ConsistOf(NewInterval(10, 20), NewInterval(350, 355)))
+		})
+
+		It("should return the inner interval when nested", func() {
+			Expect(NewInterval(0, 100).Intersect(NewInterval(50, 60))).To(Equal([]Interval{NewInterval(50, 60)}))
+			Expect(NewInterval(50, 60).Intersect(NewInterval(0, 100))).To(Equal([]Interval{NewInterval(50, 60)}))
+		})
+
+		It("should return one arc for equal starts", func() {
+			Expect(NewInterval(10, 30).Intersect(NewInterval(10, 20))).To(Equal([]Interval{NewInterval(10, 20)}))
+		})
+
+		It("should intersect with the full circle", func() {
+			Expect(FullCircle().Intersect(slit)).To(Equal([]Interval{slit}))
+		})
+	})
+
+	Describe("Union", func() {
+		It("should merge overlapping intervals across north", func() {
+			Expect(slit.Union(NewInterval(15, 40))).To(Equal([]Interval{NewInterval(350, 40)}))
+			Expect(NewInterval(15, 40).Union(slit)).To(Equal([]Interval{NewInterval(350, 40)}))
+		})
+
+		It("should keep disjoint intervals", func() {
+			Expect(slit.Union(NewInterval(90, 180))).To(HaveLen(2))
+		})
+
+		It("should produce the full circle when the intervals cover it", func() {
+			union := NewInterval(0, 200).Union(NewInterval(180, 20))
+			Expect(union).To(HaveLen(1))
+			Expect(union[0].IsFull()).To(BeTrue())
+		})
+	})
+
+	It("should detect overlaps", func() {
+		Expect(slit.Overlaps(NewInterval(20, 30))).To(BeTrue())
+		Expect(slit.Overlaps(NewInterval(21, 30))).To(BeFalse())
+	})
+
+	It("should format as a string", func() {
+		Expect(slit.String()).To(Equal("[350°, 20°]"))
+	})
+})
@@ -0,0 +1,60 @@
+package angles
+
+import (
+	"fmt"
+	"strings"
+)
+
+// parseOptions holds the settings of ParseAngle
+type parseOptions struct {
+	packed bool
+	hours  bool
+}
+
+// ParseOption configures ParseAngle
+type ParseOption func(*parseOptions)
+
+// WithPacked reads the input as packed degrees, the compact notation of astrometric catalogs:
+// a signed number whose integer digits hold whole degrees, two digits of minutes and optionally
+// two of seconds, as in ±DDDMMSS.s, ±DDMMSS.s or ±DDMM.m. "+123456.7" is 12°34'56.7" and
+// "-0530" is -5°30'.
+func WithPacked() ParseOption {
+	return func(o *parseOptions) { o.packed = true }
+}
+
+// WithPackedHours reads the input as packed hours, minutes and optionally seconds, as right
+// ascensions are packed: "121530" is 12h15m30s and "0542.5" is 5h42.5m
+func WithPackedHours() ParseOption {
+	return func(o *parseOptions) { o.packed, o.hours = true, true }
+}
+
+// parsePacked splits packed digits into sexagesimal components and parses those, so that the
+// usual range checks apply: the last four integer digits are minutes and seconds when there are
+// at least five, otherwise the last two are minutes
+func parsePacked(input string, hours bool, originalInput string) (*Angle, error) {
+	sign := ""
+	if input[0] == '+' || input[0] == '-' {
+		sign, input = input[:1], input[1:]
+	}
+	integer, fraction, _ := strings.Cut(input, ".")
+	if strings.Trim(integer, "0123456789") != "" || strings.Trim(fraction, "0123456789") != "" {
+		return nil, fmt.Errorf("invalid packed angle: expected digits in '%s'", originalInput)
+	}
+	if len(integer) < 3 {
+		return nil, fmt.Errorf("invalid packed angle: expected at least 3 integer digits in '%s'", originalInput)
+	}
+	if strings.Contains(input, ".") {
+		fraction = "." + fraction
+	}
+
+	var parts []string
+	if n := len(integer); n >= 5 {
+		parts = []string{sign + integer[:n-4], integer[n-4 : n-2], integer[n-2:] + fraction}
+	} else {
+		parts = []string{sign + integer[:n-2], integer[n-2:] + fraction}
+	}
+	if hours {
+		return parseHMSParts(parts, originalInput)
+	}
+	return parseDMSFormat(parts, originalInput)
+}
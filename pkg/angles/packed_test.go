@@ -0,0 +1,48 @@
+package angles
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Packed angles", func() {
+	DescribeTable("WithPacked",
+		func(input string, degrees float64, format AngleFormat) {
+			angle, err := ParseAngle(input, WithPacked())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(angle.Degrees()).To(BeNumerically("~", degrees, 1e-9))
+			Expect(angle.AngleFormat()).To(Equal(format))
+		},
+		Entry("signed with decimals", "+123456.7", 12+34/60.0+56.7/3600, DMMSSs),
+		Entry("negative", "-123456", -(12+34/60.0+56/3600.0), DMMSS),
+		Entry("three-digit degrees", "1234530", 123+45/60.0+30/3600.0, DMMSS),
+		Entry("under a degree", "-000030.0", -30/3600.0, DMMSSs),
+		Entry("degrees and minutes", "-0530", -5.5, DMM),
+		Entry("decimal minutes", "1234.5", 12+34.5/60, DMMm),
+	)
+
+	DescribeTable("WithPackedHours",
+		func(input string, degrees float64, format AngleFormat) {
+			angle, err := ParseAngle(input, WithPackedHours())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(angle.Degrees()).To(BeNumerically("~", degrees, 1e-9))
+			Expect(angle.AngleFormat()).To(Equal(format))
+		},
+		Entry("hours, minutes and seconds", "121530", 15*(12+15/60.0+30/3600.0), HMMSS),
+		Entry("decimal seconds", "053432.25", 15*(5+34/60.0+32.25/3600), HMMSSs),
+		Entry("hours and minutes", "0542", 15*(5+42/60.0), HMM),
+	)
+
+	It("should reject malformed packed values", func() {
+		for _, input := range []string{"12", "12 34 56", "+12a456", "126056", "123460.5", "1.2.3", "--123456"} {
+			_, err := ParseAngle(input, WithPacked())
+			Expect(err).To(HaveOccurred(), input)
+		}
+	})
+
+	It("should leave unpacked parsing alone without the option", func() {
+		angle, err := ParseAngle("123456")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(angle.Degrees()).To(Equal(123456.0))
+	})
+})
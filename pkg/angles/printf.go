@@ -0,0 +1,79 @@
+package angles
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// Degrees is an angle in decimal degrees that formats itself under the fmt verbs described at
+// Angle.Format, printing as Dd under %v
+type Degrees float64
+
+// Format implements fmt.Formatter
+func (d Degrees) Format(s fmt.State, verb rune) {
+	formatVerb(s, verb, float64(d), Dd)
+}
+
+// Format implements fmt.Formatter, so angles can be given directly to Printf and log calls:
+//
+//	%v, %s  the angle's own format in symbol notation, as String prints it
+//	%d      degrees, minutes and seconds of arc
+//	%h      hours, minutes and seconds of time
+//	%f, %g  decimal degrees as for a float64, as are %e and the capitals
+//
+// A precision sets the decimals of the last component, so "%.1d" gives 12°20'44.2"; the #
+// flag selects the spaced notation of the fluent formatter, "12 20 44.16"; the + flag signs
+// angles that are not negative once rounded; and a width pads on the left, or on the right
+// with the - flag.
+func (a Angle) Format(s fmt.State, verb rune) {
+	formatVerb(s, verb, a.alpha, a.format)
+}
+
+// formatVerb writes an angle in a format under a fmt verb
+func formatVerb(s fmt.State, verb rune, alpha float64, format AngleFormat) {
+	switch verb {
+	case 'v', 's':
+	case 'd':
+		format = DMMSSs
+	case 'h':
+		format = HMMSSs
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+		fmt.Fprintf(s, fmt.FormatString(s, verb), alpha)
+		return
+	default:
+		fmt.Fprintf(s, "%%!%c(angle=%g)", verb, alpha)
+		return
+	}
+
+	useSymbols := !s.Flag('#')
+	precision, ok := s.Precision()
+	if !ok {
+		precision = -1
+		if !useSymbols {
+			precision = DefaultPrecision
+		}
+	}
+
+	var buf [64]byte
+	text := appendDisplayedAngle(buf[:0], alpha, format, useSymbols, DisplayOptions{Precision: precision, Sign: s.Flag('+')})
+
+	width, ok := s.Width()
+	padding := 0
+	if ok {
+		padding = max(0, width-utf8.RuneCount(text))
+	}
+	if !s.Flag('-') {
+		writeSpaces(s, padding)
+	}
+	s.Write(text)
+	if s.Flag('-') {
+		writeSpaces(s, padding)
+	}
+}
+
+// writeSpaces writes n spaces of padding
+func writeSpaces(s fmt.State, n int) {
+	for ; n > 0; n-- {
+		s.Write([]byte{' '})
+	}
+}
@@ -0,0 +1,45 @@
+package angles
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Printf verbs", func() {
+	angle := NewAngle(12.3456, WithAngleFormat(DMMSSs))
+
+	DescribeTable("should format angles under fmt verbs",
+		func(format string, value any, expected string) {
+			Expect(fmt.Sprintf(format, value)).To(Equal(expected))
+		},
+		Entry("%v as String", "%v", angle, angle.String()),
+		Entry("%s as String", "%s", angle, `12°20'44.160"`),
+		Entry("%v with a precision", "%.1v", angle, `12°20'44.2"`),
+		Entry("%v spaced", "%#v", angle, "12 20 44.16"),
+		Entry("%d for degrees, minutes and seconds", "%.0d", Degrees(-33.8675), `-33°52'3"`),
+		Entry("%h for hours", "%h", NewAngleFromHours(5.5), "5h30m0.000s"),
+		Entry("%h spaced with a precision", "%#.1h", NewAngleFromHours(5.5), "5h 30m 0.0s"),
+		Entry("%v of Degrees as Dd", "%v", Degrees(12.5), "12.50000°"),
+		Entry("%f as a float", "%8.3f", angle, "  12.346"),
+		Entry("%g as a float", "%g", Degrees(0.25), "0.25"),
+		Entry("+ for a sign", "%+.0d", Degrees(12.5), `+12°30'0"`),
+		Entry("+ leaves negatives alone", "%+.0d", Degrees(-12.5), `-12°30'0"`),
+		Entry("+ on a negative that rounds to zero", "%+.2v", Degrees(-0.001), "+0.00°"),
+		Entry("width padding on the left", "%12.2v", Degrees(1.5), "       1.50°"),
+		Entry("- for padding on the right", "%-8.1v|", Degrees(1.5), "1.5°    |"),
+		Entry("unknown verbs", "%x", Degrees(1.5), "%!x(angle=1.5)"),
+	)
+
+	It("should take an Angle directly as a printf argument", func() {
+		Expect(fmt.Sprintf("%.0d %.3v", angle, angle)).To(Equal(`12°20'44" 12°20'44.160"`))
+		Expect(angle.AngleFormat()).To(Equal(DMMSSs))
+	})
+
+	It("should format an Angle held by value", func() {
+		value := *angle
+		Expect(fmt.Sprintf("%.0d", value)).To(Equal(`12°20'44"`))
+		Expect(fmt.Sprintf("%v", struct{ Dec Angle }{value})).To(Equal(`{12°20'44.160"}`))
+	})
+})
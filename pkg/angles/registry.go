@@ -0,0 +1,95 @@
+package angles
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// formatStrategy formats through one of the built-in formats in plain notation
+type formatStrategy struct {
+	format AngleFormat
+}
+
+// Format implements FormatStrategy as the fluent formatter writes the format
+func (s formatStrategy) Format(value float64, precision int) string {
+	return formatAngle(value, s.format, precision, 0, false)
+}
+
+// strategies holds the registered strategies by lower-case name, starting with the built-in
+// formats under their names
+var strategies = struct {
+	sync.RWMutex
+	byName map[string]FormatStrategy
+}{byName: builtinStrategies()}
+
+// builtinStrategies returns a strategy for each AngleFormat, named as String gives it
+func builtinStrategies() map[string]FormatStrategy {
+	byName := make(map[string]FormatStrategy, len(angleFormats))
+	for i, f := range angleFormats {
+		byName[strings.ToLower(f.name)] = formatStrategy{format: AngleFormat(i)}
+	}
+	return byName
+}
+
+// strategyKey returns the name a strategy is registered under, ignoring letter case and
+// surrounding space
+func strategyKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// RegisterStrategy makes a strategy available by name to LookupStrategy and
+// NewNamedFormatter, so that applications can select their own formats from configuration.
+// Names are matched in any letter case, and the built-in formats are registered under their
+// own names, as "DMMSSs". Like sql.Register it panics when the name is empty or taken or the
+// strategy is nil, as registration belongs in an init function.
+func RegisterStrategy(name string, strategy FormatStrategy) {
+	key := strategyKey(name)
+	if key == "" {
+		panic("angles: RegisterStrategy with an empty name")
+	}
+	if strategy == nil {
+		panic(fmt.Sprintf("angles: RegisterStrategy of a nil strategy for '%s'", name))
+	}
+
+	strategies.Lock()
+	defer strategies.Unlock()
+	if _, taken := strategies.byName[key]; taken {
+		panic(fmt.Sprintf("angles: RegisterStrategy called twice for '%s'", name))
+	}
+	strategies.byName[key] = strategy
+}
+
+// LookupStrategy returns the strategy registered under a name, in any letter case
+func LookupStrategy(name string) (FormatStrategy, error) {
+	strategies.RLock()
+	defer strategies.RUnlock()
+	strategy, ok := strategies.byName[strategyKey(name)]
+	if !ok {
+		return nil, fmt.Errorf("unknown format strategy '%s'", name)
+	}
+	return strategy, nil
+}
+
+// Strategies returns the names of the registered strategies in lower case and sorted, for
+// listing the choices of a flag or a configuration setting
+func Strategies() []string {
+	strategies.RLock()
+	defer strategies.RUnlock()
+	names := make([]string, 0, len(strategies.byName))
+	for name := range strategies.byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewNamedFormatter creates a formatter with the strategy registered under a name
+func NewNamedFormatter(value AngleValue, name string) (*ExtensibleAngleFormatter, error) {
+	strategy, err := LookupStrategy(name)
+	if err != nil {
+		return nil, err
+	}
+	return NewExtensibleFormatter(value, strategy), nil
+}
@@ -0,0 +1,54 @@
+package angles
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// arcminuteStrategy formats angles as minutes of arc
+type arcminuteStrategy struct{}
+
+func (arcminuteStrategy) Format(value float64, precision int) string {
+	return fmt.Sprintf("%.*f'", precision, value*MinutesPerDegree)
+}
+
+var _ = Describe("Strategy registry", func() {
+	It("should find the built-in formats by name in any letter case", func() {
+		strategy, err := LookupStrategy(" dmmss ")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(strategy.Format(12.3456, 0)).To(Equal(NewFormatter(12.3456).Format(DMMSS).String()))
+
+		strategy, err = LookupStrategy("HMMSSs")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(strategy.Format(-188.73625, 1)).To(Equal("-12h 34m 56.7s"))
+	})
+
+	It("should register and look up a custom strategy", func() {
+		RegisterStrategy("Arcmin-Test", arcminuteStrategy{})
+		strategy, err := LookupStrategy("arcmin-test")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(strategy.Format(1.5, 1)).To(Equal("90.0'"))
+		Expect(Strategies()).To(ContainElements("arcmin-test", "dd", "mas"))
+	})
+
+	It("should create a formatter from a name", func() {
+		formatter, err := NewNamedFormatter(NewAngle(12.3456), "dd")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(formatter.WithPrecision(1).String()).To(Equal("12.3"))
+	})
+
+	It("should report unknown names", func() {
+		_, err := LookupStrategy("iau-unknown")
+		Expect(err).To(MatchError("unknown format strategy 'iau-unknown'"))
+		_, err = NewNamedFormatter(NewAngle(1), "iau-unknown")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should panic on names taken, empty names and nil strategies", func() {
+		Expect(func() { RegisterStrategy("DMM", arcminuteStrategy{}) }).To(Panic())
+		Expect(func() { RegisterStrategy("  ", arcminuteStrategy{}) }).To(Panic())
+		Expect(func() { RegisterStrategy("nil-test", nil) }).To(Panic())
+	})
+})
@@ -0,0 +1,263 @@
+package angles
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Sexagesimal is an angle or a time split into whole degrees or hours, minutes and seconds,
+// with the sign held apart from the components. Values under one degree keep their sign this
+// way, so -0.3456° is -0°20'44.16" rather than a minus sign partway through.
+type Sexagesimal struct {
+	Sign    int     // -1 for negative values; anything else, the zero value included, is positive
+	Degrees int     // whole degrees or hours
+	Minutes int     // whole minutes, 0 to 59
+	Seconds float64 // seconds, in [0, 60)
+}
+
+// NewSexagesimal splits degrees, or hours, into their components
+func NewSexagesimal(value float64) Sexagesimal {
+	s := Sexagesimal{Sign: 1}
+	if value < 0 {
+		s.Sign = -1
+	}
+	s.Degrees, s.Minutes, s.Seconds = SplitDMS(math.Abs(value))
+	return s
+}
+
+// Negative reports whether the value is below zero
+func (s Sexagesimal) Negative() bool {
+	return s.Sign < 0
+}
+
+// Value returns the value in degrees or hours
+func (s Sexagesimal) Value() float64 {
+	value := float64(s.Degrees) + float64(s.Minutes)/MinutesPerDegree + s.Seconds/SecondsPerDegree
+	if s.Negative() {
+		return -value
+	}
+	return value
+}
+
+// String returns the value as degrees, minutes and seconds in symbol notation
+func (s Sexagesimal) String() string {
+	var buf [32]byte
+	return string(s.AppendFormat(buf[:0], DMMSSs, -1))
+}
+
+// AppendFormat appends the value in a sexagesimal format in symbol notation, as degrees for
+// DMM to DMMSSs and as hours for HMM to HMMSSs; the formats without minutes give DMMSSs. The
+// last component is rounded to precision decimals and carried, and a negative precision
+// selects the format's usual one.
+func (s Sexagesimal) AppendFormat(dst []byte, format AngleFormat, precision int) []byte {
+	switch format {
+	case Hh:
+		format = HMMSSs
+	case Dd, Arcsec, Mas:
+		format = DMMSSs
+	}
+	if precision < 0 {
+		precision = format.symbolPrecision()
+	}
+	return roundSexagesimal(s.Value(), format, precision).appendTo(dst, format, precision, true, false, false)
+}
+
+// ParseSexagesimal parses degrees or hours with minutes and optional seconds as ParseAngle
+// reads them, keeping the components as written rather than passing them through a float64:
+// "-0 20 44.16", "-0°20'44.16\"", "73°59'W", "12h 34m 56.7s" and the "0 -20 44" of older
+// versions are all accepted.
+func ParseSexagesimal(input string) (Sexagesimal, error) {
+	originalInput := input
+	input = strings.TrimSpace(input)
+
+	var parts []string
+	negate := false
+	unitName := "hours"
+	if strings.ContainsAny(input, "hH") {
+		var err error
+		if parts, err = hourParts(input, originalInput); err != nil {
+			return Sexagesimal{}, err
+		}
+	} else {
+		var err error
+		if input, negate, err = trimHemisphere(input, originalInput); err != nil {
+			return Sexagesimal{}, err
+		}
+		parts, unitName = strings.Fields(sexagesimalSymbols.Replace(input)), "degrees"
+	}
+	if len(parts) < 2 || len(parts) > 3 {
+		return Sexagesimal{}, fmt.Errorf("invalid format: expected 2-3 sexagesimal components, got %d in input '%s'", len(parts), originalInput)
+	}
+
+	s, _, err := parseSexagesimalParts(parts, unitName, originalInput)
+	if negate {
+		s.Sign = -1
+	}
+	return s, err
+}
+
+// parseSexagesimalParts parses whole units and minutes and optional seconds, reporting whether
+// the last component has decimals. The sign is a minus on the units, or on the first non-zero
+// component as older versions wrote "0 -20 44".
+func parseSexagesimalParts(parts []string, unitName, originalInput string) (Sexagesimal, bool, error) {
+	units, err := parseIntegerComponent(parts[0], unitName, originalInput)
+	if err != nil {
+		return Sexagesimal{}, false, err
+	}
+
+	var minutes, seconds float64
+	decimal := len(parts) == 2 && strings.Contains(parts[1], ".")
+	if decimal {
+		if minutes, err = parseFloatComponent(parts[1], "minutes", originalInput); err != nil {
+			return Sexagesimal{}, false, err
+		}
+		if err := validateMinutesFloat(minutes, originalInput); err != nil {
+			return Sexagesimal{}, false, err
+		}
+	} else {
+		whole, err := parseIntegerComponent(parts[1], "minutes", originalInput)
+		if err != nil {
+			return Sexagesimal{}, false, err
+		}
+		if err := validateMinutesInt(whole, originalInput); err != nil {
+			return Sexagesimal{}, false, err
+		}
+		minutes = float64(whole)
+	}
+
+	if len(parts) == 3 {
+		decimal = strings.Contains(parts[2], ".")
+		if decimal {
+			seconds, err = parseFloatComponent(parts[2], "seconds", originalInput)
+		} else {
+			var whole int
+			whole, err = parseIntegerComponent(parts[2], "seconds", originalInput)
+			seconds = float64(whole)
+		}
+		if err != nil {
+			return Sexagesimal{}, false, err
+		}
+		if err := validateSecondsFloat(seconds, originalInput); err != nil {
+			return Sexagesimal{}, false, err
+		}
+	}
+
+	s := Sexagesimal{Sign: 1, Degrees: absInt(units), Minutes: int(math.Abs(minutes))}
+	s.Seconds = (math.Abs(minutes)-float64(s.Minutes))*SecondsPerMinute + math.Abs(seconds)
+	if strings.HasPrefix(parts[0], "-") || units < 0 || (units == 0 && (minutes < 0 || (minutes == 0 && seconds < 0))) {
+		s.Sign = -1
+	}
+	return s, decimal, nil
+}
+
+// roundSexagesimal splits degrees or hours for a format after bringing them to the last place
+// the format shows, so that a rounded field carries into the one before: whole minutes and
+// seconds are truncated and decimal ones rounded to precision places, which turns 29.99999°
+// into 30°00'00.0" rather than 29°59'60.0". A value that rounds to zero loses its sign.
+func roundSexagesimal(value float64, format AngleFormat, precision int) Sexagesimal {
+	ticksPerMinute, round := 0.0, false
+	switch format {
+	case DMM, HMM:
+		ticksPerMinute = 1
+	case DMMSS, HMMSS:
+		ticksPerMinute = SecondsPerMinute
+	case DMMm:
+		ticksPerMinute, round = math.Pow10(precision), true
+	case DMMSSs, HMMSSs:
+		ticksPerMinute, round = SecondsPerMinute*math.Pow10(precision), true
+	}
+	if ticksPerMinute == 0 || precision < 0 || precision > 9 {
+		return NewSexagesimal(value)
+	}
+
+	ticks := math.Abs(value) * MinutesPerDegree * ticksPerMinute
+	if round {
+		ticks = math.Round(ticks)
+	} else {
+		ticks = math.Floor(ticks)
+	}
+	totalMinutes := math.Floor(ticks / ticksPerMinute)
+	s := Sexagesimal{Sign: 1, Degrees: int(totalMinutes / MinutesPerDegree)}
+	s.Minutes = int(totalMinutes) - s.Degrees*int(MinutesPerDegree)
+	s.Seconds = (ticks - totalMinutes*ticksPerMinute) * SecondsPerMinute / ticksPerMinute
+	if value < 0 && ticks > 0 {
+		s.Sign = -1
+	}
+	return s
+}
+
+// appendTo appends the components in a sexagesimal format without rounding them. Symbol
+// notation marks degrees as 12°20'44.16" and hours compactly as 12h04m5.000s, with two-digit
+// minutes; otherwise degrees are spaced as "12 20 44.16" and hours as "12h 4m 5.00s". With
+// zeroPad every field has at least two digits before any decimals, as "05 03 07.25", and with
+// sign a '+' marks values that are not negative.
+func (s Sexagesimal) appendTo(dst []byte, format AngleFormat, precision int, useSymbols, zeroPad, sign bool) []byte {
+	hours := format.isHours()
+	unit, minute, second := "°", "'", "\""
+	if hours {
+		unit, minute, second = "h", "m", "s"
+	}
+	mark := func(dst []byte, symbol string, last bool) []byte {
+		if useSymbols || hours {
+			dst = append(dst, symbol...)
+		}
+		if !useSymbols && !last {
+			dst = append(dst, ' ')
+		}
+		return dst
+	}
+	field := func(dst []byte, n int) []byte {
+		if useSymbols || zeroPad {
+			return appendTwoDigits(dst, n)
+		}
+		return strconv.AppendInt(dst, int64(n), 10)
+	}
+
+	if s.Negative() {
+		dst = append(dst, '-')
+	} else if sign {
+		dst = append(dst, '+')
+	}
+	if zeroPad {
+		dst = appendTwoDigits(dst, s.Degrees)
+	} else {
+		dst = strconv.AppendInt(dst, int64(s.Degrees), 10)
+	}
+	dst = mark(dst, unit, false)
+	switch format {
+	case DMMm:
+		dst = appendFloat(dst, float64(s.Minutes)+s.Seconds/SecondsPerMinute, precision, zeroPad)
+		return mark(dst, minute, true)
+	case DMM, HMM:
+		dst = field(dst, s.Minutes)
+		return mark(dst, minute, true)
+	}
+	dst = field(dst, s.Minutes)
+	dst = mark(dst, minute, false)
+	if format == DMMSS || format == HMMSS {
+		dst = field(dst, int(s.Seconds))
+	} else {
+		dst = appendFloat(dst, s.Seconds, precision, zeroPad)
+	}
+	return mark(dst, second, true)
+}
+
+// hourParts splits hours with unit letters, as in "12h 34m 56.7s" or "12h34m56.7s", into
+// their numbers
+func hourParts(input, originalInput string) ([]string, error) {
+	spaced := strings.NewReplacer("h", "h ", "H", "h ", "m", "m ", "M", "m ", "S", "s").Replace(input)
+	parts := strings.Fields(spaced)
+	units := "hms"
+	if len(parts) > len(units) {
+		return nil, fmt.Errorf("invalid format: expected 1-3 hour components, got %d in input '%s'", len(parts), originalInput)
+	}
+	for i, part := range parts {
+		if !strings.HasSuffix(part, units[i:i+1]) || len(part) == 1 {
+			return nil, fmt.Errorf("invalid hour component '%s': expected a number followed by '%c' in '%s'", part, units[i], originalInput)
+		}
+		parts[i] = strings.TrimSuffix(part, units[i:i+1])
+	}
+	return parts, nil
+}
@@ -0,0 +1,62 @@
+package angles
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sexagesimal", func() {
+	It("should hold the sign apart from the components", func() {
+		s := NewSexagesimal(-0.3456)
+		Expect(s.Negative()).To(BeTrue())
+		Expect([]int{s.Degrees, s.Minutes}).To(Equal([]int{0, 20}))
+		Expect(s.Seconds).To(BeNumerically("~", 44.16, 1e-9))
+		Expect(s.Value()).To(BeNumerically("~", -0.3456, 1e-12))
+		Expect(s.String()).To(Equal("-0°20'44.160\""))
+
+		Expect(NewSexagesimal(12.5).Negative()).To(BeFalse())
+		Expect(Sexagesimal{}.String()).To(Equal("0°00'0.000\""))
+	})
+
+	DescribeTable("AppendFormat",
+		func(s Sexagesimal, format AngleFormat, precision int, expected string) {
+			Expect(string(s.AppendFormat(nil, format, precision))).To(Equal(expected))
+		},
+		Entry("DMM", Sexagesimal{Sign: -1, Minutes: 20, Seconds: 44.16}, DMM, -1, "-0°20'"),
+		Entry("DMMm", Sexagesimal{Sign: -1, Minutes: 20, Seconds: 44.16}, DMMm, 2, "-0°20.74'"),
+		Entry("HMMSSs", Sexagesimal{Degrees: 12, Minutes: 4, Seconds: 5}, HMMSSs, -1, "12h04m5.000s"),
+		Entry("HMM", Sexagesimal{Sign: -1, Minutes: 30}, HMM, -1, "-0h30m"),
+		Entry("decimal degrees as DMMSSs", Sexagesimal{Degrees: 1, Minutes: 2, Seconds: 3}, Dd, 0, "1°02'3\""),
+		Entry("carrying", Sexagesimal{Degrees: 29, Minutes: 59, Seconds: 59.99}, DMMSSs, 1, "30°00'0.0\""),
+		Entry("rounding to zero", Sexagesimal{Sign: -1, Seconds: 0.0001}, DMMSSs, 2, "0°00'0.00\""),
+	)
+
+	DescribeTable("ParseSexagesimal",
+		func(input string, expected Sexagesimal) {
+			Expect(ParseSexagesimal(input)).To(Equal(expected))
+		},
+		Entry("plain", "-0 20 44.16", Sexagesimal{Sign: -1, Minutes: 20, Seconds: 44.16}),
+		Entry("older sign placement", "0 -20 44.16", Sexagesimal{Sign: -1, Minutes: 20, Seconds: 44.16}),
+		Entry("symbols", "12°34'56.7\"", Sexagesimal{Sign: 1, Degrees: 12, Minutes: 34, Seconds: 56.7}),
+		Entry("hemisphere", "73°59'W", Sexagesimal{Sign: -1, Degrees: 73, Minutes: 59}),
+		Entry("decimal minutes", "-0 20.5", Sexagesimal{Sign: -1, Minutes: 20, Seconds: 30}),
+		Entry("hours", "12h 34m 56.7s", Sexagesimal{Sign: 1, Degrees: 12, Minutes: 34, Seconds: 56.7}),
+		Entry("negative hours", "-0h30m", Sexagesimal{Sign: -1, Minutes: 30}),
+	)
+
+	It("should reject values that are not sexagesimal", func() {
+		for _, input := range []string{"12.5", "12 60", "1 2 3 4", "12h", "12 20 abc"} {
+			_, err := ParseSexagesimal(input)
+			Expect(err).To(HaveOccurred(), input)
+		}
+	})
+
+	It("should give ParseAngle a negative zero in front", func() {
+		angle, err := ParseAngle("-0 20")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(angle.Degrees()).To(BeNumerically("~", -1.0/3, 1e-12))
+		angle, err = ParseAngle("-0 20.5")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(angle.Degrees()).To(BeNumerically("~", -20.5/60, 1e-12))
+	})
+})
@@ -0,0 +1,33 @@
+package angles
+
+import (
+	"math"
+)
+
+// AngularSeparation returns the angle in degrees between two directions given by right
+// ascension and declination, or any longitude and latitude, in degrees. It uses the Vincenty
+// formula, which unlike the cosine rule keeps full precision for nearly coincident and nearly
+// opposite directions.
+func AngularSeparation(ra1, dec1, ra2, dec2 float64) float64 {
+	sinDelta, cosDelta := math.Sincos(DegreesToRadians(ra2 - ra1))
+	sinDec1, cosDec1 := math.Sincos(DegreesToRadians(dec1))
+	sinDec2, cosDec2 := math.Sincos(DegreesToRadians(dec2))
+
+	x := cosDec2 * sinDelta
+	y := cosDec1*sinDec2 - sinDec1*cosDec2*cosDelta
+	z := sinDec1*sinDec2 + cosDec1*cosDec2*cosDelta
+	return RadiansToDegrees(math.Atan2(math.Hypot(x, y), z))
+}
+
+// PositionAngle returns the direction in degrees of the second position seen from the first,
+// measured from north through east in [0, 360), as for the companion of a double star. It is
+// 0 for coincident positions and undefined, though finite, from a pole.
+func PositionAngle(ra1, dec1, ra2, dec2 float64) float64 {
+	sinDelta, cosDelta := math.Sincos(DegreesToRadians(ra2 - ra1))
+	sinDec1, cosDec1 := math.Sincos(DegreesToRadians(dec1))
+	sinDec2, cosDec2 := math.Sincos(DegreesToRadians(dec2))
+
+	x := cosDec2 * sinDelta
+	y := cosDec1*sinDec2 - sinDec1*cosDec2*cosDelta
+	return NormalizeDegrees(RadiansToDegrees(math.Atan2(x, y)))
+}
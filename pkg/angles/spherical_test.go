@@ -0,0 +1,45 @@
+package angles
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Spherical angles", func() {
+	It("should find the separation of Arcturus and Spica (Meeus 17.a)", func() {
+		Expect(AngularSeparation(213.9154, 19.1825, 201.2983, -11.1614)).To(BeNumerically("~", 32.7930, 1e-4))
+	})
+
+	It("should keep precision near 0° and 180°", func() {
+		arcsec := 1.0 / 3600
+		Expect(AngularSeparation(10, 20, 10+1e-6*arcsec/math.Cos(20*math.Pi/180), 20)).To(
+			BeNumerically("~", 1e-6*arcsec, 1e-15))
+		Expect(AngularSeparation(0, 0, 0, 1e-9)).To(BeNumerically("~", 1e-9, 1e-18))
+		Expect(AngularSeparation(0, 0, 180, 1e-9)).To(BeNumerically("~", 180-1e-9, 1e-12))
+		Expect(AngularSeparation(45, 30, 225, -30)).To(BeNumerically("~", 180, 1e-12))
+		Expect(AngularSeparation(0, 90, 123, 90)).To(BeNumerically("~", 0, 1e-12))
+	})
+
+	It("should be symmetric and ignore whole turns of right ascension", func() {
+		Expect(AngularSeparation(350, 10, 10, -5)).To(BeNumerically("~", AngularSeparation(10, -5, 350, 10), 1e-12))
+		Expect(AngularSeparation(350, 10, 10, -5)).To(BeNumerically("~", AngularSeparation(-10, 10, 370, -5), 1e-12))
+	})
+
+	DescribeTable("should measure position angles from north through east",
+		func(ra2, dec2, expected float64) {
+			Expect(PositionAngle(100, 20, ra2, dec2)).To(BeNumerically("~", expected, 1e-4))
+		},
+		Entry("north", 100.0, 21.0, 0.0),
+		Entry("east", 100.1, 20.0, 90-0.0171),
+		Entry("south", 100.0, 19.0, 180.0),
+		Entry("west", 99.9, 20.0, 270+0.0171),
+	)
+
+	It("should approach the plane bearing for small offsets", func() {
+		Expect(PositionAngle(0, 0, 1e-4, 1e-4)).To(BeNumerically("~", 45, 1e-6))
+		Expect(PositionAngle(0, 0, -1e-4, -1e-4)).To(BeNumerically("~", 225, 1e-6))
+		Expect(PositionAngle(0, 0, 0, 0)).To(Equal(0.0))
+	})
+})
@@ -0,0 +1,49 @@
+package angles
+
+import "text/template"
+
+// FuncMap returns template functions that format angles, for reports built with text/template
+// or, converted to html/template.FuncMap, with html/template:
+//
+//	formatAngle DEGREES FORMAT [PRECISION]  in a format named as ParseAngleFormat reads it: {{formatAngle .Dec "DMMSS"}}
+//	formatRA DEGREES [PRECISION]            a right ascension in hours, minutes and seconds, in [0h, 24h)
+//	formatTime HOURS [PRECISION]            hours, such as a sidereal time or hour angle, in hours, minutes and seconds
+//
+// Angles are written in symbol notation as Angle.String writes them; a precision sets the
+// decimals of the last component.
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"formatAngle": formatAngleFunc,
+		"formatRA":    formatRAFunc,
+		"formatTime":  formatTimeFunc,
+	}
+}
+
+// formatAngleFunc formats degrees in a named format for templates
+func formatAngleFunc(degrees float64, format string, precision ...int) (string, error) {
+	af, err := ParseAngleFormat(format)
+	if err != nil {
+		return "", err
+	}
+	return formatAngle(degrees, af, templatePrecision(precision), 0, true), nil
+}
+
+// formatRAFunc formats a right ascension in degrees as hours for templates
+func formatRAFunc(degrees float64, precision ...int) string {
+	var buf [32]byte
+	return string(appendRightAscension(buf[:0], degrees, templatePrecision(precision)))
+}
+
+// formatTimeFunc formats hours for templates
+func formatTimeFunc(hours float64, precision ...int) string {
+	return formatAngle(hours*DegreesPerHour, HMMSSs, templatePrecision(precision), 0, true)
+}
+
+// templatePrecision returns the precision a template function was given, or -1 for the
+// format's usual one
+func templatePrecision(precision []int) int {
+	if len(precision) == 0 {
+		return -1
+	}
+	return precision[0]
+}
@@ -0,0 +1,59 @@
+package angles
+
+import (
+	"bytes"
+	"errors"
+	htmltemplate "html/template"
+	"strings"
+	"text/template"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// failingWriter rejects every write
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("disk full") }
+
+var _ = Describe("Template integration", func() {
+	It("should write the formatter's text", func() {
+		var sb strings.Builder
+		formatter := NewFormatter(-0.3456, WithFormat(DMMSSs), WithPrecision(2))
+		n, err := formatter.WriteTo(&sb)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sb.String()).To(Equal(formatter.String()))
+		Expect(n).To(Equal(int64(len(formatter.String()))))
+
+		_, err = formatter.WriteTo(failingWriter{})
+		Expect(err).To(MatchError("disk full"))
+	})
+
+	It("should format coordinates inside text templates", func() {
+		tmpl := template.Must(template.New("report").Funcs(FuncMap()).Parse(
+			`{{formatRA .RA}} {{formatAngle .Dec "DMMSS"}} {{formatAngle .Dec "dmmssS" 1}} {{formatTime .LST 0}} {{formatRA -15 0}}`))
+		var buf bytes.Buffer
+		data := struct{ RA, Dec, LST float64 }{RA: 188.73625, Dec: -0.3456, LST: 6.5}
+		Expect(tmpl.Execute(&buf, data)).To(Succeed())
+		Expect(buf.String()).To(Equal(`12h34m56.700s -0°20'44" -0°20'44.2" 6h30m0s 23h00m0s`))
+	})
+
+	It("should wrap right ascensions that round to 24h in templates", func() {
+		tmpl := template.Must(template.New("report").Funcs(FuncMap()).Parse(`{{formatRA -0.000001}} {{formatRA 359.9999999 1}}`))
+		var buf bytes.Buffer
+		Expect(tmpl.Execute(&buf, nil)).To(Succeed())
+		Expect(buf.String()).To(Equal("0h00m0.000s 0h00m0.0s"))
+	})
+
+	It("should report unknown formats", func() {
+		tmpl := template.Must(template.New("report").Funcs(FuncMap()).Parse(`{{formatAngle 10 "furlongs"}}`))
+		Expect(tmpl.Execute(&bytes.Buffer{}, nil)).To(MatchError(ContainSubstring("unknown angle format")))
+	})
+
+	It("should work with html/template", func() {
+		tmpl := htmltemplate.Must(htmltemplate.New("report").Funcs(htmltemplate.FuncMap(FuncMap())).Parse(`<td>{{formatAngle . "DMM"}}</td>`))
+		var buf bytes.Buffer
+		Expect(tmpl.Execute(&buf, 45.5)).To(Succeed())
+		Expect(buf.String()).To(Equal("<td>45°30&#39;</td>"))
+	})
+})
@@ -0,0 +1,186 @@
+package astrometry
+
+import (
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/catalog"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/geodesy"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/planets"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	"github.com/ocrosby/astronomy/pkg/vectors"
+	"math"
+	"time"
+)
+
+// The reduction lives outside the coordinates package because it needs the Earth's orbit and
+// sidereal time, both of which are built on coordinates. Earth positions come from the mean
+// planetary orbits, so annual parallax and aberration are good to about 0.01".
+
+// Physical constants of the reduction
+const (
+	ParsecAU          = 206264.806247                                 // astronomical units per parsec
+	SchwarzschildSun  = 1.97412574336e-8                              // Schwarzschild radius of the Sun in AU
+	LightDayAU        = constants.SpeedOfLight * 86400 / constants.AU // speed of light in AU per day
+	kmPerSecondAUYear = 365.25 * 86400 / constants.AU                 // AU per Julian year for 1 km/s
+	minimumParallax   = 1e-7                                          // parallax in mas assumed when none is given
+	velocityStep      = 0.05                                          // half-width in days of the Earth velocity difference
+)
+
+// CatalogEntry is an astrometric catalog position with its space motion, in the units of
+// catalog.Star
+type CatalogEntry struct {
+	RA             float64 // right ascension in degrees (ICRS/J2000)
+	Dec            float64 // declination in degrees (ICRS/J2000)
+	PMRA           float64 // proper motion in RA including cos(Dec), mas/yr
+	PMDec          float64 // proper motion in Dec, mas/yr
+	Parallax       float64 // parallax in mas
+	RadialVelocity float64 // radial velocity in km/s
+	Epoch          float64 // Julian epoch of the position
+}
+
+// FromStar returns the catalog entry for a star
+func FromStar(s catalog.Star) CatalogEntry {
+	return CatalogEntry{RA: s.RA, Dec: s.Dec, PMRA: s.PMRA, PMDec: s.PMDec, Parallax: s.Parallax,
+		RadialVelocity: s.RadialVelocity, Epoch: s.Epoch}
+}
+
+// Place holds every stage of the reduction from catalog to observed position, in the order the
+// corrections are applied, so each step can be inspected
+type Place struct {
+	Barycentric       coordinates.Equatorial // space motion applied, seen from the barycenter
+	Astrometric       coordinates.Equatorial // annual parallax applied, seen from the Earth
+	Deflected         coordinates.Equatorial // gravitational light deflection by the Sun applied
+	Proper            coordinates.Equatorial // annual aberration applied (GCRS direction)
+	Mean              coordinates.Equatorial // precessed to the mean equator and equinox of date
+	Apparent          coordinates.Equatorial // nutation applied: true equator and equinox of date
+	Topocentric       coordinates.Equatorial // diurnal parallax applied for the observer
+	LocalSiderealTime float64                // local apparent sidereal time in degrees
+	HourAngle         float64                // local hour angle in degrees
+	Horizontal        coordinates.Horizontal // airless horizontal position
+	Refraction        float64                // refraction added to the altitude in degrees
+	Observed          coordinates.Horizontal // refracted horizontal position
+}
+
+// ApparentPlace reduces a catalog entry to the position observed at t (UTC) from obs, applying
+// proper motion, annual parallax, light deflection, aberration, precession, nutation, diurnal
+// parallax and refraction by the observer's model and atmosphere in turn. The Classical model is used
+// unless WithModel selects another.
+func ApparentPlace(entry CatalogEntry, t time.Time, obs observer.Observer, options ...coordinates.ModelOption) Place {
+	m := coordinates.ModelOf(options)
+	jd := astrotime.TT(t)
+	centuries := julian.Centuries(jd)
+	var place Place
+
+	star := entry.positionAt(julian.Epoch(jd))
+	place.Barycentric = j2000(star)
+
+	earth := earthPosition(jd)
+	geocentric := star.Subtract(earth)
+	direction := geocentric.Normalize()
+	place.Astrometric = j2000(direction)
+
+	direction = deflect(direction, earth)
+	place.Deflected = j2000(direction)
+
+	direction = aberrate(direction, jd)
+	place.Proper = j2000(direction)
+
+	place.Mean = coordinates.Precess(place.Proper, 0, centuries, options...)
+	place.Apparent = nutate(place.Mean, centuries, m)
+
+	place.LocalSiderealTime = sidereal.LocalApparentSiderealTime(julian.FromTime(t), obs.Longitude, options...)
+	place.Topocentric = topocentric(place.Apparent, geocentric.Magnitude(), obs, place.LocalSiderealTime)
+	place.HourAngle = angles.NormalizeDegrees(place.LocalSiderealTime - place.Topocentric.RA)
+
+	place.Horizontal = place.Topocentric.ToHorizontal(obs.Latitude, place.LocalSiderealTime)
+	place.Refraction = obs.RefractionAt(place.Horizontal.Altitude)
+	place.Observed = coordinates.Horizontal{
+		Azimuth:  place.Horizontal.Azimuth,
+		Altitude: place.Horizontal.Altitude + place.Refraction,
+	}
+	return place
+}
+
+// j2000 returns the direction of a vector labelled with the J2000 equator and equinox
+func j2000(v vectors.Vector3D) coordinates.Equatorial {
+	e := coordinates.EquatorialFromVector(v)
+	e.Epoch = coordinates.J2000
+	return e
+}
+
+// distance returns the distance of the entry from the barycenter in AU
+func (e CatalogEntry) distance() float64 {
+	return ParsecAU * 1000 / math.Max(e.Parallax, minimumParallax)
+}
+
+// positionAt returns the barycentric position in AU at a Julian epoch, moving the star linearly
+// along its space motion
+func (e CatalogEntry) positionAt(epoch float64) vectors.Vector3D {
+	r := e.distance()
+	sinRA, cosRA := math.Sincos(e.RA * constants.Rad)
+	sinDec, cosDec := math.Sincos(e.Dec * constants.Rad)
+	east := vectors.Vector3D{X: -sinRA, Y: cosRA}
+	north := vectors.Vector3D{X: -sinDec * cosRA, Y: -sinDec * sinRA, Z: cosDec}
+	radial := vectors.Vector3D{X: cosDec * cosRA, Y: cosDec * sinRA, Z: sinDec}
+
+	masPerYear := constants.Rad / 3600000
+	velocity := east.ScalarMultiply(e.PMRA * masPerYear * r).
+		Add(north.ScalarMultiply(e.PMDec * masPerYear * r)).
+		Add(radial.ScalarMultiply(e.RadialVelocity * kmPerSecondAUYear))
+	return radial.ScalarMultiply(r).Add(velocity.ScalarMultiply(epoch - e.Epoch))
+}
+
+// earthPosition returns the heliocentric position of the Earth in AU in J2000 equatorial axes
+// for a Julian date in TT
+func earthPosition(jd float64) vectors.Vector3D {
+	ecliptic := planets.Heliocentric(planets.Earth, julian.Centuries(jd))
+	return vectors.Rotate3Dx(ecliptic, astrotime.ObliquityJ2000*constants.Rad)
+}
+
+// earthVelocity returns the heliocentric velocity of the Earth in AU per day
+func earthVelocity(jd float64) vectors.Vector3D {
+	return earthPosition(jd + velocityStep).Subtract(earthPosition(jd - velocityStep)).ScalarMultiply(1 / (2 * velocityStep))
+}
+
+// deflect bends a unit direction away from the Sun for an observer at earth (SOFA iauLd)
+func deflect(p, earth vectors.Vector3D) vectors.Vector3D {
+	distance := earth.Magnitude()
+	e := earth.ScalarMultiply(1 / distance)
+	limit := 1e-6 / math.Max(distance*distance, 1)
+	w := SchwarzschildSun / distance / math.Max(p.DotProduct(p.Add(e)), limit)
+	return p.Add(p.CrossProduct(e.CrossProduct(p)).ScalarMultiply(w)).Normalize()
+}
+
+// aberrate applies annual aberration to a unit direction for a Julian date in TT
+func aberrate(p vectors.Vector3D, jd float64) vectors.Vector3D {
+	return p.Add(earthVelocity(jd).ScalarMultiply(1 / LightDayAU)).Normalize()
+}
+
+// nutate moves a mean position of date to the true equator and equinox of date
+func nutate(mean coordinates.Equatorial, t float64, m coordinates.Model) coordinates.Equatorial {
+	obliquity := m.MeanObliquity(t)
+	longitude, deltaObliquity := m.Nutation(t)
+	ecliptic := mean.ToEcliptic(obliquity)
+	ecliptic.Longitude += longitude
+	return ecliptic.ToEquatorial(obliquity + deltaObliquity)
+}
+
+// topocentric shifts a geocentric apparent position at a distance in AU to the observer's
+// location on the WGS84 ellipsoid
+func topocentric(apparent coordinates.Equatorial, distance float64, obs observer.Observer, lst float64) coordinates.Equatorial {
+	sinLat, cosLat := math.Sincos(obs.Latitude * constants.Rad)
+	c := 1 / math.Sqrt(cosLat*cosLat+(1-geodesy.Flattening)*(1-geodesy.Flattening)*sinLat*sinLat)
+	s := (1 - geodesy.Flattening) * (1 - geodesy.Flattening) * c
+	sinLST, cosLST := math.Sincos(lst * constants.Rad)
+	metersToAU := 1 / (constants.AU * 1000)
+	site := vectors.Vector3D{
+		X: (geodesy.SemiMajorAxis*c + obs.Elevation) * cosLat * cosLST * metersToAU,
+		Y: (geodesy.SemiMajorAxis*c + obs.Elevation) * cosLat * sinLST * metersToAU,
+		Z: (geodesy.SemiMajorAxis*s + obs.Elevation) * sinLat * metersToAU,
+	}
+	return coordinates.EquatorialFromVector(apparent.Vector().ScalarMultiply(distance).Subtract(site))
+}
@@ -0,0 +1,13 @@
+package astrometry_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAstrometry(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Astrometry Suite")
+}
@@ -0,0 +1,73 @@
+package astrometry
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/catalog"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ApparentPlace", func() {
+	// Meeus example 23.a: θ Persei on 2028 November 13.19 TD
+	thetaPersei := CatalogEntry{
+		RA:    41.0499417,
+		Dec:   49.2284667,
+		PMRA:  0.03425 * 15000 * 0.65295,
+		PMDec: -89.5,
+		Epoch: 2000,
+	}
+	t := julian.ToTime(2462088.69).Add(-69184 * time.Millisecond)
+	arcsecond := 1.0 / 3600
+
+	It("should match the apparent place of Meeus example 23.a", func() {
+		place := ApparentPlace(thetaPersei, t, observer.Observer{Latitude: 40, Longitude: -75})
+		Expect(place.Apparent.RA).To(BeNumerically("~", 41.5599625, 0.5*arcsecond))
+		Expect(place.Apparent.Dec).To(BeNumerically("~", 49.3520694, 0.5*arcsecond))
+	})
+
+	It("should agree between precession-nutation models", func() {
+		obs := observer.Observer{Latitude: 40, Longitude: -75}
+		classical := ApparentPlace(thetaPersei, t, obs)
+		modern := ApparentPlace(thetaPersei, t, obs, coordinates.WithModel(coordinates.IAU2006))
+		Expect(coordinates.Separation(classical.Apparent, modern.Apparent)).To(BeNumerically("<", 0.5*arcsecond))
+	})
+
+	It("should apply each stage in turn", func() {
+		place := ApparentPlace(thetaPersei, t, observer.Observer{Latitude: 40, Longitude: -75})
+		Expect(coordinates.Separation(place.Barycentric, place.Astrometric)).To(BeNumerically("<", 1e-6))
+		Expect(coordinates.Separation(place.Deflected, place.Proper)).To(BeNumerically("<", 21*arcsecond))
+		Expect(coordinates.Separation(place.Proper, place.Mean)).To(BeNumerically(">", 0.3))
+		Expect(coordinates.Separation(place.Apparent, place.Topocentric)).To(BeNumerically("<", 1e-9))
+		Expect(place.Observed.Altitude - place.Horizontal.Altitude).To(BeNumerically("~", place.Refraction, 1e-12))
+		Expect(place.Horizontal.ToEquatorial(40, place.LocalSiderealTime).RA).To(BeNumerically("~", place.Topocentric.RA, 1e-9))
+	})
+
+	It("should refract with the observer's model", func() {
+		airless := ApparentPlace(thetaPersei, t, observer.Observer{Latitude: 40, Longitude: -75,
+			Refraction: coordinates.NoRefraction{}})
+		Expect(airless.Observed).To(Equal(airless.Horizontal))
+		radio := ApparentPlace(thetaPersei, t, observer.NewObserver(40, -75,
+			observer.WithRefraction(coordinates.Radio{Humidity: 0.8})))
+		Expect(radio.Refraction).To(BeNumerically(">", airless.Refraction))
+		Expect(AstrometricFromObserved(radio.Observed, t, observer.NewObserver(40, -75,
+			observer.WithRefraction(coordinates.Radio{Humidity: 0.8})))).NotTo(BeZero())
+	})
+
+	It("should show annual parallax for a nearby star", func() {
+		entry := CatalogEntry{RA: 217.42894, Dec: -62.67949, Parallax: 768.0665, Epoch: 2000}
+		place := ApparentPlace(entry, time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC), observer.Observer{})
+		shift := coordinates.Separation(place.Barycentric, place.Astrometric) * 3600
+		Expect(shift).To(BeNumerically(">", 0.1))
+		Expect(shift).To(BeNumerically("<", 0.77))
+	})
+
+	It("should convert catalog stars", func() {
+		star := catalog.Star{RA: 10, Dec: 20, PMRA: 1, PMDec: 2, Parallax: 3, RadialVelocity: 4, Epoch: 2016}
+		Expect(FromStar(star)).To(Equal(CatalogEntry{RA: 10, Dec: 20, PMRA: 1, PMDec: 2, Parallax: 3,
+			RadialVelocity: 4, Epoch: 2016}))
+	})
+})
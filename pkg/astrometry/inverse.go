@@ -0,0 +1,57 @@
+package astrometry
+
+import (
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	"time"
+)
+
+// Iteration limits for inverting the aberration and light deflection
+const (
+	inverseTolerance  = 1e-13 // change in the unit direction at convergence
+	inverseIterations = 10
+)
+
+// AstrometricFromApparent reduces an apparent position (true equator and equinox of date) at t
+// back to the geocentric astrometric J2000 direction. Nutation and precession are undone
+// exactly; aberration and light deflection are inverted iteratively.
+func AstrometricFromApparent(apparent coordinates.Equatorial, t time.Time, options ...coordinates.ModelOption) coordinates.Equatorial {
+	m := coordinates.ModelOf(options)
+	jd := astrotime.TT(t)
+	centuries := julian.Centuries(jd)
+
+	mean := unnutate(apparent, centuries, m)
+	proper := coordinates.Precess(mean, centuries, 0, options...).Vector()
+
+	earth := earthPosition(jd)
+	guess := proper
+	for i := 0; i < inverseIterations; i++ {
+		residual := proper.Subtract(aberrate(deflect(guess, earth), jd))
+		guess = guess.Add(residual).Normalize()
+		if residual.Magnitude() < inverseTolerance {
+			break
+		}
+	}
+	return coordinates.EquatorialFromVector(guess)
+}
+
+// AstrometricFromObserved reduces a refracted horizontal position seen from obs at t back to
+// the geocentric astrometric J2000 direction. Diurnal parallax is neglected, so the result
+// suits stars and other distant targets used to calibrate instruments.
+func AstrometricFromObserved(observed coordinates.Horizontal, t time.Time, obs observer.Observer, options ...coordinates.ModelOption) coordinates.Equatorial {
+	airless := coordinates.Horizontal{Azimuth: observed.Azimuth, Altitude: obs.TrueAltitude(observed.Altitude)}
+	lst := sidereal.LocalApparentSiderealTime(julian.FromTime(t), obs.Longitude, options...)
+	return AstrometricFromApparent(airless.ToEquatorial(obs.Latitude, lst), t, options...)
+}
+
+// unnutate moves a position on the true equator and equinox of date to the mean equator
+func unnutate(apparent coordinates.Equatorial, t float64, m coordinates.Model) coordinates.Equatorial {
+	obliquity := m.MeanObliquity(t)
+	longitude, deltaObliquity := m.Nutation(t)
+	ecliptic := apparent.ToEcliptic(obliquity + deltaObliquity)
+	ecliptic.Longitude -= longitude
+	return ecliptic.ToEquatorial(obliquity)
+}
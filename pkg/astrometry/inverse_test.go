@@ -0,0 +1,33 @@
+package astrometry
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Inverse reduction", func() {
+	vega := CatalogEntry{RA: 279.2347, Dec: 38.7837, Epoch: 2000}
+	t := time.Date(2024, 8, 15, 3, 0, 0, 0, time.UTC)
+	obs := observer.Observer{Latitude: 40, Longitude: -75}
+
+	DescribeTable("should recover the astrometric position from the apparent place",
+		func(model coordinates.Model) {
+			place := ApparentPlace(vega, t, obs, coordinates.WithModel(model))
+			astrometric := AstrometricFromApparent(place.Apparent, t, coordinates.WithModel(model))
+			Expect(coordinates.Separation(astrometric, place.Astrometric)).To(BeNumerically("<", 1e-9))
+		},
+		Entry("classical model", coordinates.Classical),
+		Entry("IAU 2006 model", coordinates.IAU2006),
+	)
+
+	It("should recover the astrometric position from the observed alt/az", func() {
+		place := ApparentPlace(vega, t, obs)
+		Expect(place.Observed.Altitude).To(BeNumerically(">", 10))
+		astrometric := AstrometricFromObserved(place.Observed, t, obs)
+		Expect(coordinates.Separation(astrometric, place.Astrometric)).To(BeNumerically("<", 1e-8))
+	})
+})
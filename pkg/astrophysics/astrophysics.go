@@ -0,0 +1,41 @@
+package astrophysics
+
+import (
+	"math"
+)
+
+// FluidRocheCoefficient is the Roche limit in primary radii per cube root of the density
+// ratio for a fluid satellite deformed by tides (the rigid case uses 2^(1/3))
+const FluidRocheCoefficient = 2.44
+
+// The helpers below accept any consistent units: masses in the same unit, distances and radii
+// in the same unit, and results in that distance unit.
+
+// RocheLimit returns the distance from a primary inside which a rigid satellite would be
+// torn apart by tides, from the primary's radius and mass and the satellite's radius and mass
+func RocheLimit(primaryRadius, primaryMass, satelliteRadius, satelliteMass float64) float64 {
+	return primaryRadius * math.Cbrt(2*densityRatio(primaryRadius, primaryMass, satelliteRadius, satelliteMass))
+}
+
+// FluidRocheLimit returns the Roche limit for a fluid satellite that deforms under tides
+func FluidRocheLimit(primaryRadius, primaryMass, satelliteRadius, satelliteMass float64) float64 {
+	return FluidRocheCoefficient * primaryRadius * math.Cbrt(densityRatio(primaryRadius, primaryMass, satelliteRadius, satelliteMass))
+}
+
+// HillRadius returns the radius of the Hill sphere of a body of mass m orbiting a primary of
+// mass primaryMass with semi-major axis a and eccentricity e, evaluated at periapsis
+func HillRadius(a, e, m, primaryMass float64) float64 {
+	return a * (1 - e) * math.Cbrt(m/(3*primaryMass))
+}
+
+// SOIRadius returns the radius of the Laplace sphere of influence of a body of mass m orbiting
+// a primary of mass primaryMass at distance a, as used for patched-conic mission design
+func SOIRadius(a, m, primaryMass float64) float64 {
+	return a * math.Pow(m/primaryMass, 0.4)
+}
+
+// densityRatio returns the ratio of the primary's mean density to the satellite's
+func densityRatio(primaryRadius, primaryMass, satelliteRadius, satelliteMass float64) float64 {
+	ratio := satelliteRadius / primaryRadius
+	return primaryMass / satelliteMass * ratio * ratio * ratio
+}
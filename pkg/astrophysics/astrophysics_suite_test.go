@@ -0,0 +1,13 @@
+package astrophysics_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAstrophysics(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Astrophysics Suite")
+}
@@ -0,0 +1,57 @@
+package astrophysics
+
+import (
+	"github.com/ocrosby/astronomy/pkg/constants"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Astrophysics", func() {
+	Describe("Roche limit", func() {
+		It("should compute the Earth-Moon limits", func() {
+			rigid := RocheLimit(constants.EarthRadius, constants.EarthMass, constants.MoonRadius, constants.MoonMass)
+			fluid := FluidRocheLimit(constants.EarthRadius, constants.EarthMass, constants.MoonRadius, constants.MoonMass)
+			Expect(rigid).To(BeNumerically("~", 9500, 100))
+			Expect(fluid).To(BeNumerically("~", 18400, 200))
+		})
+
+		It("should equal 2^(1/3) primary radii for equal densities", func() {
+			Expect(RocheLimit(10, 1000, 1, 1)).To(BeNumerically("~", 12.5992, 1e-4))
+			Expect(FluidRocheLimit(10, 1000, 1, 1)).To(BeNumerically("~", 24.4, 1e-9))
+		})
+	})
+
+	Describe("Hill sphere", func() {
+		It("should compute the Earth's Hill radius", func() {
+			Expect(HillRadius(constants.AU, 0, constants.EarthMass, constants.SunMass)).To(BeNumerically("~", 1.4966e6, 2e3))
+		})
+
+		It("should shrink at periapsis for eccentric orbits", func() {
+			Expect(HillRadius(100, 0.5, 3, 1000)).To(BeNumerically("~", 5, 1e-9))
+		})
+
+		It("should compute the Moon's Hill radius relative to the Earth", func() {
+			Expect(HillRadius(constants.MoonDistance, 0, constants.MoonMass, constants.EarthMass)).To(BeNumerically("~", 61500, 500))
+		})
+	})
+
+	Describe("Sphere of influence", func() {
+		DescribeTable("matches published radii",
+			func(a, m, expected float64) {
+				Expect(SOIRadius(a, m, constants.SunMass)).To(BeNumerically("~", expected, expected*0.01))
+			},
+			Entry("Earth", constants.AU, constants.EarthMass, 9.25e5),
+			Entry("Mars", 1.523679*constants.AU, constants.MarsMass, 5.77e5),
+			Entry("Jupiter", 5.2044*constants.AU, constants.JupiterMass, 4.82e7),
+		)
+
+		It("should compute the Moon's sphere of influence", func() {
+			Expect(SOIRadius(constants.MoonDistance, constants.MoonMass, constants.EarthMass)).To(BeNumerically("~", 66100, 300))
+		})
+	})
+
+	It("should derive the Sun's mass from its gravitational parameter", func() {
+		Expect(constants.SunMass).To(BeNumerically("~", 1.98847e30, 1e26))
+		Expect(constants.GMEarth / constants.G).To(BeNumerically("~", constants.EarthMass, 1e21))
+	})
+})
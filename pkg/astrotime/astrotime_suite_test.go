@@ -0,0 +1,13 @@
+package astrotime_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAstrotime(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Astrotime Suite")
+}
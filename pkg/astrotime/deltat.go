@@ -0,0 +1,74 @@
+package astrotime
+
+import (
+	"time"
+)
+
+// leapSecondEra is the start of UTC with leap seconds, before which TT − UTC no longer follows
+// the rotation of the Earth
+var leapSecondEra = time.Date(1972, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// DeltaT returns ΔT = TT − UT1 in seconds at t from the polynomials of Espenak and Meeus (Five
+// Millennium Canon of Solar Eclipses, NASA TP-2006-214141), which follow the historical record
+// from -1999 to 2005 and extrapolate beyond it. Its uncertainty grows from under a second in
+// the twentieth century to minutes in antiquity and hours in the earliest millennia.
+func DeltaT(t time.Time) float64 {
+	t = t.UTC()
+	y := float64(t.Year()) + (float64(t.Month())-0.5)/12
+	switch {
+	case y < -500:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u
+	case y < 500:
+		u := y / 100
+		return polynomial(u, 10583.6, -1014.41, 33.78311, -5.952053, -0.1798452, 0.022174192, 0.0090316521)
+	case y < 1600:
+		u := (y - 1000) / 100
+		return polynomial(u, 1574.2, -556.01, 71.23472, 0.319781, -0.8503463, -0.005050998, 0.0083572073)
+	case y < 1700:
+		return polynomial(y-1600, 120, -0.9808, -0.01532, 1.0/7129)
+	case y < 1800:
+		return polynomial(y-1700, 8.83, 0.1603, -0.0059285, 0.00013336, -1.0/1174000)
+	case y < 1860:
+		return polynomial(y-1800, 13.72, -0.332447, 0.0068612, 0.0041116, -0.00037436, 0.0000121272,
+			-0.0000001699, 0.000000000875)
+	case y < 1900:
+		return polynomial(y-1860, 7.62, 0.5737, -0.251754, 0.01680668, -0.0004473624, 1.0/233174)
+	case y < 1920:
+		return polynomial(y-1900, -2.79, 1.494119, -0.0598939, 0.0061966, -0.000197)
+	case y < 1941:
+		return polynomial(y-1920, 21.20, 0.84493, -0.076100, 0.0020936)
+	case y < 1961:
+		return polynomial(y-1950, 29.07, 0.407, -1.0/233, 1.0/2547)
+	case y < 1986:
+		return polynomial(y-1975, 45.45, 1.067, -1.0/260, -1.0/718)
+	case y < 2005:
+		return polynomial(y-2000, 63.86, 0.3345, -0.060374, 0.0017275, 0.000651814, 0.00002373599)
+	case y < 2050:
+		return polynomial(y-2000, 62.92, 0.32217, 0.005589)
+	case y < 2150:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u - 0.5628*(2150-y)
+	default:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u
+	}
+}
+
+// TTMinusUT returns TT − UT in seconds at t: TT − UTC from the leap-second table since 1972,
+// within 0.9 s of ΔT, and DeltaT before, when UTC did not exist in its present form
+func TTMinusUT(t time.Time) float64 {
+	if t.Before(leapSecondEra) {
+		return DeltaT(t)
+	}
+	return TTMinusUTC(t)
+}
+
+// polynomial evaluates the polynomial with coefficients in increasing order at x
+func polynomial(x float64, coefficients ...float64) float64 {
+	sum := 0.0
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		sum = sum*x + coefficients[i]
+	}
+	return sum
+}
@@ -0,0 +1,35 @@
+package astrotime
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DeltaT", func() {
+	// values tabulated in the Five Millennium Canon, NASA TP-2006-214141, table 1.1
+	DescribeTable("should follow the historical record",
+		func(year int, expected, tolerance float64) {
+			Expect(DeltaT(time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC))).To(BeNumerically("~", expected, tolerance))
+		},
+		Entry("-500", -500, 17190.0, 30.0),
+		Entry("0", 0, 10580.0, 30.0),
+		Entry("1000", 1000, 1570.0, 30.0),
+		Entry("1600", 1600, 120.0, 1.0),
+		Entry("1700", 1700, 9.0, 1.0),
+		Entry("1800", 1800, 13.7, 0.5),
+		Entry("1900", 1900, -2.8, 0.5),
+		Entry("1950", 1950, 29.1, 0.5),
+		Entry("2000", 2000, 63.8, 0.5),
+	)
+
+	It("should switch from the polynomials to the leap seconds in 1972", func() {
+		before := time.Date(1971, 12, 31, 23, 59, 59, 0, time.UTC)
+		after := time.Date(1972, 1, 1, 0, 0, 0, 0, time.UTC)
+		Expect(TTMinusUT(before)).To(Equal(DeltaT(before)))
+		Expect(TTMinusUT(after)).To(Equal(TTMinusUTC(after)))
+		Expect(TTMinusUT(before)).To(BeNumerically("~", TTMinusUT(after), 1))
+		Expect(TTMinusUT(time.Date(1800, 1, 1, 0, 0, 0, 0, time.UTC))).NotTo(BeNumerically("~", TTMinusUTC(before), 10))
+	})
+})
@@ -0,0 +1,90 @@
+package astrotime
+
+import (
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/planets"
+	"github.com/ocrosby/astronomy/pkg/solar"
+	"github.com/ocrosby/astronomy/pkg/vectors"
+	"time"
+)
+
+// Light-time corrections are computed from the low-precision solar theory and mean planetary
+// orbits; they are accurate to about 0.1 s, ample for time-series photometry of variable
+// stars and exoplanet transits.
+
+// ObliquityJ2000 is the obliquity of the ecliptic at J2000.0 in degrees
+const ObliquityJ2000 = 23.4392911
+
+// LightTimeAU is the light travel time across one astronomical unit in days
+const LightTimeAU = constants.AU / constants.SpeedOfLight / julian.SecondsPerDay
+
+// giantPlanets lists the planets displacing the Sun from the solar system barycenter with
+// their gravitational parameters
+var giantPlanets = []struct {
+	planet planets.Planet
+	gm     float64
+}{
+	{planets.Jupiter, constants.GMJupiter},
+	{planets.Saturn, constants.GMSaturn},
+	{planets.Uranus, constants.GMUranus},
+	{planets.Neptune, constants.GMNeptune},
+}
+
+// HeliocentricCorrection returns the light-time correction in days that converts a Julian
+// date at the Earth into a heliocentric Julian date for a target with J2000 coordinates
+func HeliocentricCorrection(t time.Time, target coordinates.Equatorial) float64 {
+	return earthHeliocentric(julian.Centuries(TT(t))).DotProduct(target.Vector()) * LightTimeAU
+}
+
+// BarycentricCorrection returns the light-time correction in days from the Earth to the
+// solar system barycenter for a target with J2000 coordinates
+func BarycentricCorrection(t time.Time, target coordinates.Equatorial) float64 {
+	centuries := julian.Centuries(TT(t))
+	earth := earthHeliocentric(centuries).Add(sunBarycentric(centuries))
+	return earth.DotProduct(target.Vector()) * LightTimeAU
+}
+
+// HJD returns the heliocentric Julian date (UTC) of an observation at t
+func HJD(t time.Time, target coordinates.Equatorial) float64 {
+	return julian.FromTime(t) + HeliocentricCorrection(t, target)
+}
+
+// BJD returns the barycentric Julian date in the TDB time scale (BJD_TDB) of an observation at t
+func BJD(t time.Time, target coordinates.Equatorial) float64 {
+	return TDB(t) + BarycentricCorrection(t, target)
+}
+
+// velocityStep is the half-width in Julian centuries of the difference giving the Earth's
+// velocity, half a day
+const velocityStep = 0.5 / 36525
+
+// EarthBarycentricVelocity returns the Earth's velocity relative to the solar system barycenter
+// in km/s, in J2000 equatorial axes, good to about 0.02 km/s
+func EarthBarycentricVelocity(t time.Time) vectors.Vector3D {
+	centuries := julian.Centuries(TT(t))
+	position := func(t float64) vectors.Vector3D {
+		return earthHeliocentric(t).Add(sunBarycentric(t))
+	}
+	change := position(centuries + velocityStep).Subtract(position(centuries - velocityStep))
+	return change.ScalarMultiply(constants.AU / (2 * velocityStep * 36525 * julian.SecondsPerDay))
+}
+
+// earthHeliocentric returns the Earth's position relative to the Sun in AU, in J2000
+// equatorial axes
+func earthHeliocentric(t float64) vectors.Vector3D {
+	sun := coordinates.Ecliptic{Longitude: solar.J2000Longitude(t)}.ToEquatorial(ObliquityJ2000)
+	return sun.Vector().ScalarMultiply(-solar.Distance(t))
+}
+
+// sunBarycentric returns the Sun's position relative to the solar system barycenter in AU,
+// in J2000 equatorial axes, from the pull of the giant planets
+func sunBarycentric(t float64) vectors.Vector3D {
+	var offset vectors.Vector3D
+	for _, p := range giantPlanets {
+		ratio := p.gm / (constants.GMSun + p.gm)
+		offset = offset.Subtract(planets.Heliocentric(p.planet, t).ScalarMultiply(ratio))
+	}
+	return vectors.Rotate3Dx(offset, ObliquityJ2000*constants.Rad)
+}
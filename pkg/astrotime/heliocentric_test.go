@@ -0,0 +1,66 @@
+package astrotime
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/solar"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Heliocentric", func() {
+	t := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	centuries := julian.Centuries(TT(t))
+	sunLongitude := solar.J2000Longitude(centuries)
+	toward := func(longitude, latitude float64) coordinates.Equatorial {
+		return coordinates.Ecliptic{Longitude: longitude, Latitude: latitude}.ToEquatorial(ObliquityJ2000)
+	}
+
+	It("should add the full light time for a target at opposition", func() {
+		seconds := HeliocentricCorrection(t, toward(sunLongitude+180, 0)) * julian.SecondsPerDay
+		Expect(seconds).To(BeNumerically("~", 499.005*solar.Distance(centuries), 0.01))
+	})
+
+	It("should subtract the light time for a target behind the Sun", func() {
+		seconds := HeliocentricCorrection(t, toward(sunLongitude, 0)) * julian.SecondsPerDay
+		Expect(seconds).To(BeNumerically("~", -499.005*solar.Distance(centuries), 0.01))
+	})
+
+	It("should vanish at the ecliptic pole and at quadrature", func() {
+		Expect(HeliocentricCorrection(t, toward(0, 90)) * julian.SecondsPerDay).To(BeNumerically("~", 0, 1e-6))
+		Expect(HeliocentricCorrection(t, toward(sunLongitude+90, 0)) * julian.SecondsPerDay).To(BeNumerically("~", 0, 1e-6))
+	})
+
+	It("should keep the barycentric offset within the Sun's wobble", func() {
+		algol := coordinates.Equatorial{RA: 47.0422, Dec: 40.9556}
+		for month := 1; month <= 12; month++ {
+			at := time.Date(2024, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+			difference := (BarycentricCorrection(at, algol) - HeliocentricCorrection(at, algol)) * julian.SecondsPerDay
+			Expect(difference).To(BeNumerically("<", 5.0))
+			Expect(difference).To(BeNumerically(">", -5.0))
+		}
+	})
+
+	It("should place the barycenter outside the Sun along Jupiter's direction", func() {
+		Expect(sunBarycentric(0).Magnitude()).To(BeNumerically("~", 0.0075, 0.003))
+	})
+
+	It("should give the Earth's orbital velocity", func() {
+		v := EarthBarycentricVelocity(t)
+		// near perihelion in January the Earth moves fastest, 30.3 km/s
+		Expect(v.Magnitude()).To(BeNumerically("~", 30.29, 0.05))
+		Expect(EarthBarycentricVelocity(t.AddDate(0, 6, 0)).Magnitude()).To(BeNumerically("~", 29.29, 0.05))
+		// perpendicular to the Sun's direction to within the orbit's eccentricity
+		Expect(v.Normalize().DotProduct(toward(sunLongitude, 0).Vector())).To(BeNumerically("~", 0, 0.02))
+	})
+
+	It("should compute HJD and BJD_TDB", func() {
+		target := coordinates.Equatorial{RA: 47.0422, Dec: 40.9556}
+		jd := julian.FromTime(t)
+		Expect(HJD(t, target)).To(BeNumerically("~", jd+HeliocentricCorrection(t, target), 1e-9))
+		offset := (BJD(t, target) - HJD(t, target)) * julian.SecondsPerDay
+		Expect(offset).To(BeNumerically("~", 69.184, 5.0))
+	})
+})
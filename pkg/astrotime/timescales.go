@@ -0,0 +1,45 @@
+package astrotime
+
+import (
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/internal/timescale"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"math"
+	"time"
+)
+
+// TTMinusTAI is the constant offset between Terrestrial Time and TAI in seconds
+const TTMinusTAI = timescale.TTMinusTAI
+
+// LeapSecondTableVersion identifies the leap-second table: the date of its last entry, which
+// changes whenever IERS Bulletin C announces a new leap second and the table is extended
+const LeapSecondTableVersion = timescale.TableVersion
+
+// TAIMinusUTC returns the number of seconds TAI is ahead of UTC at t. Dates before 1972
+// return the initial value of 10 s.
+func TAIMinusUTC(t time.Time) int {
+	return timescale.TAIMinusUTC(t)
+}
+
+// TTMinusUTC returns the difference between Terrestrial Time and UTC in seconds at t
+func TTMinusUTC(t time.Time) float64 {
+	return timescale.TTMinusUTC(t)
+}
+
+// TT returns the Julian date in Terrestrial Time for a UTC instant
+func TT(t time.Time) float64 {
+	return timescale.TT(t)
+}
+
+// TDBMinusTT returns the periodic difference between Barycentric Dynamical Time and
+// Terrestrial Time in seconds for a Julian date in TT (accurate to about 30 µs)
+func TDBMinusTT(jdTT float64) float64 {
+	g := (357.53 + 0.98560028*(jdTT-julian.J2000)) * constants.Rad
+	return 0.001657*math.Sin(g) + 0.000014*math.Sin(2*g)
+}
+
+// TDB returns the Julian date in Barycentric Dynamical Time for a UTC instant
+func TDB(t time.Time) float64 {
+	tt := TT(t)
+	return tt + TDBMinusTT(tt)/julian.SecondsPerDay
+}
@@ -0,0 +1,37 @@
+package astrotime
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/julian"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Time scales", func() {
+	DescribeTable("TAIMinusUTC",
+		func(t time.Time, expected int) {
+			Expect(TAIMinusUTC(t)).To(Equal(expected))
+		},
+		Entry("before 1972", time.Date(1960, 1, 1, 0, 0, 0, 0, time.UTC), 10),
+		Entry("1980", time.Date(1980, 6, 1, 0, 0, 0, 0, time.UTC), 19),
+		Entry("last second of 2016", time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC), 36),
+		Entry("first second of 2017", time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), 37),
+		Entry("2024", time.Date(2024, 4, 8, 0, 0, 0, 0, time.UTC), 37),
+	)
+
+	It("should offset TT from UTC", func() {
+		t := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		Expect(TTMinusUTC(t)).To(Equal(69.184))
+		Expect((TT(t) - julian.FromTime(t)) * julian.SecondsPerDay).To(BeNumerically("~", 69.184, 1e-4))
+	})
+
+	It("should keep TDB within 2 ms of TT", func() {
+		for day := 0.0; day < 366; day += 7 {
+			Expect(TDBMinusTT(julian.J2000 + day)).To(BeNumerically("<=", 0.00168))
+			Expect(TDBMinusTT(julian.J2000 + day)).To(BeNumerically(">=", -0.00168))
+		}
+		t := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		Expect((TDB(t) - TT(t)) * julian.SecondsPerDay).To(BeNumerically("~", 0, 0.002))
+	})
+})
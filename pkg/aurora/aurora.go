@@ -0,0 +1,60 @@
+// Package aurora estimates where the aurora can be seen from the planetary Kp index, using the
+// equatorward boundary of the auroral oval in geomagnetic latitude.
+package aurora
+
+import (
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/geomag"
+	"math"
+)
+
+// Auroral oval and viewing geometry
+const (
+	QuietBoundary    = 66.5 // geomagnetic latitude of the equatorward boundary at Kp 0
+	BoundaryPerKp    = 2.0  // equatorward shift of the boundary per unit of Kp, degrees
+	MaxKp            = 9.0
+	AuroraHeight     = 200.0 // height of the upper part of the auroral curtain in km
+	MinimumElevation = 10.0  // elevation in degrees above which a display is noticeable
+)
+
+// BoundaryLatitude returns the geomagnetic latitude of the equatorward edge of the auroral oval
+// on the night side for a Kp index, clamped to 0-9
+func BoundaryLatitude(kp float64) float64 {
+	return QuietBoundary - BoundaryPerKp*math.Max(0, math.Min(kp, MaxKp))
+}
+
+// ViewingDistance returns the great-circle distance in degrees from which aurora at a height in
+// km appears at an elevation in degrees above the horizon
+func ViewingDistance(height, elevation float64) float64 {
+	e := elevation * constants.Rad
+	ratio := constants.EarthRadius / (constants.EarthRadius + height)
+	return (math.Acos(ratio*math.Cos(e)) - e) * constants.Deg
+}
+
+// GeomagneticLatitude returns the latitude in degrees of a geographic position in the
+// coordinates of the centred dipole of the latest IGRF model
+func GeomagneticLatitude(lat, lon float64) float64 {
+	mlat, _ := geomag.GeographicToGeomagnetic(lat, lon, geomag.LatestEpoch)
+	return mlat
+}
+
+// Overhead reports whether the auroral oval reaches the zenith of a geographic position at a
+// Kp index, in either hemisphere
+func Overhead(lat, lon, kp float64) bool {
+	return math.Abs(GeomagneticLatitude(lat, lon)) >= BoundaryLatitude(kp)
+}
+
+// VisibleFrom reports whether aurora at the equatorward edge of the oval rises above
+// MinimumElevation on the poleward horizon of a geographic position at a Kp index, given
+// clear dark skies
+func VisibleFrom(lat, lon, kp float64) bool {
+	reach := ViewingDistance(AuroraHeight, MinimumElevation)
+	return math.Abs(GeomagneticLatitude(lat, lon)) >= BoundaryLatitude(kp)-reach
+}
+
+// MinimumKp returns the lowest Kp index at which aurora is visible from a geographic position:
+// 0 inside the quiet oval and above 9 where even a severe storm does not reach
+func MinimumKp(lat, lon float64) float64 {
+	reach := ViewingDistance(AuroraHeight, MinimumElevation)
+	return math.Max(0, (QuietBoundary-reach-math.Abs(GeomagneticLatitude(lat, lon)))/BoundaryPerKp)
+}
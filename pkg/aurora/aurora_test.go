@@ -0,0 +1,41 @@
+package aurora
+
+import (
+	"github.com/ocrosby/astronomy/pkg/geomag"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Aurora", func() {
+	It("should move the oval equatorward with Kp", func() {
+		Expect(BoundaryLatitude(0)).To(Equal(QuietBoundary))
+		Expect(BoundaryLatitude(5)).To(Equal(56.5))
+		Expect(BoundaryLatitude(12)).To(Equal(BoundaryLatitude(9)))
+	})
+
+	It("should see aurora several degrees beyond the oval", func() {
+		Expect(ViewingDistance(110, 0)).To(BeNumerically("~", 10.5, 0.1))
+		Expect(ViewingDistance(AuroraHeight, MinimumElevation)).To(BeNumerically("~", 7.3, 0.1))
+	})
+
+	It("should use geomagnetic rather than geographic latitude", func() {
+		Expect(GeomagneticLatitude(geomag.DipoleAt(geomag.LatestEpoch).Pole())).To(BeNumerically("~", 90, 1e-5))
+		// Minneapolis lies several degrees further poleward geomagnetically than Rome
+		Expect(GeomagneticLatitude(44.98, -93.27)).To(BeNumerically("~", 53.5, 0.5))
+		Expect(GeomagneticLatitude(41.9, 12.5)).To(BeNumerically("~", 41.9, 1.5))
+	})
+
+	It("should decide visibility from the Kp index", func() {
+		Expect(Overhead(64.84, -147.72, 2)).To(BeTrue()) // Fairbanks
+		Expect(VisibleFrom(44.98, -93.27, 1)).To(BeFalse())
+		Expect(VisibleFrom(44.98, -93.27, 5)).To(BeTrue())
+		Expect(VisibleFrom(-43.5, 172.6, 7)).To(BeTrue()) // Christchurch, southern aurora
+		Expect(VisibleFrom(41.9, 12.5, 7)).To(BeFalse())
+	})
+
+	It("should give the Kp needed for visibility", func() {
+		Expect(MinimumKp(69.65, 18.96)).To(BeZero()) // Tromsø
+		Expect(MinimumKp(44.98, -93.27)).To(BeNumerically("~", 2.9, 0.2))
+		Expect(MinimumKp(0, 0)).To(BeNumerically(">", MaxKp))
+	})
+})
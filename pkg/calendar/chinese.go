@@ -0,0 +1,200 @@
+package calendar
+
+import (
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/lunar"
+	"github.com/ocrosby/astronomy/pkg/solar"
+	"math"
+	"time"
+)
+
+// The Chinese calendar follows the modern rules in use since 1645 with astronomical new moons
+// and solar terms reckoned in China Standard Time (UTC+8): a month starts on the day of a new
+// moon, the winter solstice always falls in the 11th month, and in a span of 13 months between
+// winter-solstice months the first month without a principal solar term is intercalary.
+
+// ChinaStandardTime is the time zone used for Chinese calendar days
+var ChinaStandardTime = time.FixedZone("CST", 8*3600)
+
+// solarTermNames lists the 24 solar terms starting from Lichun at solar longitude 315°
+var solarTermNames = [...]string{
+	"Lichun", "Yushui", "Jingzhe", "Chunfen", "Qingming", "Guyu",
+	"Lixia", "Xiaoman", "Mangzhong", "Xiazhi", "Xiaoshu", "Dashu",
+	"Liqiu", "Chushu", "Bailu", "Qiufen", "Hanlu", "Shuangjiang",
+	"Lidong", "Xiaoxue", "Daxue", "Dongzhi", "Xiaohan", "Dahan",
+}
+
+// SolarTerm is one of the 24 points dividing the ecliptic into 15° steps of solar longitude
+type SolarTerm struct {
+	Index     int     // 0 for Lichun through 23 for Dahan
+	Name      string  // pinyin name, e.g. "Dongzhi"
+	Longitude float64 // apparent solar longitude in degrees
+	Time      time.Time
+}
+
+// Major reports whether the term is a principal term (zhongqi), at a multiple of 30° longitude
+func (s SolarTerm) Major() bool {
+	return s.Index%2 == 1
+}
+
+// SolarTerms returns the 24 solar terms falling in the Gregorian year in chronological order
+func SolarTerms(year int) []SolarTerm {
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, ChinaStandardTime)
+	end := start.AddDate(1, 0, 0)
+	terms := make([]SolarTerm, 0, len(solarTermNames))
+	// Xiaohan (285°) is the first term of the Gregorian year
+	for i := 0; i < len(solarTermNames); i++ {
+		index := (i + 22) % len(solarTermNames)
+		longitude := math.Mod(315+15*float64(index), 360)
+		t := NextSolarLongitude(longitude, start)
+		if t.Before(end) {
+			terms = append(terms, SolarTerm{Index: index, Name: solarTermNames[index], Longitude: longitude,
+				Time: t.In(ChinaStandardTime)})
+		}
+	}
+	return terms
+}
+
+// ChineseMonth is a month of the Chinese calendar
+type ChineseMonth struct {
+	Number int       // 1 to 12
+	Leap   bool      // intercalary month taking the number of the preceding month
+	Start  time.Time // midnight China Standard Time on the first day
+	Days   int       // 29 or 30
+}
+
+// ChineseDate is a date in the Chinese calendar
+type ChineseDate struct {
+	Year  int // Gregorian year in which the Chinese year began
+	Month int
+	Leap  bool
+	Day   int
+}
+
+var (
+	heavenlyStems   = [...]string{"Jia", "Yi", "Bing", "Ding", "Wu", "Ji", "Geng", "Xin", "Ren", "Gui"}
+	earthlyBranches = [...]string{"Zi", "Chou", "Yin", "Mao", "Chen", "Si", "Wu", "Wei", "Shen", "You", "Xu", "Hai"}
+	zodiacAnimals   = [...]string{"Rat", "Ox", "Tiger", "Rabbit", "Dragon", "Snake", "Horse", "Goat", "Monkey",
+		"Rooster", "Dog", "Pig"}
+)
+
+// Sexagenary returns the stem-branch name of the year, e.g. "Jia-Chen" for 2024
+func (d ChineseDate) Sexagenary() string {
+	return heavenlyStems[mod(d.Year-4, 10)] + "-" + earthlyBranches[mod(d.Year-4, 12)]
+}
+
+// Animal returns the zodiac animal of the year
+func (d ChineseDate) Animal() string {
+	return zodiacAnimals[mod(d.Year-4, 12)]
+}
+
+// ChineseNewYear returns the first day of the Chinese year beginning in the Gregorian year
+func ChineseNewYear(year int) time.Time {
+	return ChineseMonths(year)[0].Start
+}
+
+// ChineseMonths returns the months of the Chinese year beginning in the Gregorian year,
+// from the first month to the last day before the next new year
+func ChineseMonths(year int) []ChineseMonth {
+	months := append(solsticeSpan(year-1), solsticeSpan(year)...)
+	for i, m := range months {
+		if m.Number == 1 && !m.Leap {
+			months = months[i:]
+			break
+		}
+	}
+	for i, m := range months[1:] {
+		if m.Number == 1 && !m.Leap {
+			return months[:i+1]
+		}
+	}
+	return months
+}
+
+// ToChinese converts an instant to its Chinese calendar date
+func ToChinese(t time.Time) ChineseDate {
+	day := startOfDay(t.In(ChinaStandardTime))
+	year := day.Year()
+	if day.Before(ChineseNewYear(year)) {
+		year--
+	}
+	months := ChineseMonths(year)
+	for i := len(months) - 1; i >= 0; i-- {
+		if !day.Before(months[i].Start) {
+			return ChineseDate{Year: year, Month: months[i].Number, Leap: months[i].Leap,
+				Day: daysBetween(months[i].Start, day) + 1}
+		}
+	}
+	return ChineseDate{Year: year}
+}
+
+// solsticeSpan returns the numbered months from the 11th month containing the winter solstice
+// of year up to, but excluding, the 11th month of the following year
+func solsticeSpan(year int) []ChineseMonth {
+	first := monthContaining(SeasonStart(year, DecemberSolstice))
+	last := monthContaining(SeasonStart(year+1, DecemberSolstice))
+
+	var starts []time.Time
+	for m := first; m.Before(last); m = dayOf(lunar.NextPhase(lunar.NewMoon, m.Add(24*time.Hour))) {
+		starts = append(starts, m)
+	}
+	starts = append(starts, last)
+
+	leapAllowed := len(starts)-1 == 13
+	months := make([]ChineseMonth, 0, len(starts)-1)
+	number := 11
+	for i := 0; i < len(starts)-1; i++ {
+		m := ChineseMonth{Number: number, Start: starts[i], Days: daysBetween(starts[i], starts[i+1])}
+		if leapAllowed && i > 0 && !hasPrincipalTerm(starts[i], starts[i+1]) {
+			m.Leap = true
+			m.Number = months[i-1].Number
+			leapAllowed = false
+		} else if i > 0 {
+			m.Number = months[i-1].Number%12 + 1
+		}
+		months = append(months, m)
+	}
+	return months
+}
+
+// monthContaining returns the start of the lunar month containing t: the day of the latest
+// new moon on or before t's day in China Standard Time
+func monthContaining(t time.Time) time.Time {
+	day := dayOf(t)
+	m := dayOf(lunar.NextPhase(lunar.NewMoon, t.AddDate(0, 0, -30)))
+	for {
+		next := dayOf(lunar.NextPhase(lunar.NewMoon, m.Add(24*time.Hour)))
+		if next.After(day) {
+			return m
+		}
+		m = next
+	}
+}
+
+// hasPrincipalTerm reports whether a principal solar term falls on a day in [start, end)
+func hasPrincipalTerm(start, end time.Time) bool {
+	current := solar.ApparentLongitude(julian.Centuries(astrotime.TT(start)))
+	next := math.Mod(math.Ceil(current/30)*30, 360)
+	return dayOf(NextSolarLongitude(next, start)).Before(end)
+}
+
+// dayOf returns midnight China Standard Time of the day containing t
+func dayOf(t time.Time) time.Time {
+	return startOfDay(t.In(ChinaStandardTime))
+}
+
+// startOfDay returns midnight at the start of t's calendar day in t's location
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// daysBetween returns the number of calendar days from a to b
+func daysBetween(a, b time.Time) int {
+	return int(math.Round(b.Sub(a).Hours() / 24))
+}
+
+// mod returns the non-negative remainder of a divided by n
+func mod(a, n int) int {
+	return (a%n + n) % n
+}
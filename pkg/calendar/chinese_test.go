@@ -0,0 +1,90 @@
+package calendar
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Chinese calendar", func() {
+	cst := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, ChinaStandardTime)
+	}
+
+	DescribeTable("ChineseNewYear",
+		func(year int, expected time.Time) {
+			Expect(ChineseNewYear(year)).To(Equal(expected))
+		},
+		Entry("2020", 2020, cst(2020, 1, 25)),
+		Entry("2021", 2021, cst(2021, 2, 12)),
+		Entry("2022", 2022, cst(2022, 2, 1)),
+		Entry("2023", 2023, cst(2023, 1, 22)),
+		Entry("2024", 2024, cst(2024, 2, 10)),
+		Entry("2025", 2025, cst(2025, 1, 29)),
+		Entry("2026", 2026, cst(2026, 2, 17)),
+	)
+
+	DescribeTable("places leap months",
+		func(year, number int, start time.Time) {
+			var leap []ChineseMonth
+			for _, m := range ChineseMonths(year) {
+				if m.Leap {
+					leap = append(leap, m)
+				}
+			}
+			Expect(leap).To(HaveLen(1))
+			Expect(leap[0].Number).To(Equal(number))
+			Expect(leap[0].Start).To(Equal(start))
+		},
+		Entry("leap 4th month of 2020", 2020, 4, cst(2020, 5, 23)),
+		Entry("leap 2nd month of 2023", 2023, 2, cst(2023, 3, 22)),
+		Entry("leap 6th month of 2025", 2025, 6, cst(2025, 7, 25)),
+		Entry("leap 11th month of 2033", 2033, 11, cst(2033, 12, 22)),
+	)
+
+	It("should have twelve months of 29 or 30 days in a common year", func() {
+		months := ChineseMonths(2024)
+		Expect(months).To(HaveLen(12))
+		for i, m := range months {
+			Expect(m.Number).To(Equal(i + 1))
+			Expect(m.Days).To(BeNumerically(">=", 29))
+			Expect(m.Days).To(BeNumerically("<=", 30))
+		}
+	})
+
+	It("should convert Gregorian dates", func() {
+		Expect(ToChinese(time.Date(2024, 2, 10, 12, 0, 0, 0, ChinaStandardTime))).To(Equal(ChineseDate{Year: 2024, Month: 1, Day: 1}))
+		Expect(ToChinese(time.Date(2024, 2, 9, 12, 0, 0, 0, ChinaStandardTime))).To(Equal(ChineseDate{Year: 2023, Month: 12, Day: 30}))
+		Expect(ToChinese(time.Date(2024, 9, 17, 12, 0, 0, 0, ChinaStandardTime))).To(Equal(ChineseDate{Year: 2024, Month: 8, Day: 15}))
+		Expect(ToChinese(time.Date(2023, 4, 1, 12, 0, 0, 0, ChinaStandardTime))).To(Equal(ChineseDate{Year: 2023, Month: 2, Leap: true, Day: 11}))
+	})
+
+	It("should use China Standard Time for the day boundary", func() {
+		// 2024 February 9, 17:00 UTC is already February 10 in Beijing
+		Expect(ToChinese(time.Date(2024, 2, 9, 17, 0, 0, 0, time.UTC)).Day).To(Equal(1))
+	})
+
+	It("should name the year", func() {
+		d := ChineseDate{Year: 2024}
+		Expect(d.Sexagenary()).To(Equal("Jia-Chen"))
+		Expect(d.Animal()).To(Equal("Dragon"))
+		Expect(ChineseDate{Year: 1984}.Sexagenary()).To(Equal("Jia-Zi"))
+	})
+
+	It("should list the 24 solar terms of a year", func() {
+		terms := SolarTerms(2024)
+		Expect(terms).To(HaveLen(24))
+		Expect(terms[0].Name).To(Equal("Xiaohan"))
+		Expect(terms[0].Time.Month()).To(Equal(time.January))
+		for i := 1; i < len(terms); i++ {
+			Expect(terms[i].Time.After(terms[i-1].Time)).To(BeTrue())
+		}
+		Expect(terms[23].Name).To(Equal("Dongzhi"))
+		Expect(terms[23].Major()).To(BeTrue())
+		Expect(terms[23].Time).To(BeTemporally("~", SeasonStart(2024, DecemberSolstice), time.Second))
+		Expect(terms[2].Name).To(Equal("Lichun"))
+		Expect(terms[2].Major()).To(BeFalse())
+		Expect(terms[2].Time.Day()).To(Equal(4))
+	})
+})
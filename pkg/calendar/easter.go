@@ -0,0 +1,56 @@
+package calendar
+
+import (
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"math"
+	"time"
+)
+
+// Computus selects the rules used to compute the date of Easter
+type Computus int
+
+const (
+	Gregorian Computus = iota // Western churches, Gregorian calendar (from 1583)
+	Julian                    // Eastern Orthodox churches, Julian calendar
+)
+
+// Easter returns Easter Sunday of year at midnight UTC. Time values use the Gregorian calendar,
+// so the Julian computus result is converted from its Julian calendar date; see JulianEaster for
+// the date as written in the Julian calendar.
+func Easter(year int, c Computus) time.Time {
+	if c == Julian {
+		month, day := JulianEaster(year)
+		return JulianCalendarDate(year, month, day)
+	}
+	a := year % 19
+	b, cc := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := cc/4, cc%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// JulianEaster returns the month and day of Easter in the Julian calendar
+func JulianEaster(year int) (time.Month, int) {
+	a, b, c := year%4, year%7, year%19
+	d := (19*c + 15) % 30
+	e := (2*a + 4*b - d + 34) % 7
+	return time.Month((d + e + 114) / 31), (d+e+114)%31 + 1
+}
+
+// JulianCalendarDate returns midnight UTC of a Julian calendar date as a (proleptic Gregorian)
+// time (Meeus 7.1)
+func JulianCalendarDate(year int, month time.Month, day int) time.Time {
+	y, m := float64(year), float64(month)
+	if month <= time.February {
+		y, m = y-1, m+12
+	}
+	jd := math.Floor(365.25*(y+4716)) + math.Floor(30.6001*(m+1)) + float64(day) - 1524.5
+	return julian.ToTime(jd)
+}
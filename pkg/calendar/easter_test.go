@@ -0,0 +1,58 @@
+package calendar
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Easter", func() {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	DescribeTable("Gregorian computus",
+		func(year int, expected time.Time) {
+			Expect(Easter(year, Gregorian)).To(Equal(expected))
+			Expect(Easter(year, Gregorian).Weekday()).To(Equal(time.Sunday))
+		},
+		Entry("first Gregorian Easter", 1583, date(1583, 4, 10)),
+		Entry("earliest possible date", 1818, date(1818, 3, 22)),
+		Entry("latest possible date", 1943, date(1943, 4, 25)),
+		Entry("1886", 1886, date(1886, 4, 25)),
+		Entry("1961", 1961, date(1961, 4, 2)),
+		Entry("2000", 2000, date(2000, 4, 23)),
+		Entry("2024", 2024, date(2024, 3, 31)),
+		Entry("2025", 2025, date(2025, 4, 20)),
+		Entry("2038", 2038, date(2038, 4, 25)),
+		Entry("2285", 2285, date(2285, 3, 22)),
+	)
+
+	DescribeTable("Julian computus in the Julian calendar (Meeus)",
+		func(year int, month time.Month, day int) {
+			m, d := JulianEaster(year)
+			Expect(m).To(Equal(month))
+			Expect(d).To(Equal(day))
+		},
+		Entry("179", 179, time.April, 12),
+		Entry("711", 711, time.April, 12),
+		Entry("1243", 1243, time.April, 12),
+	)
+
+	DescribeTable("Orthodox Easter in the Gregorian calendar",
+		func(year int, expected time.Time) {
+			Expect(Easter(year, Julian)).To(Equal(expected))
+			Expect(Easter(year, Julian).Weekday()).To(Equal(time.Sunday))
+		},
+		Entry("2000", 2000, date(2000, 4, 30)),
+		Entry("2023", 2023, date(2023, 4, 16)),
+		Entry("2024", 2024, date(2024, 5, 5)),
+		Entry("2025 shared with the Western date", 2025, date(2025, 4, 20)),
+	)
+
+	It("should convert Julian calendar dates", func() {
+		Expect(JulianCalendarDate(1582, 10, 4)).To(Equal(date(1582, 10, 14)))
+		Expect(JulianCalendarDate(1900, 2, 29)).To(Equal(date(1900, 3, 13)))
+	})
+})
@@ -0,0 +1,113 @@
+package calendar
+
+import (
+	"errors"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/solar"
+	"time"
+)
+
+// ErrNoSunrise is returned for planetary hours on a day without sunrise or sunset, as in polar
+// summer and winter
+var ErrNoSunrise = errors.New("the Sun does not both rise and set on this day")
+
+// Ruler is one of the seven classical planets, in the descending Chaldean order that the
+// planetary hours follow
+type Ruler int
+
+const (
+	Saturn Ruler = iota
+	Jupiter
+	Mars
+	Sun
+	Venus
+	Mercury
+	Moon
+)
+
+// String returns the name of the classical planet
+func (r Ruler) String() string {
+	return [...]string{"Saturn", "Jupiter", "Mars", "Sun", "Venus", "Mercury", "Moon"}[r]
+}
+
+// dayRulers are the rulers of the first hour of each weekday, which name the days
+var dayRulers = [...]Ruler{
+	time.Sunday:    Sun,
+	time.Monday:    Moon,
+	time.Tuesday:   Mars,
+	time.Wednesday: Mercury,
+	time.Thursday:  Jupiter,
+	time.Friday:    Venus,
+	time.Saturday:  Saturn,
+}
+
+// DayRuler returns the ruler of a weekday
+func DayRuler(day time.Weekday) Ruler {
+	return dayRulers[day]
+}
+
+// PlanetaryHour is one twelfth of the daylight or of the night that follows it
+type PlanetaryHour struct {
+	Number int // 1-12 for the day hours and 13-24 for the night hours
+	Ruler  Ruler
+	Start  time.Time
+	End    time.Time
+}
+
+// Day reports whether the hour falls between sunrise and sunset
+func (h PlanetaryHour) Day() bool {
+	return h.Number <= 12
+}
+
+// PlanetaryHours returns the 24 unequal hours of the planetary day beginning at sunrise on the
+// calendar day of date in date's location: twelve from sunrise to sunset and twelve from sunset
+// to the next sunrise, ruled in Chaldean order from the ruler of the weekday
+func PlanetaryHours(date time.Time, obs observer.Observer) ([]PlanetaryHour, error) {
+	today, err := solar.RiseSet(date, obs)
+	if err != nil {
+		return nil, err
+	}
+	tomorrow, err := solar.RiseSet(date.AddDate(0, 0, 1), obs)
+	if err != nil {
+		return nil, err
+	}
+	if today.Rise.IsZero() || today.Set.IsZero() || tomorrow.Rise.IsZero() || !today.Set.After(today.Rise) {
+		return nil, ErrNoSunrise
+	}
+
+	first := DayRuler(date.Weekday())
+	hours := make([]PlanetaryHour, 24)
+	divide := func(offset int, start, end time.Time) {
+		length := end.Sub(start) / 12
+		for i := 0; i < 12; i++ {
+			n := offset + i
+			hours[n] = PlanetaryHour{
+				Number: n + 1,
+				Ruler:  Ruler((int(first) + n) % 7),
+				Start:  start.Add(time.Duration(i) * length),
+				End:    start.Add(time.Duration(i+1) * length),
+			}
+		}
+		hours[offset+11].End = end
+	}
+	divide(0, today.Rise, today.Set)
+	divide(12, today.Set, tomorrow.Rise)
+	return hours, nil
+}
+
+// PlanetaryHourAt returns the planetary hour containing t, looking at the previous day's night
+// before sunrise
+func PlanetaryHourAt(t time.Time, obs observer.Observer) (PlanetaryHour, error) {
+	for _, date := range []time.Time{t, t.AddDate(0, 0, -1)} {
+		hours, err := PlanetaryHours(date, obs)
+		if err != nil {
+			return PlanetaryHour{}, err
+		}
+		for _, h := range hours {
+			if !t.Before(h.Start) && t.Before(h.End) {
+				return h, nil
+			}
+		}
+	}
+	return PlanetaryHour{}, ErrNoSunrise
+}
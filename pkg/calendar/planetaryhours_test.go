@@ -0,0 +1,58 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/solar"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Planetary hours", func() {
+	london := observer.NewObserver(51.48, 0)
+	// Sunday
+	date := time.Date(2024, 6, 23, 0, 0, 0, 0, time.UTC)
+
+	It("should divide daylight and night into twelve hours each", func() {
+		hours, err := PlanetaryHours(date, london)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hours).To(HaveLen(24))
+
+		sun, err := solar.RiseSet(date, london)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hours[0].Start).To(Equal(sun.Rise))
+		Expect(hours[11].End).To(Equal(sun.Set))
+		Expect(hours[12].Start).To(Equal(sun.Set))
+		Expect(hours[0].Day()).To(BeTrue())
+		Expect(hours[12].Day()).To(BeFalse())
+		// Midsummer day hours are long and night hours short
+		Expect(hours[0].End.Sub(hours[0].Start)).To(BeNumerically("~", 82*time.Minute, 2*time.Minute))
+		Expect(hours[12].End.Sub(hours[12].Start)).To(BeNumerically("~", 38*time.Minute, 2*time.Minute))
+	})
+
+	It("should rule the hours in Chaldean order from the day's ruler", func() {
+		hours, _ := PlanetaryHours(date, london)
+		Expect(hours[0].Ruler).To(Equal(Sun))
+		Expect(hours[1].Ruler).To(Equal(Venus))
+		Expect(hours[7].Ruler).To(Equal(Sun))
+		Expect(hours[23].Ruler).To(Equal(Mercury))
+		// the cycle continues into Monday, whose first hour is the Moon's
+		Expect(Ruler((int(hours[23].Ruler) + 1) % 7)).To(Equal(DayRuler(time.Monday)))
+		Expect(DayRuler(time.Saturday).String()).To(Equal("Saturn"))
+	})
+
+	It("should find the hour containing an instant", func() {
+		h, err := PlanetaryHourAt(time.Date(2024, 6, 24, 2, 0, 0, 0, time.UTC), london)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.Day()).To(BeFalse())
+		Expect(h.Start.Day()).To(Equal(24))
+		h, _ = PlanetaryHourAt(time.Date(2024, 6, 23, 12, 15, 0, 0, time.UTC), london)
+		Expect(h.Number).To(Equal(7))
+	})
+
+	It("should fail in the polar summer", func() {
+		_, err := PlanetaryHours(date, observer.NewObserver(78.2, 15.6))
+		Expect(err).To(MatchError(ErrNoSunrise))
+	})
+})
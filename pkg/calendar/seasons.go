@@ -0,0 +1,117 @@
+package calendar
+
+import (
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/events"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/solar"
+	"math"
+	"time"
+)
+
+// Season identifies an equinox or solstice by the solar longitude at which it occurs
+type Season int
+
+const (
+	MarchEquinox Season = iota
+	JuneSolstice
+	SeptemberEquinox
+	DecemberSolstice
+)
+
+// String returns the name of the equinox or solstice
+func (s Season) String() string {
+	return [...]string{"March Equinox", "June Solstice", "September Equinox", "December Solstice"}[s]
+}
+
+// Longitude returns the apparent solar longitude in degrees at which the season begins
+func (s Season) Longitude() float64 {
+	return float64(s) * 90
+}
+
+// CrossQuarter identifies a cross-quarter day, midway in solar longitude between an
+// equinox and a solstice
+type CrossQuarter int
+
+const (
+	Imbolc     CrossQuarter = iota // solar longitude 315°, early February
+	Beltane                        // solar longitude 45°, early May
+	Lughnasadh                     // solar longitude 135°, early August
+	Samhain                        // solar longitude 225°, early November
+)
+
+// String returns the traditional name of the cross-quarter day
+func (c CrossQuarter) String() string {
+	return [...]string{"Imbolc", "Beltane", "Lughnasadh", "Samhain"}[c]
+}
+
+// Longitude returns the apparent solar longitude in degrees of the cross-quarter day
+func (c CrossQuarter) Longitude() float64 {
+	return math.Mod(315+float64(c)*90, 360)
+}
+
+// solarSpeed is the Sun's mean motion in longitude in degrees per day
+const solarSpeed = 360 / 365.2422
+
+// NextSolarLongitude returns the first instant at or after t when the Sun's apparent geocentric
+// longitude equals longitude (degrees). Results are accurate to within a few minutes.
+func NextSolarLongitude(longitude float64, t time.Time) time.Time {
+	offset := astrotime.TT(t) - julian.FromTime(t) // TT - UTC in days
+	apparent := func(jd float64) float64 {
+		return solar.ApparentLongitude(julian.Centuries(jd + offset))
+	}
+
+	from := julian.FromTime(t)
+	ahead := math.Mod(longitude-apparent(from)+360, 360)
+	estimate := from + ahead/solarSpeed
+	for _, c := range events.FindAngleCrossings(apparent, longitude, math.Max(from, estimate-5), estimate+5, 1) {
+		if c.JD >= from {
+			return julian.ToTime(c.JD)
+		}
+	}
+	return julian.ToTime(estimate)
+}
+
+// SeasonStart returns the UTC instant of an equinox or solstice in year
+func SeasonStart(year int, s Season) time.Time {
+	return NextSolarLongitude(s.Longitude(), time.Date(year, time.March, 1, 0, 0, 0, 0, time.UTC))
+}
+
+// Seasons returns the equinoxes and solstices of year in chronological order
+func Seasons(year int) [4]time.Time {
+	var starts [4]time.Time
+	for s := MarchEquinox; s <= DecemberSolstice; s++ {
+		starts[s] = SeasonStart(year, s)
+	}
+	return starts
+}
+
+// SeasonLengths returns the lengths of the astronomical seasons beginning in year: from the
+// March equinox to the June solstice, and so on to the following year's March equinox
+func SeasonLengths(year int) [4]time.Duration {
+	starts := Seasons(year)
+	next := SeasonStart(year+1, MarchEquinox)
+	var lengths [4]time.Duration
+	for i := range starts {
+		end := next
+		if i < len(starts)-1 {
+			end = starts[i+1]
+		}
+		lengths[i] = end.Sub(starts[i])
+	}
+	return lengths
+}
+
+// CrossQuarterDay returns the UTC instant of a cross-quarter day in year
+func CrossQuarterDay(year int, c CrossQuarter) time.Time {
+	return NextSolarLongitude(c.Longitude(), time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC))
+}
+
+// CrossQuarterDays returns the cross-quarter days of year in chronological order
+func CrossQuarterDays(year int) [4]time.Time {
+	var days [4]time.Time
+	for c := Imbolc; c <= Samhain; c++ {
+		days[c] = CrossQuarterDay(year, c)
+	}
+	return days
+}
@@ -0,0 +1,65 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/solar"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Seasons", func() {
+	utc := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	DescribeTable("finds equinoxes and solstices",
+		func(year int, s Season, expected time.Time) {
+			Expect(SeasonStart(year, s)).To(BeTemporally("~", expected, 10*time.Minute))
+		},
+		Entry("2024 March equinox", 2024, MarchEquinox, utc(2024, 3, 20, 3, 6)),
+		Entry("2024 June solstice", 2024, JuneSolstice, utc(2024, 6, 20, 20, 51)),
+		Entry("2024 September equinox", 2024, SeptemberEquinox, utc(2024, 9, 22, 12, 44)),
+		Entry("2024 December solstice", 2024, DecemberSolstice, utc(2024, 12, 21, 9, 20)),
+		Entry("2000 March equinox", 2000, MarchEquinox, utc(2000, 3, 20, 7, 35)),
+		Entry("1990 June solstice", 1990, JuneSolstice, utc(1990, 6, 21, 15, 33)),
+	)
+
+	It("should compute season lengths summing to a tropical year", func() {
+		lengths := SeasonLengths(2024)
+		days := func(d time.Duration) float64 { return d.Hours() / 24 }
+		Expect(days(lengths[0])).To(BeNumerically("~", 92.74, 0.05))
+		Expect(days(lengths[1])).To(BeNumerically("~", 93.66, 0.05))
+		Expect(days(lengths[2])).To(BeNumerically("~", 89.86, 0.05))
+		Expect(days(lengths[3])).To(BeNumerically("~", 88.99, 0.05))
+		total := lengths[0] + lengths[1] + lengths[2] + lengths[3]
+		Expect(days(total)).To(BeNumerically("~", 365.24, 0.05))
+	})
+
+	It("should find the cross-quarter days at the solar longitude midpoints", func() {
+		days := CrossQuarterDays(2024)
+		Expect(days[Imbolc]).To(BeTemporally("~", utc(2024, 2, 4, 14, 0), 24*time.Hour))
+		Expect(days[Beltane]).To(BeTemporally("~", utc(2024, 5, 5, 1, 0), 24*time.Hour))
+		Expect(days[Lughnasadh]).To(BeTemporally("~", utc(2024, 8, 7, 0, 0), 24*time.Hour))
+		Expect(days[Samhain]).To(BeTemporally("~", utc(2024, 11, 6, 20, 0), 24*time.Hour))
+		for c, day := range days {
+			longitude := solar.ApparentLongitude(julian.Centuries(astrotime.TT(day)))
+			Expect(longitude).To(BeNumerically("~", CrossQuarter(c).Longitude(), 1e-4))
+		}
+	})
+
+	It("should return the next crossing after a given time", func() {
+		t := utc(2024, 3, 21, 0, 0)
+		Expect(NextSolarLongitude(0, t).Year()).To(Equal(2025))
+		Expect(NextSolarLongitude(0, utc(2024, 3, 20, 3, 0))).To(BeTemporally("~", utc(2024, 3, 20, 3, 6), 10*time.Minute))
+	})
+
+	It("should name seasons and cross-quarter days", func() {
+		Expect(JuneSolstice.String()).To(Equal("June Solstice"))
+		Expect(Samhain.String()).To(Equal("Samhain"))
+		Expect(Imbolc.Longitude()).To(Equal(315.0))
+		Expect(Beltane.Longitude()).To(Equal(45.0))
+	})
+})
@@ -0,0 +1,169 @@
+package catalog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"github.com/ocrosby/astronomy/pkg/internal/mmap"
+	"io"
+	"math"
+	"os"
+)
+
+// Binary catalog layout: a fixed header followed by fixed-size little-endian records
+const (
+	BinaryMagic      = "ASTRCAT\x00"
+	BinaryVersion    = 1
+	BinaryHeaderSize = 32
+	BinaryIDSize     = 24
+	BinaryRecordSize = BinaryIDSize + 8*binaryFieldCount
+	binaryFieldCount = 8
+)
+
+// WriteBinary writes stars in the compact binary catalog format. Names are not stored and
+// identifiers longer than BinaryIDSize bytes are truncated.
+func WriteBinary(w io.Writer, stars []Star) error {
+	bw := bufio.NewWriter(w)
+
+	header := make([]byte, BinaryHeaderSize)
+	copy(header, BinaryMagic)
+	binary.LittleEndian.PutUint16(header[8:], BinaryVersion)
+	binary.LittleEndian.PutUint16(header[10:], BinaryRecordSize)
+	binary.LittleEndian.PutUint64(header[16:], uint64(len(stars)))
+	if _, err := bw.Write(header); err != nil {
+		return err
+	}
+
+	record := make([]byte, BinaryRecordSize)
+	for _, s := range stars {
+		encodeRecord(record, s)
+		if _, err := bw.Write(record); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// encodeRecord serializes a star into a record buffer
+func encodeRecord(record []byte, s Star) {
+	clear(record[:BinaryIDSize])
+	copy(record[:BinaryIDSize], s.ID)
+	fields := [binaryFieldCount]float64{s.RA, s.Dec, s.Magnitude, s.PMRA, s.PMDec, s.Parallax, s.RadialVelocity, s.Epoch}
+	for i, f := range fields {
+		binary.LittleEndian.PutUint64(record[BinaryIDSize+8*i:], math.Float64bits(f))
+	}
+}
+
+// decodeRecord deserializes a star from a record buffer
+func decodeRecord(record []byte) Star {
+	var fields [binaryFieldCount]float64
+	for i := range fields {
+		fields[i] = math.Float64frombits(binary.LittleEndian.Uint64(record[BinaryIDSize+8*i:]))
+	}
+	return Star{
+		ID:             string(bytes.TrimRight(record[:BinaryIDSize], "\x00")),
+		RA:             fields[0],
+		Dec:            fields[1],
+		Magnitude:      fields[2],
+		PMRA:           fields[3],
+		PMDec:          fields[4],
+		Parallax:       fields[5],
+		RadialVelocity: fields[6],
+		Epoch:          fields[7],
+	}
+}
+
+// BinaryCatalog provides lazy, memory-mapped access to a binary catalog file
+type BinaryCatalog struct {
+	file  *mmap.File
+	count int
+}
+
+// OpenBinary maps a binary catalog file; records are decoded only when accessed
+func OpenBinary(path string) (*BinaryCatalog, error) {
+	file, err := mmap.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	count, err := validateBinary(file.Bytes())
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return &BinaryCatalog{file: file, count: count}, nil
+}
+
+// validateBinary checks the header and returns the record count
+func validateBinary(data []byte) (int, error) {
+	if len(data) < BinaryHeaderSize || string(data[:len(BinaryMagic)]) != BinaryMagic {
+		return 0, fmt.Errorf("not a binary catalog file")
+	}
+	if version := binary.LittleEndian.Uint16(data[8:]); version != BinaryVersion {
+		return 0, fmt.Errorf("unsupported binary catalog version %d", version)
+	}
+	if size := binary.LittleEndian.Uint16(data[10:]); size != BinaryRecordSize {
+		return 0, fmt.Errorf("unexpected record size %d", size)
+	}
+	count := binary.LittleEndian.Uint64(data[16:])
+	if uint64(len(data)-BinaryHeaderSize)/BinaryRecordSize < count {
+		return 0, fmt.Errorf("truncated binary catalog: header declares %d records", count)
+	}
+	return int(count), nil
+}
+
+// Len returns the number of records
+func (b *BinaryCatalog) Len() int {
+	return b.count
+}
+
+// Star decodes the record at index i
+func (b *BinaryCatalog) Star(i int) (Star, error) {
+	if i < 0 || i >= b.count {
+		return Star{}, fmt.Errorf("record index %d out of range [0, %d)", i, b.count)
+	}
+	offset := BinaryHeaderSize + i*BinaryRecordSize
+	return decodeRecord(b.file.Bytes()[offset : offset+BinaryRecordSize]), nil
+}
+
+// Each calls fn for every record in order until fn returns false
+func (b *BinaryCatalog) Each(fn func(i int, s Star) bool) {
+	data := b.file.Bytes()
+	for i := 0; i < b.count; i++ {
+		offset := BinaryHeaderSize + i*BinaryRecordSize
+		if !fn(i, decodeRecord(data[offset:offset+BinaryRecordSize])) {
+			return
+		}
+	}
+}
+
+// Catalog decodes the records accepted by keep (all records when keep is nil) into an
+// indexed in-memory Catalog
+func (b *BinaryCatalog) Catalog(keep func(Star) bool) *Catalog {
+	var stars []Star
+	b.Each(func(_ int, s Star) bool {
+		if keep == nil || keep(s) {
+			stars = append(stars, s)
+		}
+		return true
+	})
+	return New(stars)
+}
+
+// Close unmaps the file
+func (b *BinaryCatalog) Close() error {
+	return b.file.Close()
+}
+
+// SaveBinary writes stars to path in the binary catalog format
+func SaveBinary(path string, stars []Star) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := WriteBinary(f, stars); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
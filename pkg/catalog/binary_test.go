@@ -0,0 +1,80 @@
+package catalog
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Binary", func() {
+	stars := []Star{
+		{ID: "HIP 91262", RA: 279.23473, Dec: 38.78369, Magnitude: 0.03, PMRA: 200.94, PMDec: 286.23, Parallax: 130.23, RadialVelocity: -13.5, Epoch: 1991.25},
+		{ID: "HIP 32349", RA: 101.28716, Dec: -16.71612, Magnitude: -1.46, Epoch: 2000.0},
+		{ID: "an identifier that is much too long", RA: 1, Dec: 2, Epoch: 2000.0},
+	}
+
+	var path string
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "stars.bin")
+		Expect(SaveBinary(path, stars)).To(Succeed())
+	})
+
+	It("should round-trip records through a mapped file", func() {
+		b, err := OpenBinary(path)
+		Expect(err).To(BeNil())
+		defer b.Close()
+
+		Expect(b.Len()).To(Equal(3))
+		s, err := b.Star(0)
+		Expect(err).To(BeNil())
+		Expect(s).To(Equal(stars[0]))
+	})
+
+	It("should truncate long identifiers", func() {
+		b, err := OpenBinary(path)
+		Expect(err).To(BeNil())
+		defer b.Close()
+
+		s, _ := b.Star(2)
+		Expect(s.ID).To(HaveLen(BinaryIDSize))
+	})
+
+	It("should reject out of range indices", func() {
+		b, err := OpenBinary(path)
+		Expect(err).To(BeNil())
+		defer b.Close()
+
+		_, err = b.Star(3)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should build a filtered catalog", func() {
+		b, err := OpenBinary(path)
+		Expect(err).To(BeNil())
+		defer b.Close()
+
+		c := b.Catalog(func(s Star) bool { return s.Magnitude < 0 })
+		Expect(c.Len()).To(Equal(1))
+		Expect(c.Stars[0].ID).To(Equal("HIP 32349"))
+	})
+
+	It("should reject files that are not catalogs", func() {
+		bad := filepath.Join(GinkgoT().TempDir(), "bad.bin")
+		Expect(os.WriteFile(bad, []byte("hello, world, this is not a catalog"), 0o644)).To(Succeed())
+		_, err := OpenBinary(bad)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("not a binary catalog"))
+	})
+
+	It("should reject truncated files", func() {
+		data, err := os.ReadFile(path)
+		Expect(err).To(BeNil())
+		Expect(os.WriteFile(path, data[:len(data)-10], 0o644)).To(Succeed())
+		_, err = OpenBinary(path)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("truncated"))
+	})
+})
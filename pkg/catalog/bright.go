@@ -0,0 +1,90 @@
+package catalog
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+//go:embed brightstars.csv
+var brightStarsCSV string
+
+var (
+	brightOnce  sync.Once
+	brightStars []Star
+)
+
+// BrightStars returns the naked-eye stars brighter than about magnitude 2.1, ordered by
+// magnitude, with IDs of the form "HR 2491". Proper motions are not included.
+// The returned slice is a copy and may be modified by the caller.
+func BrightStars() []Star {
+	brightOnce.Do(func() {
+		stars, err := parseBrightStars(brightStarsCSV)
+		if err != nil {
+			panic(fmt.Sprintf("catalog: embedded bright star data is invalid: %v", err))
+		}
+		brightStars = stars
+	})
+	return append([]Star(nil), brightStars...)
+}
+
+// parseBrightStars parses the embedded bright star CSV data
+func parseBrightStars(data string) ([]Star, error) {
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.Comment = '#'
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	stars := make([]Star, 0, len(records))
+	for _, r := range records[1:] {
+		ra, err := parseSexagesimalSeconds(r[2])
+		if err != nil {
+			return nil, fmt.Errorf("record %v: %v", r, err)
+		}
+		dec, err := parseSexagesimalSeconds(r[3])
+		if err != nil {
+			return nil, fmt.Errorf("record %v: %v", r, err)
+		}
+		magnitude, err := strconv.ParseFloat(r[4], 64)
+		if err != nil {
+			return nil, fmt.Errorf("record %v: %v", r, err)
+		}
+		stars = append(stars, Star{
+			ID:        "HR " + r[0],
+			Name:      r[1],
+			RA:        ra * hoursToDegrees,
+			Dec:       dec,
+			Magnitude: magnitude,
+			Epoch:     DefaultEpoch,
+		})
+	}
+	return stars, nil
+}
+
+// parseSexagesimalSeconds parses "±units minutes seconds.s", keeping the sign of values such as
+// "-00 12 07"
+func parseSexagesimalSeconds(s string) (float64, error) {
+	parts := strings.Fields(s)
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("expected units, minutes and seconds in '%s'", s)
+	}
+	var values [3]float64
+	for i, part := range parts {
+		v, err := strconv.ParseFloat(part, 64)
+		if err != nil {
+			return 0, err
+		}
+		values[i] = v
+	}
+	value := math.Abs(values[0]) + values[1]/60.0 + values[2]/3600.0
+	if strings.HasPrefix(parts[0], "-") {
+		value = -value
+	}
+	return value, nil
+}
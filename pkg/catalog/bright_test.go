@@ -0,0 +1,37 @@
+package catalog
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BrightStars", func() {
+	It("should embed the brightest stars in order of magnitude", func() {
+		stars := BrightStars()
+		Expect(len(stars)).To(BeNumerically(">=", 40))
+		for i := 1; i < len(stars); i++ {
+			Expect(stars[i].Magnitude).To(BeNumerically(">=", stars[i-1].Magnitude))
+		}
+	})
+
+	It("should decode Sirius", func() {
+		sirius := BrightStars()[0]
+		Expect(sirius.Name).To(Equal("Sirius"))
+		Expect(sirius.ID).To(Equal("HR 2491"))
+		Expect(sirius.RA).To(BeNumerically("~", 101.28708, 1e-4))
+		Expect(sirius.Dec).To(BeNumerically("~", -16.71611, 1e-4))
+		Expect(sirius.Magnitude).To(Equal(-1.46))
+	})
+
+	It("should keep the sign of declinations just south of the equator", func() {
+		stars := New(BrightStars())
+		alnilam := stars.Filter(func(s Star) bool { return s.Name == "Alnilam" })
+		Expect(alnilam).To(HaveLen(1))
+		Expect(alnilam[0].Dec).To(BeNumerically("~", -1.20194, 1e-4))
+	})
+
+	It("should return a copy", func() {
+		BrightStars()[0].Name = "changed"
+		Expect(BrightStars()[0].Name).To(Equal("Sirius"))
+	})
+})
@@ -0,0 +1,78 @@
+package catalog
+
+import (
+	"github.com/ocrosby/astronomy/pkg/spatial"
+)
+
+// DefaultEpoch is the Julian epoch of catalog positions when none is given
+const DefaultEpoch = 2000.0
+
+// Star is a catalog entry with astrometric and photometric data
+type Star struct {
+	ID             string
+	Name           string
+	RA             float64 // right ascension in degrees (ICRS/J2000)
+	Dec            float64 // declination in degrees (ICRS/J2000)
+	Magnitude      float64 // apparent visual magnitude
+	PMRA           float64 // proper motion in RA including cos(Dec), mas/yr
+	PMDec          float64 // proper motion in Dec, mas/yr
+	Parallax       float64 // parallax in mas
+	RadialVelocity float64 // radial velocity in km/s
+	Epoch          float64 // Julian epoch of the position
+}
+
+// Catalog holds a set of stars with a spatial index for positional queries
+type Catalog struct {
+	Stars []Star
+	index *spatial.KDTree
+}
+
+// Match is a catalog star with its separation from a query position
+type Match struct {
+	Star       Star
+	Separation float64 // degrees
+}
+
+// New creates a catalog and builds its spatial index
+func New(stars []Star) *Catalog {
+	points := make([]spatial.Point, len(stars))
+	for i, s := range stars {
+		points[i] = spatial.Point{ID: i, Direction: spatial.DirectionFromEquatorial(s.RA, s.Dec)}
+	}
+	return &Catalog{Stars: stars, index: spatial.NewKDTree(points)}
+}
+
+// Len returns the number of stars in the catalog
+func (c *Catalog) Len() int {
+	return len(c.Stars)
+}
+
+// ConeSearch returns stars within radius degrees of the position, ordered by separation
+func (c *Catalog) ConeSearch(ra, dec, radius float64) []Match {
+	found := c.index.ConeSearch(spatial.DirectionFromEquatorial(ra, dec), radius)
+	matches := make([]Match, len(found))
+	for i, m := range found {
+		matches[i] = Match{Star: c.Stars[m.ID], Separation: m.Separation}
+	}
+	return matches
+}
+
+// CrossMatch returns the star nearest to the position if it lies within radius degrees
+func (c *Catalog) CrossMatch(ra, dec, radius float64) (Match, bool) {
+	m, ok := c.index.Nearest(spatial.DirectionFromEquatorial(ra, dec))
+	if !ok || m.Separation > radius {
+		return Match{}, false
+	}
+	return Match{Star: c.Stars[m.ID], Separation: m.Separation}, true
+}
+
+// Filter returns the stars for which keep returns true
+func (c *Catalog) Filter(keep func(Star) bool) []Star {
+	var stars []Star
+	for _, s := range c.Stars {
+		if keep(s) {
+			stars = append(stars, s)
+		}
+	}
+	return stars
+}
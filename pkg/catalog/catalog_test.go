@@ -0,0 +1,43 @@
+package catalog
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Catalog", func() {
+	stars := []Star{
+		{ID: "HIP 91262", Name: "Vega", RA: 279.23473, Dec: 38.78369, Magnitude: 0.03},
+		{ID: "HIP 97649", Name: "Altair", RA: 297.69583, Dec: 8.86832, Magnitude: 0.76},
+		{ID: "HIP 102098", Name: "Deneb", RA: 310.35798, Dec: 45.28034, Magnitude: 1.25},
+		{ID: "HIP 32349", Name: "Sirius", RA: 101.28716, Dec: -16.71612, Magnitude: -1.46},
+	}
+	c := New(stars)
+
+	It("should report its size", func() {
+		Expect(c.Len()).To(Equal(4))
+	})
+
+	It("should find stars in a cone", func() {
+		matches := c.ConeSearch(300.0, 30.0, 25.0)
+		Expect(matches).To(HaveLen(3))
+		Expect(matches[0].Star.Name).To(Equal("Deneb"))
+	})
+
+	It("should cross-match a nearby position", func() {
+		m, ok := c.CrossMatch(279.2350, 38.7840, 1.0/60.0)
+		Expect(ok).To(BeTrue())
+		Expect(m.Star.Name).To(Equal("Vega"))
+		Expect(m.Separation).To(BeNumerically("<", 2.0/3600.0))
+	})
+
+	It("should not cross-match beyond the radius", func() {
+		_, ok := c.CrossMatch(0.0, 0.0, 1.0)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should filter stars", func() {
+		bright := c.Filter(func(s Star) bool { return s.Magnitude < 0.5 })
+		Expect(bright).To(HaveLen(2))
+	})
+})
@@ -0,0 +1,204 @@
+package catalog
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ObjectType classifies deep-sky objects
+type ObjectType int
+
+const (
+	Galaxy ObjectType = iota
+	GlobularCluster
+	OpenCluster
+	Nebula
+	PlanetaryNebula
+	SupernovaRemnant
+	StarCloud
+	Asterism
+	DoubleStarObject
+)
+
+// objectTypeCodes are the abbreviations used in the embedded data, indexed by ObjectType
+var objectTypeCodes = [...]string{"Gal", "GC", "OC", "Neb", "PN", "SNR", "StarCloud", "Ast", "Dbl"}
+
+// String returns a readable name for the object type
+func (t ObjectType) String() string {
+	return [...]string{"Galaxy", "Globular Cluster", "Open Cluster", "Nebula", "Planetary Nebula",
+		"Supernova Remnant", "Star Cloud", "Asterism", "Double Star"}[t]
+}
+
+// DeepSkyObject is an entry of the embedded deep-sky catalog
+type DeepSkyObject struct {
+	Messier       int // Messier number, 0 when not a Messier object
+	NGC           string
+	Name          string
+	Type          ObjectType
+	Constellation string
+	RA            float64 // right ascension in degrees (J2000)
+	Dec           float64 // declination in degrees (J2000)
+	Magnitude     float64 // integrated visual magnitude
+	MajorAxis     float64 // apparent size in arcminutes
+	MinorAxis     float64 // apparent size in arcminutes
+}
+
+// Designation returns the primary catalog designation, e.g. "M31"
+func (o DeepSkyObject) Designation() string {
+	if o.Messier > 0 {
+		return fmt.Sprintf("M%d", o.Messier)
+	}
+	return o.NGC
+}
+
+//go:embed messier.csv
+var messierCSV string
+
+var (
+	messierOnce    sync.Once
+	messierObjects []DeepSkyObject
+)
+
+// Messier returns the 110 objects of the Messier catalog ordered by number.
+// The returned slice is a copy and may be modified by the caller.
+func Messier() []DeepSkyObject {
+	messierOnce.Do(func() {
+		objects, err := parseDeepSky(messierCSV)
+		if err != nil {
+			panic(fmt.Sprintf("catalog: embedded Messier data is invalid: %v", err))
+		}
+		messierObjects = objects
+	})
+	return append([]DeepSkyObject(nil), messierObjects...)
+}
+
+// MessierObject returns the Messier object with the given number
+func MessierObject(number int) (DeepSkyObject, bool) {
+	objects := Messier()
+	if number < 1 || number > len(objects) {
+		return DeepSkyObject{}, false
+	}
+	return objects[number-1], true
+}
+
+// FindDeepSky looks up an object by Messier designation ("M31", "M 31"), NGC/IC
+// designation ("NGC 224") or common name ("Andromeda Galaxy"), ignoring case
+func FindDeepSky(query string) (DeepSkyObject, bool) {
+	key := normalizeDesignation(query)
+	for _, o := range Messier() {
+		if key == normalizeDesignation(o.Designation()) ||
+			(o.NGC != "" && key == normalizeDesignation(o.NGC)) ||
+			(o.Name != "" && key == normalizeDesignation(o.Name)) {
+			return o, true
+		}
+	}
+	return DeepSkyObject{}, false
+}
+
+// DeepSkyByType returns the Messier objects of the given type
+func DeepSkyByType(t ObjectType) []DeepSkyObject {
+	var objects []DeepSkyObject
+	for _, o := range Messier() {
+		if o.Type == t {
+			objects = append(objects, o)
+		}
+	}
+	return objects
+}
+
+// normalizeDesignation removes spaces and case so "m 31" matches "M31"
+func normalizeDesignation(s string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(s), ""))
+}
+
+// parseDeepSky parses the embedded deep-sky CSV data
+func parseDeepSky(data string) ([]DeepSkyObject, error) {
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.Comment = '#'
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]DeepSkyObject, 0, len(records))
+	for _, r := range records[1:] {
+		o, err := parseDeepSkyRecord(r)
+		if err != nil {
+			return nil, fmt.Errorf("record %v: %v", r, err)
+		}
+		objects = append(objects, o)
+	}
+	return objects, nil
+}
+
+// parseDeepSkyRecord converts one CSV record into a DeepSkyObject
+func parseDeepSkyRecord(r []string) (DeepSkyObject, error) {
+	number, err := strconv.Atoi(r[0])
+	if err != nil {
+		return DeepSkyObject{}, err
+	}
+	objectType := -1
+	for i, code := range objectTypeCodes {
+		if code == r[3] {
+			objectType = i
+		}
+	}
+	if objectType < 0 {
+		return DeepSkyObject{}, fmt.Errorf("unknown object type '%s'", r[3])
+	}
+
+	ra, err := parseSexagesimalMinutes(r[5])
+	if err != nil {
+		return DeepSkyObject{}, err
+	}
+	dec, err := parseSexagesimalMinutes(r[6])
+	if err != nil {
+		return DeepSkyObject{}, err
+	}
+
+	var numbers [3]float64
+	for i := range numbers {
+		if numbers[i], err = strconv.ParseFloat(r[7+i], 64); err != nil {
+			return DeepSkyObject{}, err
+		}
+	}
+
+	return DeepSkyObject{
+		Messier:       number,
+		NGC:           r[1],
+		Name:          r[2],
+		Type:          ObjectType(objectType),
+		Constellation: r[4],
+		RA:            ra * hoursToDegrees,
+		Dec:           dec,
+		Magnitude:     numbers[0],
+		MajorAxis:     numbers[1],
+		MinorAxis:     numbers[2],
+	}, nil
+}
+
+// parseSexagesimalMinutes parses "±units minutes.m", keeping the sign of values such as "-00 49"
+func parseSexagesimalMinutes(s string) (float64, error) {
+	parts := strings.Fields(s)
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("expected units and minutes in '%s'", s)
+	}
+	units, err := strconv.ParseFloat(parts[0], 64)
+	if err != nil {
+		return 0, err
+	}
+	minutes, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil {
+		return 0, err
+	}
+	value := math.Abs(units) + minutes/60.0
+	if strings.HasPrefix(parts[0], "-") {
+		value = -value
+	}
+	return value, nil
+}
@@ -0,0 +1,71 @@
+package catalog
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DeepSky", func() {
+	It("should embed all 110 Messier objects in order", func() {
+		objects := Messier()
+		Expect(objects).To(HaveLen(110))
+		for i, o := range objects {
+			Expect(o.Messier).To(Equal(i + 1))
+			Expect(o.Dec).To(BeNumerically(">=", -90.0))
+			Expect(o.Dec).To(BeNumerically("<=", 90.0))
+			Expect(o.RA).To(BeNumerically(">=", 0.0))
+			Expect(o.RA).To(BeNumerically("<", 360.0))
+		}
+	})
+
+	It("should decode M31", func() {
+		m31, ok := MessierObject(31)
+		Expect(ok).To(BeTrue())
+		Expect(m31.Name).To(Equal("Andromeda Galaxy"))
+		Expect(m31.Type).To(Equal(Galaxy))
+		Expect(m31.RA).To(BeNumerically("~", 10.675, 1e-9))
+		Expect(m31.Dec).To(BeNumerically("~", 41.266667, 1e-6))
+		Expect(m31.MajorAxis).To(Equal(178.0))
+	})
+
+	It("should keep the sign of declinations just south of the equator", func() {
+		m2, _ := MessierObject(2)
+		Expect(m2.Dec).To(BeNumerically("~", -49.0/60.0, 1e-9))
+	})
+
+	It("should reject numbers outside the catalog", func() {
+		_, ok := MessierObject(111)
+		Expect(ok).To(BeFalse())
+	})
+
+	DescribeTable("finds objects by designation or name",
+		func(query string, expected int) {
+			o, ok := FindDeepSky(query)
+			Expect(ok).To(BeTrue())
+			Expect(o.Messier).To(Equal(expected))
+		},
+		Entry("Messier number", "M42", 42),
+		Entry("spaced lower case", "m 13", 13),
+		Entry("NGC designation", "NGC 224", 31),
+		Entry("NGC without space", "ngc5194", 51),
+		Entry("IC designation", "IC 4715", 24),
+		Entry("common name", "ring nebula", 57),
+	)
+
+	It("should not find unknown objects", func() {
+		_, ok := FindDeepSky("NGC 7000")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should list objects by type", func() {
+		Expect(DeepSkyByType(Galaxy)).To(HaveLen(40))
+		Expect(DeepSkyByType(PlanetaryNebula)).To(HaveLen(4))
+	})
+
+	It("should describe designations and types", func() {
+		m45, _ := MessierObject(45)
+		Expect(m45.Designation()).To(Equal("M45"))
+		Expect(m45.Type.String()).To(Equal("Open Cluster"))
+		Expect(DeepSkyObject{NGC: "NGC 7000"}.Designation()).To(Equal("NGC 7000"))
+	})
+})
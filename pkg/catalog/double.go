@@ -0,0 +1,79 @@
+package catalog
+
+import (
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/orbits"
+	"math"
+	"time"
+)
+
+// BinaryOrbit holds the Campbell elements of a visual binary orbit
+type BinaryOrbit struct {
+	Period        float64 // years
+	Periastron    float64 // epoch of periastron passage (year)
+	Eccentricity  float64
+	SemiMajorAxis float64 // arcseconds
+	Inclination   float64 // degrees
+	Node          float64 // position angle of the ascending node in degrees
+	ArgPeriastron float64 // argument of periastron in degrees
+}
+
+// Position returns the separation in arcseconds and position angle in degrees of the
+// companion at epoch (a fractional year), following Meeus, Astronomical Algorithms, ch. 57
+func (o BinaryOrbit) Position(epoch float64) (separation, positionAngle float64) {
+	meanAnomaly := 2 * math.Pi / o.Period * (epoch - o.Periastron)
+	e := orbits.SolveKepler(meanAnomaly, o.Eccentricity)
+	r := o.SemiMajorAxis * (1 - o.Eccentricity*math.Cos(e))
+	nu := orbits.TrueAnomaly(e, o.Eccentricity)
+
+	u := nu + o.ArgPeriastron*constants.Rad
+	i := o.Inclination * constants.Rad
+	theta := math.Atan2(math.Sin(u)*math.Cos(i), math.Cos(u))
+	separation = r * math.Hypot(math.Cos(u), math.Sin(u)*math.Cos(i))
+	positionAngle = angles.NormalizeDegrees(theta*constants.Deg + o.Node)
+	return separation, positionAngle
+}
+
+// DoubleStar is a double star measurement: the companion's separation and position angle
+// (measured from north through east) at a given epoch
+type DoubleStar struct {
+	ID            string
+	Name          string
+	Components    string  // component designation, e.g. "AB"
+	RA            float64 // right ascension of the primary in degrees (J2000)
+	Dec           float64 // declination of the primary in degrees (J2000)
+	Separation    float64 // arcseconds
+	PositionAngle float64 // degrees
+	Epoch         float64 // fractional year of the measurement
+	Magnitude     float64 // visual magnitude of the primary
+	Companion     float64 // visual magnitude of the companion
+
+	// Optional motion models. Orbit takes precedence; otherwise the rates extrapolate
+	// the measurement linearly.
+	Orbit             *BinaryOrbit
+	SeparationRate    float64 // arcseconds per year
+	PositionAngleRate float64 // degrees per year
+}
+
+// At predicts the separation in arcseconds and position angle in degrees at epoch
+func (d DoubleStar) At(epoch float64) (separation, positionAngle float64) {
+	if d.Orbit != nil {
+		return d.Orbit.Position(epoch)
+	}
+	years := epoch - d.Epoch
+	separation = math.Max(0, d.Separation+d.SeparationRate*years)
+	positionAngle = angles.NormalizeDegrees(d.PositionAngle + d.PositionAngleRate*years)
+	return separation, positionAngle
+}
+
+// AtTime predicts the separation in arcseconds and position angle in degrees at t
+func (d DoubleStar) AtTime(t time.Time) (separation, positionAngle float64) {
+	return d.At(julian.Epoch(julian.FromTime(t)))
+}
+
+// DeltaMagnitude returns the magnitude difference between the companion and the primary
+func (d DoubleStar) DeltaMagnitude() float64 {
+	return d.Companion - d.Magnitude
+}
@@ -0,0 +1,64 @@
+package catalog
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DoubleStar", func() {
+	// Meeus, Astronomical Algorithms, example 57.a: eta Coronae Borealis in 1980.0
+	etaCrB := BinaryOrbit{
+		Period:        41.623,
+		Periastron:    1934.008,
+		Eccentricity:  0.2763,
+		SemiMajorAxis: 0.907,
+		Inclination:   59.025,
+		Node:          23.717,
+		ArgPeriastron: 219.907,
+	}
+
+	It("should predict the position from orbital elements", func() {
+		separation, positionAngle := etaCrB.Position(1980.0)
+		Expect(separation).To(BeNumerically("~", 0.411, 0.001))
+		Expect(positionAngle).To(BeNumerically("~", 318.4, 0.1))
+	})
+
+	It("should repeat after one period", func() {
+		s1, p1 := etaCrB.Position(1980.0)
+		s2, p2 := etaCrB.Position(1980.0 + etaCrB.Period)
+		Expect(s2).To(BeNumerically("~", s1, 1e-9))
+		Expect(p2).To(BeNumerically("~", p1, 1e-9))
+	})
+
+	It("should prefer the orbit over linear rates", func() {
+		d := DoubleStar{Separation: 5, PositionAngle: 10, Epoch: 1980, SeparationRate: 1, Orbit: &etaCrB}
+		separation, _ := d.At(1980.0)
+		Expect(separation).To(BeNumerically("~", 0.411, 0.001))
+	})
+
+	It("should extrapolate linear motion and wrap the position angle", func() {
+		d := DoubleStar{Separation: 2.0, PositionAngle: 355, Epoch: 2000, SeparationRate: 0.01, PositionAngleRate: 1}
+		separation, positionAngle := d.At(2010)
+		Expect(separation).To(BeNumerically("~", 2.1, 1e-12))
+		Expect(positionAngle).To(BeNumerically("~", 5, 1e-12))
+	})
+
+	It("should return the measurement for a fixed pair", func() {
+		d := DoubleStar{Separation: 14.4, PositionAngle: 153, Epoch: 2016}
+		separation, positionAngle := d.AtTime(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+		Expect(separation).To(Equal(14.4))
+		Expect(positionAngle).To(Equal(153.0))
+	})
+
+	It("should not predict a negative separation", func() {
+		d := DoubleStar{Separation: 0.1, Epoch: 2000, SeparationRate: -0.05}
+		separation, _ := d.At(2010)
+		Expect(separation).To(Equal(0.0))
+	})
+
+	It("should compute the magnitude difference", func() {
+		Expect(DoubleStar{Magnitude: 3.1, Companion: 5.1}.DeltaMagnitude()).To(BeNumerically("~", 2.0, 1e-12))
+	})
+})
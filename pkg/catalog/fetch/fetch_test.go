@@ -0,0 +1,113 @@
+package fetch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Fetcher", func() {
+	var (
+		server   *httptest.Server
+		requests int
+		query    string
+	)
+
+	BeforeEach(func() {
+		requests = 0
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			query = r.URL.Query().Get("QUERY")
+			w.Header().Set("Content-Type", "text/csv")
+			_, _ = w.Write([]byte("HIP,RAdeg,DEdeg,Vmag,pmRA,pmDE,Plx\n" +
+				"91262,279.23473479,38.78368896,0.03,201.02,287.46,128.93\n" +
+				"32349,101.28715533,-16.71611586,-1.44,-546.01,-1223.08,379.21\n"))
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	It("should download, cache and open a catalog", func() {
+		f := NewFetcher(Hipparcos, 1.0, GinkgoT().TempDir())
+		f.Endpoint = server.URL
+
+		c, err := f.Open(context.Background())
+		Expect(err).To(BeNil())
+		defer c.Close()
+
+		Expect(c.Len()).To(Equal(2))
+		s, _ := c.Star(1)
+		Expect(s.ID).To(Equal("32349"))
+		Expect(s.Magnitude).To(Equal(-1.44))
+		Expect(query).To(ContainSubstring("Vmag < 1"))
+		Expect(f.CachePath()).To(HaveSuffix("hipparcos-mag1.bin"))
+	})
+
+	It("should reuse the cached file", func() {
+		f := NewFetcher(Hipparcos, 1.0, GinkgoT().TempDir())
+		f.Endpoint = server.URL
+
+		c, err := f.Open(context.Background())
+		Expect(err).To(BeNil())
+		c.Close()
+		c, err = f.Open(context.Background())
+		Expect(err).To(BeNil())
+		c.Close()
+		Expect(requests).To(Equal(1))
+	})
+
+	It("should report HTTP errors", func() {
+		failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "service unavailable", http.StatusServiceUnavailable)
+		}))
+		defer failing.Close()
+
+		f := NewFetcher(GaiaDR3, 6.0, GinkgoT().TempDir())
+		f.Endpoint = failing.URL
+		err := f.Download(context.Background())
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("503"))
+	})
+
+	It("should convert Gaia G magnitudes to V", func() {
+		gaia := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/csv")
+			_, _ = w.Write([]byte("source_id,ra,dec,phot_g_mean_mag,bp_rp,pmra,pmdec,parallax,radial_velocity,ref_epoch\n" +
+				"1,10.0,20.0,5.0,0.0,0,0,10,0,2016.0\n" +
+				"2,11.0,21.0,5.0,,0,0,10,0,2016.0\n" +
+				"3,12.0,22.0,5.5,3.0,0,0,10,0,2016.0\n"))
+		}))
+		defer gaia.Close()
+
+		f := NewFetcher(GaiaDR3, 6.0, GinkgoT().TempDir())
+		f.Endpoint = gaia.URL
+		c, err := f.Open(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		defer c.Close()
+
+		// the red star is some 1.4 mag fainter in V than in G and falls beyond the limit
+		Expect(c.Len()).To(Equal(2))
+		blue, _ := c.Star(0)
+		Expect(blue.Magnitude).To(BeNumerically("~", 5.02704, 1e-5))
+		uncoloured, _ := c.Star(1)
+		Expect(uncoloured.Magnitude).To(BeNumerically("~", GaiaGToV(5, 0.82), 1e-6))
+		Expect(GaiaGToV(5.5, 3)).To(BeNumerically(">", 6))
+	})
+
+	It("should clamp colours to the range of the G to V relation", func() {
+		Expect(GaiaGToV(10, 7)).To(Equal(GaiaGToV(10, 5)))
+		Expect(GaiaGToV(10, -1)).To(Equal(GaiaGToV(10, -0.5)))
+		// a solar-type star is about 0.16 mag fainter in V
+		Expect(GaiaGToV(10, 0.82) - 10).To(BeNumerically("~", 0.16, 0.01))
+	})
+
+	It("should build a Gaia query with the magnitude limit", func() {
+		q := GaiaDR3.query(12.5)
+		Expect(q).To(ContainSubstring("gaiadr3.gaia_source"))
+		Expect(q).To(ContainSubstring("bp_rp"))
+		Expect(strings.HasSuffix(q, "phot_g_mean_mag < 12.5")).To(BeTrue())
+	})
+})
@@ -0,0 +1,297 @@
+package catalog
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Unit describes how a positional column is expressed
+type Unit int
+
+const (
+	UnitDegrees Unit = iota // decimal or sexagesimal degrees
+	UnitHours               // decimal or sexagesimal hours (right ascension)
+)
+
+// hoursToDegrees converts hours of right ascension to degrees
+const hoursToDegrees = 15.0
+
+// ColumnMapping maps catalog fields to column names in the source data.
+// Empty names leave the corresponding field at its zero value.
+type ColumnMapping struct {
+	ID             string
+	Name           string
+	RA             string
+	Dec            string
+	Magnitude      string
+	PMRA           string
+	PMDec          string
+	Parallax       string
+	RadialVelocity string
+	Epoch          string
+	RAUnit         Unit
+	Packed         bool // RA and Dec are packed as HHMMSS.s and ±DDMMSS.s, see angles.WithPacked
+	Delimiter      rune
+	Comment        rune
+}
+
+// DefaultColumnMapping returns the mapping for the canonical column names
+func DefaultColumnMapping() ColumnMapping {
+	return ColumnMapping{
+		ID:             "id",
+		Name:           "name",
+		RA:             "ra",
+		Dec:            "dec",
+		Magnitude:      "mag",
+		PMRA:           "pmra",
+		PMDec:          "pmdec",
+		Parallax:       "parallax",
+		RadialVelocity: "rv",
+		Epoch:          "epoch",
+		RAUnit:         UnitDegrees,
+		Delimiter:      ',',
+		Comment:        '#',
+	}
+}
+
+// columns resolves mapping names to column positions
+type columns struct {
+	id, name, ra, dec, mag, pmra, pmdec, parallax, rv, epoch int
+}
+
+// resolve finds the position of each mapped column in header (case-insensitive)
+func (m ColumnMapping) resolve(header []string) (columns, error) {
+	lookup := make(map[string]int, len(header))
+	for i, h := range header {
+		lookup[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	find := func(name string) int {
+		if name == "" {
+			return -1
+		}
+		if i, ok := lookup[strings.ToLower(name)]; ok {
+			return i
+		}
+		return -1
+	}
+
+	cols := columns{
+		id: find(m.ID), name: find(m.Name), ra: find(m.RA), dec: find(m.Dec), mag: find(m.Magnitude),
+		pmra: find(m.PMRA), pmdec: find(m.PMDec), parallax: find(m.Parallax), rv: find(m.RadialVelocity),
+		epoch: find(m.Epoch),
+	}
+	if cols.ra < 0 || cols.dec < 0 {
+		return cols, fmt.Errorf("required position columns '%s' and '%s' not found in header", m.RA, m.Dec)
+	}
+	return cols, nil
+}
+
+// star builds a Star from a row of fields
+func (m ColumnMapping) star(cols columns, fields []string) (Star, error) {
+	field := func(i int) string {
+		if i < 0 || i >= len(fields) {
+			return ""
+		}
+		return strings.TrimSpace(fields[i])
+	}
+
+	s := Star{ID: field(cols.id), Name: field(cols.name), Epoch: DefaultEpoch}
+
+	ra, err := parseAngleField(field(cols.ra), "ra", m.Packed)
+	if err != nil {
+		return s, err
+	}
+	if m.RAUnit == UnitHours {
+		ra *= hoursToDegrees
+	}
+	dec, err := parseAngleField(field(cols.dec), "dec", m.Packed)
+	if err != nil {
+		return s, err
+	}
+	if dec < -90 || dec > 90 {
+		return s, fmt.Errorf("dec %.6f out of range [-90, 90]", dec)
+	}
+	s.RA = angles.NormalizeDegrees(ra)
+	s.Dec = dec
+
+	optional := []struct {
+		index int
+		name  string
+		dest  *float64
+	}{
+		{cols.mag, "magnitude", &s.Magnitude},
+		{cols.pmra, "pmra", &s.PMRA},
+		{cols.pmdec, "pmdec", &s.PMDec},
+		{cols.parallax, "parallax", &s.Parallax},
+		{cols.rv, "radial velocity", &s.RadialVelocity},
+		{cols.epoch, "epoch", &s.Epoch},
+	}
+	for _, o := range optional {
+		text := field(o.index)
+		if text == "" {
+			continue
+		}
+		value, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return s, fmt.Errorf("invalid %s '%s': %v", o.name, text, err)
+		}
+		*o.dest = value
+	}
+	return s, nil
+}
+
+// parseAngleField parses a decimal or space/colon-separated sexagesimal value, or packed digits
+// read in the column's own unit
+func parseAngleField(text, name string, packed bool) (float64, error) {
+	if text == "" {
+		return 0, fmt.Errorf("missing %s value", name)
+	}
+	var opts []angles.ParseOption
+	if packed {
+		opts = append(opts, angles.WithPacked())
+	}
+	a, err := angles.ParseAngle(strings.ReplaceAll(text, ":", " "), opts...)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %v", name, err)
+	}
+	return a.Degrees(), nil
+}
+
+// LoadCSV reads stars from delimited text with a header row, using mapping to locate columns
+func LoadCSV(r io.Reader, mapping ColumnMapping) ([]Star, error) {
+	reader := csv.NewReader(r)
+	if mapping.Delimiter != 0 {
+		reader.Comma = mapping.Delimiter
+	}
+	reader.Comment = mapping.Comment
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading CSV header: %v", err)
+	}
+	cols, err := mapping.resolve(header)
+	if err != nil {
+		return nil, err
+	}
+
+	var stars []Star
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return stars, nil
+		}
+		if err != nil {
+			return stars, fmt.Errorf("reading CSV: %v", err)
+		}
+		line, _ := reader.FieldPos(0)
+		s, err := mapping.star(cols, fields)
+		if err != nil {
+			return stars, fmt.Errorf("line %d: %v", line, err)
+		}
+		stars = append(stars, s)
+	}
+}
+
+// VOTable UCDs used to locate columns when the mapping names do not match
+const (
+	ucdID       = "meta.id;meta.main"
+	ucdRA       = "pos.eq.ra;meta.main"
+	ucdDec      = "pos.eq.dec;meta.main"
+	ucdMag      = "phot.mag;em.opt.v"
+	ucdPMRA     = "pos.pm;pos.eq.ra"
+	ucdPMDec    = "pos.pm;pos.eq.dec"
+	ucdParallax = "pos.parallax"
+	ucdRV       = "spect.dopplerVeloc.opt"
+)
+
+// voTable models the subset of the VOTable schema read by LoadVOTable
+type voTable struct {
+	Resources []struct {
+		Tables []struct {
+			Fields []struct {
+				Name string `xml:"name,attr"`
+				ID   string `xml:"ID,attr"`
+				UCD  string `xml:"ucd,attr"`
+				Unit string `xml:"unit,attr"`
+			} `xml:"FIELD"`
+			Rows []struct {
+				Cells []string `xml:"TD"`
+			} `xml:"DATA>TABLEDATA>TR"`
+		} `xml:"TABLE"`
+	} `xml:"RESOURCE"`
+}
+
+// LoadVOTable reads stars from the first table of a VOTable document using TABLEDATA
+// serialization. Columns are located by mapping name first and then by UCD, and a unit of
+// "h" on the right ascension field selects hours.
+func LoadVOTable(r io.Reader, mapping ColumnMapping) ([]Star, error) {
+	var doc voTable
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("decoding VOTable: %v", err)
+	}
+	if len(doc.Resources) == 0 || len(doc.Resources[0].Tables) == 0 {
+		return nil, fmt.Errorf("VOTable contains no TABLE element")
+	}
+	table := doc.Resources[0].Tables[0]
+
+	header := make([]string, len(table.Fields))
+	byUCD := make(map[string]string)
+	for i, f := range table.Fields {
+		header[i] = f.Name
+		if header[i] == "" {
+			header[i] = f.ID
+		}
+		if f.UCD != "" {
+			if _, seen := byUCD[f.UCD]; !seen {
+				byUCD[f.UCD] = header[i]
+			}
+		}
+	}
+
+	resolved := mapping
+	present := make(map[string]bool, len(header))
+	for _, h := range header {
+		present[strings.ToLower(h)] = true
+	}
+	fallback := func(name *string, ucd string) {
+		if present[strings.ToLower(*name)] {
+			return
+		}
+		if alt, ok := byUCD[ucd]; ok {
+			*name = alt
+		}
+	}
+	fallback(&resolved.ID, ucdID)
+	fallback(&resolved.RA, ucdRA)
+	fallback(&resolved.Dec, ucdDec)
+	fallback(&resolved.Magnitude, ucdMag)
+	fallback(&resolved.PMRA, ucdPMRA)
+	fallback(&resolved.PMDec, ucdPMDec)
+	fallback(&resolved.Parallax, ucdParallax)
+	fallback(&resolved.RadialVelocity, ucdRV)
+
+	cols, err := resolved.resolve(header)
+	if err != nil {
+		return nil, err
+	}
+	if unit := table.Fields[cols.ra].Unit; unit == "h" || unit == "hour" {
+		resolved.RAUnit = UnitHours
+	}
+
+	stars := make([]Star, 0, len(table.Rows))
+	for i, row := range table.Rows {
+		s, err := resolved.star(cols, row.Cells)
+		if err != nil {
+			return stars, fmt.Errorf("row %d: %v", i+1, err)
+		}
+		stars = append(stars, s)
+	}
+	return stars, nil
+}
@@ -0,0 +1,119 @@
+package catalog
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Import", func() {
+	Describe("LoadCSV", func() {
+		It("should load the default column layout", func() {
+			data := `id,name,ra,dec,mag,pmra,pmdec,parallax,rv
+# bright stars
+HIP 91262,Vega,279.23473,38.78369,0.03,200.94,286.23,130.23,-13.5
+HIP 32349,Sirius,101.28716,-16.71612,-1.46,-546.01,-1223.07,379.21,-5.5
+`
+			stars, err := LoadCSV(strings.NewReader(data), DefaultColumnMapping())
+			Expect(err).To(BeNil())
+			Expect(stars).To(HaveLen(2))
+			Expect(stars[0].Name).To(Equal("Vega"))
+			Expect(stars[0].PMDec).To(Equal(286.23))
+			Expect(stars[1].Parallax).To(Equal(379.21))
+			Expect(stars[1].Epoch).To(Equal(DefaultEpoch))
+		})
+
+		It("should apply a custom mapping with sexagesimal hours", func() {
+			data := "Star;RAJ2000;DEJ2000;Vmag\nVega;18:36:56.34;+38:47:01.3;0.03\n"
+			mapping := ColumnMapping{Name: "star", RA: "RAJ2000", Dec: "DEJ2000", Magnitude: "vmag",
+				RAUnit: UnitHours, Delimiter: ';'}
+			stars, err := LoadCSV(strings.NewReader(data), mapping)
+			Expect(err).To(BeNil())
+			Expect(stars).To(HaveLen(1))
+			Expect(stars[0].RA).To(BeNumerically("~", 279.23475, 1e-4))
+			Expect(stars[0].Dec).To(BeNumerically("~", 38.78369, 1e-4))
+			Expect(stars[0].Magnitude).To(Equal(0.03))
+		})
+
+		It("should read packed coordinates", func() {
+			data := "name,ra,dec\nVega,183656.34,+384701.3\n"
+			mapping := DefaultColumnMapping()
+			mapping.RAUnit, mapping.Packed = UnitHours, true
+			stars, err := LoadCSV(strings.NewReader(data), mapping)
+			Expect(err).To(BeNil())
+			Expect(stars[0].RA).To(BeNumerically("~", 279.23475, 1e-4))
+			Expect(stars[0].Dec).To(BeNumerically("~", 38.78369, 1e-4))
+		})
+
+		It("should require position columns", func() {
+			_, err := LoadCSV(strings.NewReader("name,mag\nVega,0.03\n"), DefaultColumnMapping())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("not found in header"))
+		})
+
+		It("should report the line of a malformed row", func() {
+			data := "ra,dec,mag\n10,20,1\n10,95,2\n"
+			_, err := LoadCSV(strings.NewReader(data), DefaultColumnMapping())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("line 3"))
+			Expect(err.Error()).To(ContainSubstring("out of range"))
+		})
+
+		It("should reject non-numeric magnitudes", func() {
+			_, err := LoadCSV(strings.NewReader("ra,dec,mag\n10,20,bright\n"), DefaultColumnMapping())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid magnitude"))
+		})
+	})
+
+	Describe("LoadVOTable", func() {
+		votable := `<?xml version="1.0"?>
+<VOTABLE version="1.4" xmlns="http://www.ivoa.net/xml/VOTable/v1.3">
+  <RESOURCE>
+    <TABLE>
+      <FIELD name="HIP" ucd="meta.id;meta.main" datatype="int"/>
+      <FIELD name="RAdeg" ucd="pos.eq.ra;meta.main" unit="deg" datatype="double"/>
+      <FIELD name="DEdeg" ucd="pos.eq.dec;meta.main" unit="deg" datatype="double"/>
+      <FIELD name="Vmag" ucd="phot.mag;em.opt.V" datatype="float"/>
+      <FIELD name="Plx" ucd="pos.parallax" unit="mas" datatype="float"/>
+      <DATA>
+        <TABLEDATA>
+          <TR><TD>91262</TD><TD>279.23473</TD><TD>38.78369</TD><TD>0.03</TD><TD>130.23</TD></TR>
+          <TR><TD>32349</TD><TD>101.28716</TD><TD>-16.71612</TD><TD>-1.46</TD><TD>379.21</TD></TR>
+        </TABLEDATA>
+      </DATA>
+    </TABLE>
+  </RESOURCE>
+</VOTABLE>`
+
+		It("should locate columns by UCD", func() {
+			stars, err := LoadVOTable(strings.NewReader(votable), DefaultColumnMapping())
+			Expect(err).To(BeNil())
+			Expect(stars).To(HaveLen(2))
+			Expect(stars[0].ID).To(Equal("91262"))
+			Expect(stars[0].RA).To(Equal(279.23473))
+			Expect(stars[1].Dec).To(Equal(-16.71612))
+			Expect(stars[1].Parallax).To(Equal(379.21))
+		})
+
+		It("should prefer explicitly mapped names", func() {
+			mapping := DefaultColumnMapping()
+			mapping.Magnitude = "Vmag"
+			stars, err := LoadVOTable(strings.NewReader(votable), mapping)
+			Expect(err).To(BeNil())
+			Expect(stars[1].Magnitude).To(Equal(-1.46))
+		})
+
+		It("should reject documents without a table", func() {
+			_, err := LoadVOTable(strings.NewReader("<VOTABLE><RESOURCE/></VOTABLE>"), DefaultColumnMapping())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("no TABLE"))
+		})
+
+		It("should reject malformed XML", func() {
+			_, err := LoadVOTable(strings.NewReader("<VOTABLE>"), DefaultColumnMapping())
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,188 @@
+package catalog
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ListEntry is a target of an observing list
+type ListEntry struct {
+	Designations  []string // catalog designations, primary first, as "M31", "NGC 224" or "HR 2491"
+	Name          string   // common name, empty when there is none
+	Type          string   // kind of object, as "Star" or "Galaxy"
+	Constellation string   // IAU abbreviation, empty when unknown
+	RA            float64  // right ascension in degrees (J2000)
+	Dec           float64  // declination in degrees (J2000)
+	Magnitude     float64  // apparent visual magnitude
+}
+
+// ListEntry returns the star as an observing list target
+func (s Star) ListEntry() ListEntry {
+	e := ListEntry{Name: s.Name, Type: "Star", RA: s.RA, Dec: s.Dec, Magnitude: s.Magnitude}
+	if s.ID != "" {
+		e.Designations = []string{s.ID}
+	}
+	return e
+}
+
+// ListEntry returns the object as an observing list target, with its Messier and NGC numbers
+func (o DeepSkyObject) ListEntry() ListEntry {
+	e := ListEntry{Name: o.Name, Type: o.Type.String(), Constellation: o.Constellation,
+		RA: o.RA, Dec: o.Dec, Magnitude: o.Magnitude}
+	if o.Messier > 0 {
+		e.Designations = append(e.Designations, o.Designation())
+	}
+	if o.NGC != "" {
+		e.Designations = append(e.Designations, o.NGC)
+	}
+	return e
+}
+
+// label returns the primary designation, or the name when there is none
+func (e ListEntry) label() string {
+	if len(e.Designations) > 0 {
+		return e.Designations[0]
+	}
+	return e.Name
+}
+
+// ObservingList is a named list of targets to export to planetarium programs, as chosen from
+// the catalogs or from the windows found by the planner
+type ObservingList struct {
+	Name        string
+	Description string
+	Created     time.Time // shown by Stellarium as the creation date; now when zero
+	Entries     []ListEntry
+}
+
+// stellariumObject is an entry of a Stellarium observing list
+type stellariumObject struct {
+	Constellation string `json:"constellation"`
+	Dec           string `json:"dec"`
+	Designation   string `json:"designation"`
+	Magnitude     string `json:"magnitude"`
+	Name          string `json:"name"`
+	NameI18n      string `json:"nameI18n"`
+	ObjType       string `json:"objtype"`
+	RA            string `json:"ra"`
+	Type          string `json:"type"`
+}
+
+// stellariumList is a list within a Stellarium observing list file
+type stellariumList struct {
+	CreationDate string             `json:"creation date"`
+	Description  string             `json:"description"`
+	Name         string             `json:"name"`
+	Objects      []stellariumObject `json:"objects"`
+	SortingType  string             `json:"sortingType"`
+}
+
+// stellariumFile is the document Stellarium's Observing List tool imports
+type stellariumFile struct {
+	DefaultListOleID string                    `json:"defaultListOleId"`
+	ObservingLists   map[string]stellariumList `json:"observingLists"`
+	ShortName        string                    `json:"shortName"`
+	Version          string                    `json:"version"`
+}
+
+// WriteStellarium writes the list as JSON for the Observing List tool of Stellarium. Stars are
+// of Stellarium's type Star and everything else of Nebula, its type for deep-sky objects; the
+// list's identifier is derived from its name and targets, so exporting the same list again
+// replaces rather than duplicates it.
+func (l ObservingList) WriteStellarium(w io.Writer) error {
+	created := l.Created
+	if created.IsZero() {
+		created = time.Now()
+	}
+	list := stellariumList{
+		CreationDate: created.UTC().Format("2006-01-02 15:04:05"),
+		Description:  l.Description,
+		Name:         l.Name,
+		Objects:      make([]stellariumObject, len(l.Entries)),
+	}
+	for i, e := range l.Entries {
+		class := "Nebula"
+		if e.Type == "Star" {
+			class = "Star"
+		}
+		dec := angles.NewFormatter(e.Dec, angles.WithFormat(angles.DMMSSs), angles.WithPrecision(1),
+			angles.WithSymbols(), angles.WithSign()).String()
+		list.Objects[i] = stellariumObject{
+			Constellation: e.Constellation,
+			Dec:           dec,
+			Designation:   e.label(),
+			Magnitude:     strconv.FormatFloat(e.Magnitude, 'f', 2, 64),
+			Name:          e.label(),
+			NameI18n:      e.Name,
+			ObjType:       strings.ToLower(e.Type),
+			RA:            string(angles.NewSexagesimal(angles.NormalizeDegrees(e.RA)/angles.DegreesPerHour).AppendFormat(nil, angles.HMMSSs, 2)),
+			Type:          class,
+		}
+	}
+
+	id := l.id()
+	data, err := json.MarshalIndent(stellariumFile{
+		DefaultListOleID: id,
+		ObservingLists:   map[string]stellariumList{id: list},
+		ShortName:        l.Name,
+		Version:          "2.0",
+	}, "", "    ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// id returns a UUID in braces, as Stellarium identifies lists, hashed from the name and the
+// targets so that it does not depend on when the list is written
+func (l ObservingList) id() string {
+	h := sha1.New()
+	io.WriteString(h, l.Name)
+	for _, e := range l.Entries {
+		fmt.Fprintf(h, "\x00%s\x00%s\x00%g\x00%g", e.label(), e.Name, e.RA, e.Dec)
+	}
+	sum := h.Sum(nil)
+	sum[6] = sum[6]&0x0f | 0x50 // version 5
+	sum[8] = sum[8]&0x3f | 0x80 // RFC 4122 variant
+	return fmt.Sprintf("{%x-%x-%x-%x-%x}", sum[0:4], sum[4:6], sum[6:8], sum[8:10], sum[10:16])
+}
+
+// WriteSkySafari writes the list in the SKYLIST format SkySafari imports. SkySafari finds each
+// target in its own database by catalog number, so entries need a designation it knows, such
+// as a Messier, NGC or HR number; the name is written for those it does not.
+func (l ObservingList) WriteSkySafari(w io.Writer) error {
+	var buf bytes.Buffer
+	buf.WriteString("SkySafariObservingListVersion=3.0\n")
+	buf.WriteString("SortedBy=Default Order\n")
+	for _, e := range l.Entries {
+		buf.WriteString("SkyObject=BeginObject\n")
+		if e.Name != "" {
+			fmt.Fprintf(&buf, "\tCommonName=%s\n", e.Name)
+		}
+		for _, designation := range e.Designations {
+			fmt.Fprintf(&buf, "\tCatalogNumber=%s\n", skySafariNumber(designation))
+		}
+		buf.WriteString("EndObject=SkyObject\n")
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// skySafariNumber spaces a designation between its catalog prefix and number, as SkySafari
+// writes them: "M31" becomes "M 31"
+func skySafariNumber(designation string) string {
+	i := strings.IndexFunc(designation, unicode.IsDigit)
+	if i <= 0 || designation[i-1] == ' ' {
+		return designation
+	}
+	return designation[:i] + " " + designation[i:]
+}
@@ -0,0 +1,89 @@
+package catalog
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ObservingList", func() {
+	m1, _ := MessierObject(1)
+	sirius := Star{ID: "HR 2491", Name: "Sirius", RA: 101.28716, Dec: -16.71612, Magnitude: -1.46}
+	list := ObservingList{
+		Name:    "Winter targets",
+		Created: time.Date(2024, 12, 1, 20, 0, 0, 0, time.UTC),
+		Entries: []ListEntry{m1.ListEntry(), sirius.ListEntry()},
+	}
+
+	It("should describe catalog objects", func() {
+		Expect(m1.ListEntry().Designations).To(Equal([]string{"M1", "NGC 1952"}))
+		Expect(m1.ListEntry().Type).To(Equal("Supernova Remnant"))
+		Expect(Star{Name: "Nameless"}.ListEntry().label()).To(Equal("Nameless"))
+	})
+
+	It("should write a Stellarium observing list", func() {
+		var sb strings.Builder
+		Expect(list.WriteStellarium(&sb)).To(Succeed())
+
+		var file struct {
+			DefaultListOleID string `json:"defaultListOleId"`
+			ObservingLists   map[string]struct {
+				Name         string              `json:"name"`
+				CreationDate string              `json:"creation date"`
+				Objects      []map[string]string `json:"objects"`
+			} `json:"observingLists"`
+		}
+		Expect(json.Unmarshal([]byte(sb.String()), &file)).To(Succeed())
+		Expect(file.DefaultListOleID).To(MatchRegexp(`^\{[0-9a-f]{8}-[0-9a-f]{4}-5[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}\}$`))
+		l := file.ObservingLists[file.DefaultListOleID]
+		Expect(l.Name).To(Equal("Winter targets"))
+		Expect(l.CreationDate).To(Equal("2024-12-01 20:00:00"))
+		Expect(l.Objects).To(HaveLen(2))
+		Expect(l.Objects[0]).To(HaveKeyWithValue("designation", "M1"))
+		Expect(l.Objects[0]).To(HaveKeyWithValue("nameI18n", "Crab Nebula"))
+		Expect(l.Objects[0]).To(HaveKeyWithValue("type", "Nebula"))
+		Expect(l.Objects[0]).To(HaveKeyWithValue("ra", "5h34m30.00s"))
+		Expect(l.Objects[0]).To(HaveKeyWithValue("dec", "+22°01'0.0\""))
+		Expect(l.Objects[1]).To(HaveKeyWithValue("type", "Star"))
+		Expect(l.Objects[1]).To(HaveKeyWithValue("dec", "-16°42'58.0\""))
+		Expect(l.Objects[1]).To(HaveKeyWithValue("magnitude", "-1.46"))
+
+		var again strings.Builder
+		Expect(list.WriteStellarium(&again)).To(Succeed())
+		Expect(again.String()).To(Equal(sb.String()))
+	})
+
+	It("should keep the list identifier when the creation time is unset", func() {
+		id := func(l ObservingList) string {
+			var sb strings.Builder
+			Expect(l.WriteStellarium(&sb)).To(Succeed())
+			var file struct {
+				DefaultListOleID string `json:"defaultListOleId"`
+			}
+			Expect(json.Unmarshal([]byte(sb.String()), &file)).To(Succeed())
+			return file.DefaultListOleID
+		}
+		undated := list
+		undated.Created = time.Time{}
+		first := id(undated)
+		time.Sleep(time.Millisecond)
+		Expect(id(undated)).To(Equal(first))
+		Expect(first).To(Equal(id(list)))
+
+		shorter := list
+		shorter.Entries = list.Entries[:1]
+		Expect(id(shorter)).NotTo(Equal(first))
+	})
+
+	It("should write a SkySafari list", func() {
+		var sb strings.Builder
+		Expect(list.WriteSkySafari(&sb)).To(Succeed())
+		Expect(sb.String()).To(Equal("SkySafariObservingListVersion=3.0\n" +
+			"SortedBy=Default Order\n" +
+			"SkyObject=BeginObject\n\tCommonName=Crab Nebula\n\tCatalogNumber=M 1\n\tCatalogNumber=NGC 1952\nEndObject=SkyObject\n" +
+			"SkyObject=BeginObject\n\tCommonName=Sirius\n\tCatalogNumber=HR 2491\nEndObject=SkyObject\n"))
+	})
+})
@@ -0,0 +1,183 @@
+package catalog
+
+import (
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"math"
+	"time"
+)
+
+// VariableType classifies variable stars by their GCVS type
+type VariableType int
+
+const (
+	VariableOther VariableType = iota
+	EclipsingBinary
+	Cepheid
+	RRLyrae
+	Mira
+	SemiRegular
+	Irregular
+)
+
+// String returns a readable name for the variable type
+func (t VariableType) String() string {
+	return [...]string{"Other", "Eclipsing Binary", "Cepheid", "RR Lyrae", "Mira", "Semi-regular",
+		"Irregular"}[t]
+}
+
+// VariableStar describes a periodic variable star by its light elements
+type VariableStar struct {
+	ID           string
+	Name         string
+	Type         VariableType
+	RA           float64 // right ascension in degrees (J2000)
+	Dec          float64 // declination in degrees (J2000)
+	Period       float64 // days
+	Epoch        float64 // Julian date of a maximum (of a primary minimum for eclipsing binaries)
+	MaxMagnitude float64 // brightest visual magnitude
+	MinMagnitude float64 // faintest visual magnitude
+	RiseDuration float64 // fraction of the period from minimum to maximum (GCVS M-m); 0 means 0.5
+}
+
+// TimeCorrection converts an observer's Julian date to the time scale of a star's light
+// elements, returning the days to add (e.g. HJD - JD). Catalog elements are usually heliocentric.
+type TimeCorrection func(jd float64) float64
+
+// phaseOptions holds the settings of a phase calculation
+type phaseOptions struct {
+	correction TimeCorrection
+}
+
+// PhaseOption configures the phase and extremum calculations of a VariableStar
+type PhaseOption func(*phaseOptions)
+
+// WithTimeCorrection converts observer Julian dates to the time scale of the elements (default
+// none)
+func WithTimeCorrection(c TimeCorrection) PhaseOption {
+	return func(o *phaseOptions) { o.correction = c }
+}
+
+// eclipsingMaximumPhase is the phase reported as maximum light for eclipsing binaries,
+// midway between primary and secondary minima
+const eclipsingMaximumPhase = 0.25
+
+// Amplitude returns the range of the light variation in magnitudes
+func (v VariableStar) Amplitude() float64 {
+	return v.MinMagnitude - v.MaxMagnitude
+}
+
+// Periodic reports whether the star has light elements to predict from: irregular stars and
+// stars without a period have none
+func (v VariableStar) Periodic() bool {
+	return v.Period > 0 && v.Type != Irregular
+}
+
+// Cycle returns the number of whole periods elapsed since the epoch at Julian date jd, 0 for
+// a star that is not Periodic
+func (v VariableStar) Cycle(jd float64) int {
+	if !v.Periodic() {
+		return 0
+	}
+	return int(math.Floor((jd - v.Epoch) / v.Period))
+}
+
+// Phase returns the phase in [0, 1) at Julian date jd, where 0 is the epoch's maximum (or
+// minimum). WithTimeCorrection converts jd to the time scale of the elements. It is NaN for a
+// star that is not Periodic.
+func (v VariableStar) Phase(jd float64, options ...PhaseOption) float64 {
+	if !v.Periodic() {
+		return math.NaN()
+	}
+	cycles := (corrected(options)(jd) - v.Epoch) / v.Period
+	return cycles - math.Floor(cycles)
+}
+
+// PhaseAt returns the phase in [0, 1) at t
+func (v VariableStar) PhaseAt(t time.Time, options ...PhaseOption) float64 {
+	return v.Phase(julian.FromTime(t), options...)
+}
+
+// MaximumPhase returns the phase of maximum light
+func (v VariableStar) MaximumPhase() float64 {
+	if v.Type == EclipsingBinary {
+		return eclipsingMaximumPhase
+	}
+	return 0
+}
+
+// MinimumPhase returns the phase of minimum light: the primary eclipse for eclipsing
+// binaries, otherwise RiseDuration before the maximum
+func (v VariableStar) MinimumPhase() float64 {
+	if v.Type == EclipsingBinary {
+		return 0
+	}
+	rise := v.RiseDuration
+	if rise <= 0 || rise >= 1 {
+		rise = 0.5
+	}
+	return 1 - rise
+}
+
+// NextMaximum returns the Julian date of the first maximum at or after jd, NaN for a star that
+// is not Periodic
+func (v VariableStar) NextMaximum(jd float64, options ...PhaseOption) float64 {
+	return v.nextAtPhase(jd, v.MaximumPhase(), options)
+}
+
+// NextMinimum returns the Julian date of the first minimum at or after jd, NaN for a star that
+// is not Periodic
+func (v VariableStar) NextMinimum(jd float64, options ...PhaseOption) float64 {
+	return v.nextAtPhase(jd, v.MinimumPhase(), options)
+}
+
+// NextMaximumAfter returns the time of the first maximum at or after t, the zero time for a
+// star that is not Periodic
+func (v VariableStar) NextMaximumAfter(t time.Time, options ...PhaseOption) time.Time {
+	return eventTime(v.NextMaximum(julian.FromTime(t), options...))
+}
+
+// NextMinimumAfter returns the time of the first minimum at or after t, the zero time for a
+// star that is not Periodic
+func (v VariableStar) NextMinimumAfter(t time.Time, options ...PhaseOption) time.Time {
+	return eventTime(v.NextMinimum(julian.FromTime(t), options...))
+}
+
+// eventTime converts the Julian date of an extremum to a time, NaN to the zero time
+func eventTime(jd float64) time.Time {
+	if math.IsNaN(jd) {
+		return time.Time{}
+	}
+	return julian.ToTime(jd)
+}
+
+// nextAtPhase finds the first observer time at or after jd where the star reaches phase
+func (v VariableStar) nextAtPhase(jd, phase float64, options []PhaseOption) float64 {
+	if !v.Periodic() {
+		return math.NaN()
+	}
+	correct := corrected(options)
+	cycles := math.Ceil((correct(jd)-v.Epoch)/v.Period - phase)
+	for {
+		event := v.Epoch + (cycles+phase)*v.Period
+		// invert the correction; it changes slowly so two evaluations suffice
+		observed := event - (correct(event) - event)
+		observed = event - (correct(observed) - observed)
+		if observed >= jd {
+			return observed
+		}
+		cycles++
+	}
+}
+
+// corrected returns the function giving a Julian date on the time scale of the elements, as
+// the options select
+func corrected(options []PhaseOption) func(jd float64) float64 {
+	var o phaseOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+	if o.correction == nil {
+		return func(jd float64) float64 { return jd }
+	}
+	return func(jd float64) float64 { return jd + o.correction(jd) }
+}
@@ -0,0 +1,91 @@
+package catalog
+
+import (
+	"math"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VariableStar", func() {
+	algol := VariableStar{Name: "Algol", Type: EclipsingBinary, Period: 2.867328, Epoch: 2445641.5135,
+		MaxMagnitude: 2.09, MinMagnitude: 3.30}
+
+	It("should count phase and cycles from the epoch", func() {
+		Expect(algol.Phase(algol.Epoch)).To(Equal(0.0))
+		Expect(algol.Phase(algol.Epoch + 100.25*algol.Period)).To(BeNumerically("~", 0.25, 1e-9))
+		Expect(algol.Cycle(algol.Epoch + 100.5*algol.Period)).To(Equal(100))
+	})
+
+	It("should wrap phases before the epoch into [0, 1)", func() {
+		Expect(algol.Phase(algol.Epoch - 0.25*algol.Period)).To(BeNumerically("~", 0.75, 1e-9))
+		Expect(algol.Cycle(algol.Epoch - 0.25*algol.Period)).To(Equal(-1))
+	})
+
+	It("should compute the phase at a time", func() {
+		t := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		phase := algol.PhaseAt(t)
+		Expect(phase).To(BeNumerically(">=", 0))
+		Expect(phase).To(BeNumerically("<", 1))
+	})
+
+	It("should compute the amplitude", func() {
+		Expect(algol.Amplitude()).To(BeNumerically("~", 1.21, 1e-12))
+		Expect(EclipsingBinary.String()).To(Equal("Eclipsing Binary"))
+	})
+	It("should predict the next Algol minimum and maximum", func() {
+		jd := algol.Epoch + 10.4*algol.Period
+		Expect(algol.NextMinimum(jd)).To(BeNumerically("~", algol.Epoch+11*algol.Period, 1e-9))
+		Expect(algol.NextMaximum(jd)).To(BeNumerically("~", algol.Epoch+10.25*algol.Period+algol.Period, 1e-9))
+	})
+
+	It("should return the event itself when it is exactly now", func() {
+		jd := algol.Epoch + 3*algol.Period
+		Expect(algol.NextMinimum(jd)).To(BeNumerically("~", jd, 1e-9))
+	})
+
+	It("should place a Mira minimum from the rise duration", func() {
+		mira := VariableStar{Name: "Mira", Type: Mira, Period: 332, Epoch: 2459000, RiseDuration: 0.38,
+			MaxMagnitude: 3.0, MinMagnitude: 9.5}
+		Expect(mira.MinimumPhase()).To(BeNumerically("~", 0.62, 1e-12))
+		Expect(mira.NextMaximum(2459001)).To(BeNumerically("~", 2459332, 1e-9))
+		Expect(mira.NextMinimum(2459001)).To(BeNumerically("~", 2459000+0.62*332, 1e-9))
+	})
+
+	It("should apply a time correction in both directions", func() {
+		// elements in a time scale running 0.01 d ahead of the observer's
+		correction := func(float64) float64 { return 0.01 }
+		jd := algol.Epoch + 10.4*algol.Period
+		next := algol.NextMinimum(jd, WithTimeCorrection(correction))
+		Expect(next).To(BeNumerically("~", algol.Epoch+11*algol.Period-0.01, 1e-9))
+		Expect(algol.Phase(next, WithTimeCorrection(correction))).To(BeNumerically("~", 0, 1e-9))
+	})
+
+	It("should ignore a nil correction", func() {
+		jd := algol.Epoch + 0.5*algol.Period
+		Expect(algol.Phase(jd, WithTimeCorrection(nil))).To(BeNumerically("~", 0.5, 1e-9))
+	})
+
+	It("should return times for time-based queries", func() {
+		t := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		next := algol.NextMinimumAfter(t)
+		Expect(next.After(t)).To(BeTrue())
+		Expect(next.Sub(t)).To(BeNumerically("<", time.Duration(algol.Period*24*float64(time.Hour))))
+		Expect(algol.NextMaximumAfter(t).After(t)).To(BeTrue())
+	})
+	DescribeTable("should predict nothing for a star without light elements",
+		func(star VariableStar) {
+			Expect(star.Periodic()).To(BeFalse())
+			Expect(math.IsNaN(star.NextMaximum(2460000))).To(BeTrue())
+			Expect(math.IsNaN(star.NextMinimum(2460000))).To(BeTrue())
+			Expect(math.IsNaN(star.Phase(2460000))).To(BeTrue())
+			Expect(star.Cycle(2460000)).To(BeZero())
+			Expect(star.NextMaximumAfter(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).IsZero()).To(BeTrue())
+		},
+		Entry("irregular", VariableStar{Type: Irregular, Epoch: 2451545}),
+		Entry("irregular with a nominal period", VariableStar{Type: Irregular, Epoch: 2451545, Period: 100}),
+		Entry("zero period", VariableStar{Type: Mira, Epoch: 2451545}),
+		Entry("negative period", VariableStar{Type: Cepheid, Epoch: 2451545, Period: -5}),
+	)
+})
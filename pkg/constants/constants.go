@@ -9,4 +9,62 @@ const (
 	Arcs         = 3600.0 * 180.0 / Pi
 	AU           = 149597870.7 // Astronomical unit in km
 	SpeedOfLight = 299792.458  // Speed of light in km/s
+
+	EarthRotationRate = 7.292115e-5 // Earth's sidereal rotation rate in rad/s
+)
+
+// Gravitation
+const (
+	G = 6.67430e-20 // Newtonian constant of gravitation in km³/(kg·s²)
+
+	// Gravitational parameters (GM) in km³/s²
+	GMSun     = 1.32712440018e11
+	GMMercury = 22031.86855
+	GMVenus   = 324858.592
+	GMEarth   = 398600.4418
+	GMMoon    = 4902.800066
+	GMMars    = 42828.375214
+	GMJupiter = 126712764.8
+	GMSaturn  = 37940585.2
+	GMUranus  = 5794548.6
+	GMNeptune = 6836527.10058
+)
+
+// Masses in kg
+const (
+	SunMass     = GMSun / G
+	MercuryMass = 3.3011e23
+	VenusMass   = 4.8675e24
+	EarthMass   = 5.9722e24
+	MoonMass    = 7.342e22
+	MarsMass    = 6.4171e23
+	JupiterMass = 1.89819e27
+	SaturnMass  = 5.6834e26
+	UranusMass  = 8.6813e25
+	NeptuneMass = 1.02413e26
+)
+
+// Mean radii in km (the Sun's is the IAU nominal radius)
+const (
+	SunRadius     = 695700.0
+	MercuryRadius = 2439.7
+	VenusRadius   = 6051.8
+	EarthRadius   = 6371.0
+	MoonRadius    = 1737.4
+	MarsRadius    = 3389.5
+	JupiterRadius = 69911.0
+	SaturnRadius  = 58232.0
+	UranusRadius  = 25362.0
+	NeptuneRadius = 24622.0
+)
+
+// The Sun's apparent semidiameter and equatorial horizontal parallax at 1 AU in degrees
+const (
+	SunSemidiameter = 959.63 / 3600
+	SunParallax     = 8.794 / 3600
+)
+
+// Mean distances in km
+const (
+	MoonDistance = 384399.0 // semi-major axis of the Moon's orbit
 )
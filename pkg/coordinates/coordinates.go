@@ -0,0 +1,128 @@
+package coordinates
+
+import (
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/vectors"
+	"math"
+)
+
+// Equatorial is a position in the equatorial system
+type Equatorial struct {
+	RA    float64 // right ascension in degrees
+	Dec   float64 // declination in degrees
+	Epoch Epoch   // equator and equinox referred to, zero when unspecified
+}
+
+// NewEquatorial creates a position from a typed right ascension and declination, which cannot
+// be passed in the wrong order
+func NewEquatorial(ra angles.RightAscension, dec angles.Declination) Equatorial {
+	return Equatorial{RA: ra.Degrees(), Dec: dec.Degrees()}
+}
+
+// Ecliptic is a position in the ecliptic system
+type Ecliptic struct {
+	Longitude float64 // degrees
+	Latitude  float64 // degrees
+	Epoch     Epoch   // ecliptic and equinox referred to, zero when unspecified
+}
+
+// Horizontal is a position in the local horizon system
+type Horizontal struct {
+	Azimuth  float64 // degrees from north through east
+	Altitude float64 // degrees above the horizon
+}
+
+// MeanObliquity returns the mean obliquity of the ecliptic in degrees for Julian centuries
+// since J2000.0 (IAU 1980, Meeus 22.2)
+func MeanObliquity(t float64) float64 {
+	return 23.0 + 26.0/60 + 21.448/3600 - (46.8150*t+0.00059*t*t-0.001813*t*t*t)/3600
+}
+
+// Nutation returns the nutation in longitude and in obliquity in degrees for Julian centuries
+// since J2000.0, from the principal terms of the IAU 1980 theory (accurate to 0.5" and 0.1")
+func Nutation(t float64) (longitude, obliquity float64) {
+	node := (125.04452 - 1934.136261*t) * constants.Rad
+	sun := (280.4665 + 36000.7698*t) * constants.Rad
+	moon := (218.3165 + 481267.8813*t) * constants.Rad
+	longitude = -17.20*math.Sin(node) - 1.32*math.Sin(2*sun) - 0.23*math.Sin(2*moon) + 0.21*math.Sin(2*node)
+	obliquity = 9.20*math.Cos(node) + 0.57*math.Cos(2*sun) + 0.10*math.Cos(2*moon) - 0.09*math.Cos(2*node)
+	return longitude / 3600, obliquity / 3600
+}
+
+// Vector returns the unit direction vector of the position
+func (e Equatorial) Vector() vectors.Vector3D {
+	return UnitVector(e.RA, e.Dec)
+}
+
+// EquatorialFromVector returns the equatorial direction of a vector
+func EquatorialFromVector(v vectors.Vector3D) Equatorial {
+	lon, lat := sphericalAngles(v)
+	return Equatorial{RA: lon, Dec: lat}
+}
+
+// ToEcliptic converts the position to ecliptic coordinates for an obliquity in degrees
+func (e Equatorial) ToEcliptic(obliquity float64) Ecliptic {
+	v := vectors.Rotate3Dx(e.Vector(), -obliquity*constants.Rad)
+	lon, lat := sphericalAngles(v)
+	return Ecliptic{Longitude: lon, Latitude: lat, Epoch: e.Epoch}
+}
+
+// Vector returns the unit direction vector of the position
+func (e Ecliptic) Vector() vectors.Vector3D {
+	return UnitVector(e.Longitude, e.Latitude)
+}
+
+// ToEquatorial converts the position to equatorial coordinates for an obliquity in degrees
+func (e Ecliptic) ToEquatorial(obliquity float64) Equatorial {
+	eq := EquatorialFromVector(vectors.Rotate3Dx(e.Vector(), obliquity*constants.Rad))
+	eq.Epoch = e.Epoch
+	return eq
+}
+
+// ToHorizontal converts the position to horizontal coordinates for an observer's latitude and
+// local sidereal time, both in degrees
+func (e Equatorial) ToHorizontal(latitude, localSiderealTime float64) Horizontal {
+	hourAngle := (localSiderealTime - e.RA) * constants.Rad
+	sinH, cosH := math.Sincos(hourAngle)
+	sinDec, cosDec := math.Sincos(e.Dec * constants.Rad)
+	sinLat, cosLat := math.Sincos(latitude * constants.Rad)
+
+	azimuth := math.Atan2(-cosDec*sinH, sinDec*cosLat-cosDec*cosH*sinLat)
+	altitude := math.Asin(sinLat*sinDec + cosLat*cosDec*cosH)
+	return Horizontal{Azimuth: angles.NormalizeDegrees(azimuth * constants.Deg), Altitude: altitude * constants.Deg}
+}
+
+// ToEquatorial converts the position to equatorial coordinates for an observer's latitude and
+// local sidereal time, both in degrees
+func (h Horizontal) ToEquatorial(latitude, localSiderealTime float64) Equatorial {
+	sinAz, cosAz := math.Sincos(h.Azimuth * constants.Rad)
+	sinAlt, cosAlt := math.Sincos(h.Altitude * constants.Rad)
+	sinLat, cosLat := math.Sincos(latitude * constants.Rad)
+
+	hourAngle := math.Atan2(-sinAz*cosAlt, sinAlt*cosLat-cosAlt*cosAz*sinLat)
+	dec := math.Asin(sinLat*sinAlt + cosLat*cosAlt*cosAz)
+	return Equatorial{RA: angles.NormalizeDegrees(localSiderealTime - hourAngle*constants.Deg), Dec: dec * constants.Deg}
+}
+
+// Separation returns the angular distance in degrees between two equatorial positions
+func Separation(a, b Equatorial) float64 {
+	u, v := a.Vector(), b.Vector()
+	return math.Atan2(u.CrossProduct(v).Magnitude(), u.DotProduct(v)) * constants.Deg
+}
+
+// UnitVector returns the unit vector for a longitude and latitude in degrees, in whatever
+// frame they are measured: celestial for right ascension and declination, Earth-fixed for a
+// place on the globe
+func UnitVector(lon, lat float64) vectors.Vector3D {
+	sinLon, cosLon := math.Sincos(lon * constants.Rad)
+	sinLat, cosLat := math.Sincos(lat * constants.Rad)
+	return vectors.Vector3D{X: cosLat * cosLon, Y: cosLat * sinLon, Z: sinLat}
+}
+
+// sphericalAngles returns the longitude in [0, 360) and latitude of a vector in degrees
+func sphericalAngles(v vectors.Vector3D) (lon, lat float64) {
+	lon = angles.NormalizeDegrees(math.Atan2(v.Y, v.X) * constants.Deg)
+	lat = math.Atan2(v.Z, math.Hypot(v.X, v.Y)) * constants.Deg
+	return lon, lat
+}
@@ -0,0 +1,13 @@
+package coordinates_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCoordinates(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Coordinates Suite")
+}
@@ -0,0 +1,168 @@
+package coordinates
+
+import (
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Coordinates", func() {
+	It("should build positions from typed angles", func() {
+		ra, _ := angles.NewRightAscensionHMS(6, 45, 8.9)
+		dec, _ := angles.NewDeclinationDMS(-16, 42, 58)
+		eq := NewEquatorial(ra, dec)
+		Expect(eq.RA).To(BeNumerically("~", 101.287083, 1e-6))
+		Expect(eq.Dec).To(BeNumerically("~", -16.716111, 1e-6))
+	})
+
+	It("should compute the mean obliquity (Meeus example 22.a)", func() {
+		Expect(MeanObliquity(-0.127296372348)).To(BeNumerically("~", 23.44094629, 1e-6))
+	})
+
+	It("should compute the nutation (Meeus example 22.a)", func() {
+		longitude, obliquity := Nutation(-0.127296372348)
+		Expect(longitude * 3600).To(BeNumerically("~", -3.788, 0.5))
+		Expect(obliquity * 3600).To(BeNumerically("~", 9.443, 0.1))
+	})
+
+	It("should convert equatorial to ecliptic (Meeus example 13.a, Pollux)", func() {
+		pollux := Equatorial{RA: 116.328942, Dec: 28.026183}
+		ecl := pollux.ToEcliptic(23.4392911)
+		Expect(ecl.Longitude).To(BeNumerically("~", 113.215630, 1e-5))
+		Expect(ecl.Latitude).To(BeNumerically("~", 6.684170, 1e-5))
+	})
+
+	It("should round-trip ecliptic and equatorial coordinates", func() {
+		ecl := Ecliptic{Longitude: 281.5, Latitude: -4.25}
+		back := ecl.ToEquatorial(23.44).ToEcliptic(23.44)
+		Expect(back.Longitude).To(BeNumerically("~", ecl.Longitude, 1e-9))
+		Expect(back.Latitude).To(BeNumerically("~", ecl.Latitude, 1e-9))
+	})
+
+	It("should convert to and from unit vectors", func() {
+		eq := Equatorial{RA: 350, Dec: -45}
+		Expect(eq.Vector().Magnitude()).To(BeNumerically("~", 1, 1e-15))
+		back := EquatorialFromVector(eq.Vector().ScalarMultiply(3))
+		Expect(back.RA).To(BeNumerically("~", 350, 1e-9))
+		Expect(back.Dec).To(BeNumerically("~", -45, 1e-9))
+	})
+	It("should convert equatorial to horizontal (Meeus example 13.b, Venus from Washington)", func() {
+		venus := Equatorial{RA: 347.3193375, Dec: -6.719892}
+		// apparent sidereal time 128.7378734 deg at longitude 77.0656 W
+		h := venus.ToHorizontal(38.921389, 128.7378734-77.065556)
+		// Meeus measures azimuth from the south: 68.0337
+		Expect(h.Azimuth).To(BeNumerically("~", 248.0337, 1e-3))
+		Expect(h.Altitude).To(BeNumerically("~", 15.1249, 1e-3))
+	})
+
+	It("should round-trip horizontal and equatorial coordinates", func() {
+		eq := Equatorial{RA: 200, Dec: 35}
+		back := eq.ToHorizontal(-33.9, 150).ToEquatorial(-33.9, 150)
+		Expect(back.RA).To(BeNumerically("~", 200, 1e-9))
+		Expect(back.Dec).To(BeNumerically("~", 35, 1e-9))
+	})
+
+	It("should put a star on the meridian due south and at the co-latitude altitude", func() {
+		h := Equatorial{RA: 100, Dec: 0}.ToHorizontal(40, 100)
+		Expect(h.Azimuth).To(BeNumerically("~", 180, 1e-9))
+		Expect(h.Altitude).To(BeNumerically("~", 50, 1e-9))
+	})
+	It("should compute angular separations (Meeus example 17.a, Arcturus and Spica)", func() {
+		arcturus := Equatorial{RA: 213.9154, Dec: 19.1825}
+		spica := Equatorial{RA: 201.2983, Dec: -11.1614}
+		Expect(Separation(arcturus, spica)).To(BeNumerically("~", 32.7930, 1e-4))
+		Expect(Separation(spica, spica)).To(Equal(0.0))
+	})
+})
+
+var _ = Describe("Precession", func() {
+	// Meeus example 21.b: θ Persei from J2000.0 to 2028 November 13.19 TD
+	j2000 := Equatorial{RA: 41.054063, Dec: 49.227750}
+	t := (2462088.69 - 2451545.0) / 36525
+
+	It("should match Meeus example 21.b with the classical model", func() {
+		p := Precess(j2000, 0, t)
+		Expect(p.RA).To(BeNumerically("~", 41.547214, 1e-5))
+		Expect(p.Dec).To(BeNumerically("~", 49.348483, 1e-5))
+	})
+
+	It("should agree closely with the IAU 2006 model", func() {
+		p := Precess(j2000, 0, t, WithModel(IAU2006))
+		Expect(p.RA).To(BeNumerically("~", 41.547214, 1e-4))
+		Expect(p.Dec).To(BeNumerically("~", 49.348483, 1e-4))
+		Expect(IAU2006.String()).To(Equal("IAU2006"))
+		Expect(IAU2006.Accuracy().Angle).To(BeNumerically("<", Classical.Accuracy().Angle))
+	})
+
+	It("should round-trip between equinoxes", func() {
+		p := Precess(Precess(j2000, 0, t, WithModel(IAU2006)), t, -0.5, WithModel(IAU2006))
+		back := Precess(p, -0.5, 0, WithModel(IAU2006))
+		Expect(Separation(back, j2000)).To(BeNumerically("<", 1e-9))
+	})
+
+	It("should give the IAU 2006 obliquity and nutation", func() {
+		Expect(IAU2006.MeanObliquity(0)).To(BeNumerically("~", 84381.406/3600, 1e-12))
+		dpsi, deps := IAU2006.Nutation((2446895.5 - 2451545.0) / 36525)
+		Expect(dpsi * 3600).To(BeNumerically("~", -3.788, 0.01))
+		Expect(deps * 3600).To(BeNumerically("~", 9.443, 0.01))
+	})
+
+	It("should match SOFA's iauNut00b to well under a milliarcsecond", func() {
+		// t_sofa_c: iauNut00b(2400000.5, 53736.0)
+		dpsi, deps := IAU2006.Nutation((2453736.5 - 2451545.0) / 36525)
+		Expect(dpsi * constants.Rad).To(BeNumerically("~", -0.9632552291148362783e-5, 1e-12))
+		Expect(deps * constants.Rad).To(BeNumerically("~", 0.4063197106621159367e-4, 1e-12))
+	})
+})
+
+var _ = Describe("Refraction", func() {
+	It("should match Meeus example 16.b near the horizon", func() {
+		Expect(Refraction(0.5541) * 60).To(BeNumerically("~", 24.6, 0.2))
+	})
+
+	It("should vanish at the zenith and below the cutoff", func() {
+		Expect(Refraction(90)).To(BeNumerically("~", 0, 1e-5))
+		Expect(Refraction(-2)).To(BeZero())
+	})
+})
+
+var _ = DescribeTable("TrueAltitude inverts Refraction",
+	func(altitude float64) {
+		Expect(TrueAltitude(altitude + Refraction(altitude))).To(BeNumerically("~", altitude, 1e-9))
+	},
+	Entry("near the horizon", 0.0),
+	Entry("low", 5.0),
+	Entry("high", 60.0),
+	Entry("below the cutoff", -3.0),
+)
+
+var _ = Describe("Refraction models", func() {
+	models := []RefractionModel{Saemundsson{}, Bennett{}, Radio{Humidity: 0.5}}
+
+	It("should give Bennett's 34.5' at the apparent horizon", func() {
+		Expect(TrueAltitudeWith(Bennett{}, 0, StandardPressure, StandardTemperature) * 60).To(BeNumerically("~", -34.5, 0.1))
+	})
+
+	It("should agree between the optical models above the horizon", func() {
+		for _, altitude := range []float64{5, 15, 45} {
+			difference := Bennett{}.Refraction(altitude, StandardPressure, StandardTemperature) -
+				Saemundsson{}.Refraction(altitude, StandardPressure, StandardTemperature)
+			Expect(difference * 60).To(BeNumerically("~", 0, 0.1))
+		}
+	})
+
+	It("should raise radio refraction with humidity", func() {
+		dry := Radio{}.Refraction(10, 1013, 20)
+		Expect(dry).To(Equal(RefractionAt(10, 1013, 20)))
+		Expect(Radio{Humidity: 1}.Refraction(10, 1013, 20) / dry).To(BeNumerically("~", 1.38, 0.02))
+	})
+
+	It("should invert every model", func() {
+		for _, m := range models {
+			observed := 3 + m.Refraction(3, 1000, 0)
+			Expect(TrueAltitudeWith(m, observed, 1000, 0)).To(BeNumerically("~", 3, 1e-9))
+		}
+		Expect(NoRefraction{}.Refraction(0, StandardPressure, StandardTemperature)).To(BeZero())
+	})
+})
@@ -0,0 +1,35 @@
+package coordinates_test
+
+import (
+	"fmt"
+
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+)
+
+func ExampleEquatorial_ToEcliptic() {
+	// Pollux, J2000 (Meeus example 13.a)
+	pollux := coordinates.Equatorial{RA: 116.328942, Dec: 28.026183, Epoch: coordinates.J2000}
+	ecliptic := pollux.ToEcliptic(23.4392911)
+	fmt.Printf("λ %.5f° β %.5f°\n", ecliptic.Longitude, ecliptic.Latitude)
+	// Output:
+	// λ 113.21563° β 6.68417°
+}
+
+func ExampleEquatorial_ToHorizontal() {
+	// Saturn from Washington, 1987 April 10 19:21 UT (Meeus example 13.b); the local sidereal
+	// time is 128.7378734° - 77.0655556°
+	saturn := coordinates.Equatorial{RA: 347.3193, Dec: -6.719892}
+	horizontal := saturn.ToHorizontal(38.921389, 128.7378734-77.0655556)
+	fmt.Println(horizontal.Compact())
+	// Output:
+	// 248°02' +15°07'
+}
+
+func ExamplePrecess() {
+	// θ Persei from J2000 to 2028 November 13.19 (Meeus example 21.b)
+	star := coordinates.Equatorial{RA: 41.054063, Dec: 49.227750}
+	precessed := coordinates.Precess(star, 0, 0.288670500)
+	fmt.Printf("RA %.6f° Dec %.6f°\n", precessed.RA, precessed.Dec)
+	// Output:
+	// RA 41.547214° Dec 49.348483°
+}
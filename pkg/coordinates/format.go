@@ -0,0 +1,130 @@
+package coordinates
+
+import (
+	"fmt"
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Epoch is the Julian epoch, in years, of the equator and equinox a position is referred to.
+// The zero value leaves the frame unspecified, and String then prints no label.
+type Epoch float64
+
+// J2000 is the standard epoch J2000.0
+const J2000 Epoch = 2000
+
+// EpochOf returns the Julian epoch of Julian centuries (TT) since J2000.0
+func EpochOf(t float64) Epoch {
+	return J2000 + Epoch(100*t)
+}
+
+// Centuries returns the epoch as Julian centuries since J2000.0
+func (e Epoch) Centuries() float64 {
+	return float64(e-J2000) / 100
+}
+
+// String returns the epoch label, such as "J2000" or "J2024.25", or "" when unspecified
+func (e Epoch) String() string {
+	if e == 0 {
+		return ""
+	}
+	// round to a thousandth of a year, about nine hours, so computed epochs print cleanly
+	return "J" + strconv.FormatFloat(math.Round(float64(e)*1000)/1000, 'f', -1, 64)
+}
+
+// String returns the position with its epoch, e.g. `RA 10h08m22.3s Dec +11°58'02" (J2000)`
+func (e Equatorial) String() string {
+	return withEpoch(fmt.Sprintf("RA %s Dec %s", hours(e.RA, 1), signedDegrees(e.Dec, 0)), e.Epoch)
+}
+
+// Compact returns the position to a minute of time and of arc, e.g. "10h08m +11°58' J2000"
+func (e Equatorial) Compact() string {
+	return strings.TrimSpace(fmt.Sprintf("%s %s %s", hours(e.RA, -1), signedDegrees(e.Dec, -1), e.Epoch))
+}
+
+// String returns the position with its epoch, e.g. `λ 149°48'27" β +0°00'01" (J2000)`
+func (e Ecliptic) String() string {
+	return withEpoch(fmt.Sprintf("λ %s β %s", degrees(e.Longitude, 0), signedDegrees(e.Latitude, 0)), e.Epoch)
+}
+
+// Compact returns the position to a minute of arc, e.g. "149°48' +0°00' J2000"
+func (e Ecliptic) Compact() string {
+	return strings.TrimSpace(fmt.Sprintf("%s %s %s", degrees(e.Longitude, -1), signedDegrees(e.Latitude, -1), e.Epoch))
+}
+
+// String returns the position, e.g. `Az 123°45'06" Alt +12°34'56"`; horizontal positions are
+// tied to an observer and instant rather than an epoch
+func (h Horizontal) String() string {
+	return fmt.Sprintf("Az %s Alt %s", degrees(h.Azimuth, 0), signedDegrees(h.Altitude, 0))
+}
+
+// Compact returns the position to a minute of arc, e.g. "123°45' +12°35'"
+func (h Horizontal) Compact() string {
+	return fmt.Sprintf("%s %s", degrees(h.Azimuth, -1), signedDegrees(h.Altitude, -1))
+}
+
+// withEpoch appends the epoch label in parentheses when the epoch is known
+func withEpoch(s string, e Epoch) string {
+	if e == 0 {
+		return s
+	}
+	return s + " (" + e.String() + ")"
+}
+
+// hours formats degrees as hours, minutes and seconds of time in [0h, 24h) with the given
+// decimals, or to whole minutes when decimals is negative
+func hours(degrees float64, decimals int) string {
+	return sexagesimal(angles.NormalizeDegrees(degrees)/15, decimals, 24, "h", "m", "s")
+}
+
+// degrees formats a longitude or azimuth as degrees, minutes and seconds of arc in [0°, 360°)
+func degrees(value float64, decimals int) string {
+	return sexagesimal(angles.NormalizeDegrees(value), decimals, 360, "°", "'", "\"")
+}
+
+// signedDegrees formats degrees of arc with an explicit sign, a plus when the value rounds to
+// zero
+func signedDegrees(value float64, decimals int) string {
+	s := sexagesimal(value, decimals, 0, "°", "'", "\"")
+	if strings.HasPrefix(s, "-") {
+		return s
+	}
+	return "+" + s
+}
+
+// sexagesimal formats a value as units, minutes and seconds, rounding in the last field shown
+// and carrying into the higher ones so 59.96s never prints as 60.0s. The sign is taken from the
+// rounded value, and with a turn of more than zero units a value that rounds up to a full turn
+// wraps to zero, so 23h59m59.97s prints as 0h00m00.0s rather than 24h.
+func sexagesimal(value float64, decimals int, turn float64, units, minutes, seconds string) string {
+	sign := ""
+	if value < 0 {
+		sign, value = "-", -value
+	}
+	ticksPerUnit := 60.0
+	if decimals >= 0 {
+		ticksPerUnit = 3600 * math.Pow(10, float64(decimals))
+	}
+	total := math.Round(value * ticksPerUnit)
+	if turn > 0 && total >= turn*ticksPerUnit {
+		total -= turn * ticksPerUnit
+	}
+	if total == 0 {
+		sign = ""
+	}
+	if decimals < 0 {
+		return fmt.Sprintf("%s%.0f%s%02.0f%s", sign, math.Floor(total/60), units, math.Mod(total, 60), minutes)
+	}
+
+	total /= ticksPerUnit / 3600
+	whole := math.Floor(total / 3600)
+	minute := math.Floor((total - whole*3600) / 60)
+	second := total - whole*3600 - minute*60
+	width := 2
+	if decimals > 0 {
+		width += decimals + 1
+	}
+	return fmt.Sprintf("%s%.0f%s%02.0f%s%0*.*f%s", sign, whole, units, minute, minutes, width, decimals, second, seconds)
+}
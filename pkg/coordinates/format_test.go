@@ -0,0 +1,52 @@
+package coordinates
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Formatting", func() {
+	regulus := Equatorial{RA: 152.09296, Dec: 11.967208, Epoch: J2000}
+
+	It("should label equatorial positions with their epoch", func() {
+		Expect(regulus.String()).To(Equal(`RA 10h08m22.3s Dec +11°58'02" (J2000)`))
+		Expect(regulus.Compact()).To(Equal("10h08m +11°58' J2000"))
+	})
+
+	It("should omit the label when the epoch is unspecified", func() {
+		e := Equatorial{RA: 0, Dec: -0.5}
+		Expect(e.String()).To(Equal(`RA 0h00m00.0s Dec -0°30'00"`))
+		Expect(e.Compact()).To(Equal("0h00m -0°30'"))
+	})
+
+	It("should carry rounded seconds into minutes and hours", func() {
+		e := Equatorial{RA: 15*2 - 0.04/240, Dec: 10 - 0.4/3600}
+		Expect(e.String()).To(Equal(`RA 2h00m00.0s Dec +10°00'00"`))
+	})
+
+	It("should wrap values that round up to a full turn", func() {
+		Expect(Equatorial{RA: 359.999}.Compact()).To(Equal("0h00m +0°00'"))
+		Expect(Equatorial{RA: 360 - 0.01/240}.String()).To(Equal(`RA 0h00m00.0s Dec +0°00'00"`))
+		Expect(Horizontal{Azimuth: 359.9999, Altitude: 10}.Compact()).To(Equal("0°00' +10°00'"))
+	})
+
+	It("should not sign values that round to zero as negative", func() {
+		Expect(Equatorial{RA: 10, Dec: -1e-6}.Compact()).To(Equal("0h40m +0°00'"))
+		Expect(Horizontal{Azimuth: -1e-9, Altitude: -1e-9}.String()).To(Equal(`Az 0°00'00" Alt +0°00'00"`))
+	})
+
+	It("should label precessed positions with the target epoch", func() {
+		p := Precess(regulus, 0, 0.2425)
+		Expect(p.Epoch).To(Equal(Epoch(2024.25)))
+		Expect(p.String()).To(HaveSuffix("(J2024.25)"))
+		Expect(p.ToEcliptic(MeanObliquity(0.2425)).Epoch).To(Equal(p.Epoch))
+		Expect(EpochOf(0.2425).Centuries()).To(BeNumerically("~", 0.2425, 1e-12))
+	})
+
+	It("should format ecliptic and horizontal positions", func() {
+		ecliptic := regulus.ToEcliptic(MeanObliquity(0))
+		Expect(ecliptic.String()).To(MatchRegexp(`^λ 149°\d\d'\d\d" β \+0°\d\d'\d\d" \(J2000\)$`))
+		Expect(Horizontal{Azimuth: 123.7517, Altitude: -0.5833}.String()).To(Equal(`Az 123°45'06" Alt -0°35'00"`))
+		Expect(Horizontal{Azimuth: 123.7517, Altitude: 12.5}.Compact()).To(Equal("123°45' +12°30'"))
+	})
+})
@@ -0,0 +1,117 @@
+package coordinates
+
+import (
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/vectors"
+	"math"
+)
+
+// frameStep is the spacing in Julian centuries of the nodes of a FrameTable, half a day: short
+// enough that linear interpolation of the fortnightly nutation terms stays below 0.01"
+const frameStep = 0.5 / 36525
+
+// Frame is the precession-nutation state at one instant, in degrees
+type Frame struct {
+	T                 float64 // Julian centuries (TT) since J2000.0
+	MeanObliquity     float64
+	NutationLongitude float64
+	NutationObliquity float64
+	Zeta, Z, Theta    float64 // precession angles from J2000.0
+}
+
+// FrameAt evaluates the frame at Julian centuries (TT) t, using the Classical model unless
+// WithModel selects another
+func FrameAt(t float64, options ...ModelOption) Frame {
+	m := ModelOf(options)
+	f := Frame{T: t, MeanObliquity: m.MeanObliquity(t)}
+	f.NutationLongitude, f.NutationObliquity = m.Nutation(t)
+	f.Zeta, f.Z, f.Theta = m.PrecessionAngles(t)
+	return f
+}
+
+// TrueObliquity returns the mean obliquity corrected for nutation
+func (f Frame) TrueObliquity() float64 {
+	return f.MeanObliquity + f.NutationObliquity
+}
+
+// PrecessFromJ2000 refers a J2000.0 mean position to the mean equator and equinox of the frame
+func (f Frame) PrecessFromJ2000(e Equatorial) Equatorial {
+	v := vectors.Rotate3Dz(e.Vector(), f.Zeta*constants.Rad)
+	v = vectors.Rotate3Dy(v, -f.Theta*constants.Rad)
+	precessed := EquatorialFromVector(vectors.Rotate3Dz(v, f.Z*constants.Rad))
+	precessed.Epoch = EpochOf(f.T)
+	return precessed
+}
+
+// Nutate converts a mean position of date to the true equator and equinox of the frame
+func (f Frame) Nutate(e Equatorial) Equatorial {
+	ecliptic := e.ToEcliptic(f.MeanObliquity)
+	ecliptic.Longitude += f.NutationLongitude
+	return ecliptic.ToEquatorial(f.TrueObliquity())
+}
+
+// FrameTable interpolates the frame between nodes half a day apart, so that a series of many
+// positions evaluates the nutation series once per node rather than once per position
+type FrameTable struct {
+	start  float64
+	frames []Frame
+}
+
+// NewFrameTable tabulates the frame over Julian centuries [start, end], using the Classical
+// model unless WithModel selects another
+func NewFrameTable(start, end float64, options ...ModelOption) *FrameTable {
+	n := int(math.Ceil((end-start)/frameStep-1e-9)) + 1 // tolerate rounding of whole spans
+	table := &FrameTable{start: start, frames: make([]Frame, max(n, 2))}
+	for i := range table.frames {
+		table.frames[i] = FrameAt(start+float64(i)*frameStep, options...)
+	}
+	return table
+}
+
+// Nodes returns the number of tabulated frames
+func (ft *FrameTable) Nodes() int {
+	return len(ft.frames)
+}
+
+// At returns the frame at Julian centuries t, interpolated linearly between the nodes and
+// extrapolated from the end intervals outside the table
+func (ft *FrameTable) At(t float64) Frame {
+	i := int(math.Floor((t - ft.start) / frameStep))
+	i = max(0, min(i, len(ft.frames)-2))
+	a, b := ft.frames[i], ft.frames[i+1]
+	x := (t - a.T) / (b.T - a.T)
+	lerp := func(p, q float64) float64 { return p + x*(q-p) }
+	return Frame{
+		T:                 t,
+		MeanObliquity:     lerp(a.MeanObliquity, b.MeanObliquity),
+		NutationLongitude: lerp(a.NutationLongitude, b.NutationLongitude),
+		NutationObliquity: lerp(a.NutationObliquity, b.NutationObliquity),
+		Zeta:              lerp(a.Zeta, b.Zeta),
+		Z:                 lerp(a.Z, b.Z),
+		Theta:             lerp(a.Theta, b.Theta),
+	}
+}
+
+// Frames returns the frame at each of the Julian centuries ts, interpolated from a FrameTable
+// when the series is denser than the table's nodes and evaluated directly otherwise
+func Frames(ts []float64, options ...ModelOption) []Frame {
+	frames := make([]Frame, len(ts))
+	if len(ts) == 0 {
+		return frames
+	}
+	start, end := ts[0], ts[0]
+	for _, t := range ts {
+		start, end = math.Min(start, t), math.Max(end, t)
+	}
+	if float64(len(ts)) <= (end-start)/frameStep+2 {
+		for i, t := range ts {
+			frames[i] = FrameAt(t, options...)
+		}
+		return frames
+	}
+	table := NewFrameTable(start, end, options...)
+	for i, t := range ts {
+		frames[i] = table.At(t)
+	}
+	return frames
+}
@@ -0,0 +1,41 @@
+package coordinates
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Frame", func() {
+	t := 0.2436
+
+	It("should reproduce Precess and the nutation of date", func() {
+		f := FrameAt(t)
+		star := Equatorial{RA: 41.054063, Dec: 49.227750}
+		Expect(Separation(f.PrecessFromJ2000(star), Precess(star, 0, t))).To(BeNumerically("<", 1e-10))
+		longitude, obliquity := Nutation(t)
+		Expect(f.TrueObliquity()).To(Equal(MeanObliquity(t) + obliquity))
+		Expect(f.NutationLongitude).To(Equal(longitude))
+		Expect(FrameAt(t, WithModel(IAU2006)).MeanObliquity).To(Equal(IAU2006.MeanObliquity(t)))
+	})
+
+	It("should interpolate between nodes to well under 0.01 arcsecond", func() {
+		table := NewFrameTable(t, t+30.0/36525)
+		Expect(table.Nodes()).To(Equal(61))
+		for _, dt := range []float64{0.13, 7.77, 29.9} {
+			exact, interpolated := FrameAt(t+dt/36525), table.At(t+dt/36525)
+			Expect((interpolated.NutationLongitude - exact.NutationLongitude) * 3600).To(BeNumerically("~", 0, 0.005))
+			Expect((interpolated.Zeta - exact.Zeta) * 3600).To(BeNumerically("~", 0, 1e-6))
+		}
+	})
+
+	It("should tabulate only dense series", func() {
+		sparse := []float64{0, 0.01, 0.02}
+		Expect(Frames(sparse)[1]).To(Equal(FrameAt(0.01)))
+		dense := make([]float64, 100)
+		for i := range dense {
+			dense[i] = t + float64(i)/24/36525
+		}
+		Expect(Frames(dense)[50].NutationLongitude).To(BeNumerically("~", FrameAt(dense[50]).NutationLongitude, 0.005/3600))
+		Expect(Frames(nil)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,235 @@
+package coordinates
+
+import (
+	"github.com/ocrosby/astronomy/pkg/accuracy"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/vectors"
+	"math"
+)
+
+// Model selects the precession-nutation theory used by frame and sidereal time calculations
+type Model int
+
+const (
+	Classical Model = iota // IAU 1976 precession, IAU 1980 nutation and IAU 1982 GMST (Meeus)
+	IAU2006                // IAU 2006 precession, IAU 2000 nutation and the Earth rotation angle
+)
+
+// String returns the name of the model
+func (m Model) String() string {
+	return [...]string{"Classical", "IAU2006"}[m]
+}
+
+// Accuracy returns the expected error of the frame rotations of the model against SOFA. For
+// IAU2006 it is set by the frame bias, which is not applied, so that ICRS positions are taken
+// for J2000.0 mean ones and are off by up to 23 mas; IAU 2000B nutation adds at most 1 mas.
+func (m Model) Accuracy() accuracy.Accuracy {
+	if m == IAU2006 {
+		return accuracy.Accuracy{Name: m.String(), Angle: 0.025, FirstYear: 1995, LastYear: 2050,
+			Source: "IAU 2006 precession (Capitaine et al. 2003) with IAU 2000B nutation, without the ICRS frame bias"}
+	}
+	return accuracy.Accuracy{Name: m.String(), Angle: 0.5, FirstYear: 1800, LastYear: 2200,
+		Source: "IAU 1976 precession with the principal terms of IAU 1980 nutation (Meeus ch. 21-22)"}
+}
+
+// modelOptions holds the settings of a frame or sidereal time calculation
+type modelOptions struct {
+	model Model
+}
+
+// ModelOption configures the calculations that depend on the precession-nutation theory
+type ModelOption func(*modelOptions)
+
+// WithModel selects the precession-nutation theory (default Classical)
+func WithModel(m Model) ModelOption {
+	return func(o *modelOptions) { o.model = m }
+}
+
+// ModelOf returns the model the options select, Classical when none does
+func ModelOf(options []ModelOption) Model {
+	o := modelOptions{model: Classical}
+	for _, apply := range options {
+		apply(&o)
+	}
+	return o.model
+}
+
+// MeanObliquity returns the mean obliquity of the ecliptic in degrees for Julian centuries (TT)
+// since J2000.0 under the model
+func (m Model) MeanObliquity(t float64) float64 {
+	if m == IAU2006 {
+		return (84381.406 + (-46.836769+(-0.0001831+(0.00200340+(-0.000000576-0.0000000434*t)*t)*t)*t)*t) / 3600
+	}
+	return MeanObliquity(t)
+}
+
+// Nutation returns the nutation in longitude and in obliquity in degrees under the model
+func (m Model) Nutation(t float64) (longitude, obliquity float64) {
+	if m == IAU2006 {
+		return nutation2000(t)
+	}
+	return Nutation(t)
+}
+
+// PrecessionAngles returns the equatorial precession angles ζ, z and θ in degrees from J2000.0
+// to Julian centuries (TT) t under the model (Meeus 21.3, Capitaine et al. 2003)
+func (m Model) PrecessionAngles(t float64) (zeta, z, theta float64) {
+	if m == IAU2006 {
+		zeta = 2.650545 + (2306.083227+(0.2988499+(0.01801828+(-0.000005971-0.0000003173*t)*t)*t)*t)*t
+		z = -2.650545 + (2306.077181+(1.0927348+(0.01826837+(-0.000028596-0.0000002904*t)*t)*t)*t)*t
+		theta = (2004.191903 + (-0.4294934+(-0.04182264+(-0.000007089-0.0000001274*t)*t)*t)*t) * t
+	} else {
+		zeta = (2306.2181 + (0.30188+0.017998*t)*t) * t
+		z = (2306.2181 + (1.09468+0.018203*t)*t) * t
+		theta = (2004.3109 + (-0.42665-0.041833*t)*t) * t
+	}
+	return zeta / 3600, z / 3600, theta / 3600
+}
+
+// precessFromJ2000 rotates a J2000.0 vector to the mean equator and equinox of t
+func (m Model) precessFromJ2000(v vectors.Vector3D, t float64) vectors.Vector3D {
+	zeta, z, theta := m.PrecessionAngles(t)
+	v = vectors.Rotate3Dz(v, zeta*constants.Rad)
+	v = vectors.Rotate3Dy(v, -theta*constants.Rad)
+	return vectors.Rotate3Dz(v, z*constants.Rad)
+}
+
+// precessToJ2000 rotates a vector on the mean equator and equinox of t back to J2000.0
+func (m Model) precessToJ2000(v vectors.Vector3D, t float64) vectors.Vector3D {
+	zeta, z, theta := m.PrecessionAngles(t)
+	v = vectors.Rotate3Dz(v, -z*constants.Rad)
+	v = vectors.Rotate3Dy(v, theta*constants.Rad)
+	return vectors.Rotate3Dz(v, -zeta*constants.Rad)
+}
+
+// Precess converts a mean position from the equinox of Julian centuries from to that of
+// Julian centuries to (both TT since J2000.0), using the Classical model unless WithModel
+// selects another.
+// The result is labelled with the epoch of to.
+func Precess(e Equatorial, from, to float64, options ...ModelOption) Equatorial {
+	m := ModelOf(options)
+	precessed := EquatorialFromVector(m.precessFromJ2000(m.precessToJ2000(e.Vector(), from), to))
+	precessed.Epoch = EpochOf(to)
+	return precessed
+}
+
+// nutationTerm is one luni-solar term of the IAU 2000B nutation series in units of 0.1 µas,
+// with multipliers of the arguments l, l', F, D and Ω
+type nutationTerm struct {
+	l, lp, f, d, om float64
+	ps, pst, pc     float64
+	ec, ect, es     float64
+}
+
+// nutationTerms are the 77 luni-solar terms of the IAU 2000B series (McCarthy & Luzum 2003),
+// as in SOFA's iauNut00b
+var nutationTerms = [...]nutationTerm{
+	// 1-10
+	{0, 0, 0, 0, 1, -172064161, -174666, 33386, 92052331, 9086, 15377},
+	{0, 0, 2, -2, 2, -13170906, -1675, -13696, 5730336, -3015, -4587},
+	{0, 0, 2, 0, 2, -2276413, -234, 2796, 978459, -485, 1374},
+	{0, 0, 0, 0, 2, 2074554, 207, -698, -897492, 470, -291},
+	{0, 1, 0, 0, 0, 1475877, -3633, 11817, 73871, -184, -1924},
+	{0, 1, 2, -2, 2, -516821, 1226, -524, 224386, -677, -174},
+	{1, 0, 0, 0, 0, 711159, 73, -872, -6750, 0, 358},
+	{0, 0, 2, 0, 1, -387298, -367, 380, 200728, 18, 318},
+	{1, 0, 2, 0, 2, -301461, -36, 816, 129025, -63, 367},
+	{0, -1, 2, -2, 2, 215829, -494, 111, -95929, 299, 132},
+	// 11-20
+	{0, 0, 2, -2, 1, 128227, 137, 181, -68982, -9, 39},
+	{-1, 0, 2, 0, 2, 123457, 11, 19, -53311, 32, -4},
+	{-1, 0, 0, 2, 0, 156994, 10, -168, -1235, 0, 82},
+	{1, 0, 0, 0, 1, 63110, 63, 27, -33228, 0, -9},
+	{-1, 0, 0, 0, 1, -57976, -63, -189, 31429, 0, -75},
+	{-1, 0, 2, 2, 2, -59641, -11, 149, 25543, -11, 66},
+	{1, 0, 2, 0, 1, -51613, -42, 129, 26366, 0, 78},
+	{-2, 0, 2, 0, 1, 45893, 50, 31, -24236, -10, 20},
+	{0, 0, 0, 2, 0, 63384, 11, -150, -1220, 0, 29},
+	{0, 0, 2, 2, 2, -38571, -1, 158, 16452, -11, 68},
+	// 21-30
+	{0, -2, 2, -2, 2, 32481, 0, 0, -13870, 0, 0},
+	{-2, 0, 0, 2, 0, -47722, 0, -18, 477, 0, -25},
+	{2, 0, 2, 0, 2, -31046, -1, 131, 13238, -11, 59},
+	{1, 0, 2, -2, 2, 28593, 0, -1, -12338, 10, -3},
+	{-1, 0, 2, 0, 1, 20441, 21, 10, -10758, 0, -3},
+	{2, 0, 0, 0, 0, 29243, 0, -74, -609, 0, 13},
+	{0, 0, 2, 0, 0, 25887, 0, -66, -550, 0, 11},
+	{0, 1, 0, 0, 1, -14053, -25, 79, 8551, -2, -45},
+	{-1, 0, 0, 2, 1, 15164, 10, 11, -8001, 0, -1},
+	{0, 2, 2, -2, 2, -15794, 72, -16, 6850, -42, -5},
+	// 31-40
+	{0, 0, -2, 2, 0, 21783, 0, 13, -167, 0, 13},
+	{1, 0, 0, -2, 1, -12873, -10, -37, 6953, 0, -14},
+	{0, -1, 0, 0, 1, -12654, 11, 63, 6415, 0, 26},
+	{-1, 0, 2, 2, 1, -10204, 0, 25, 5222, 0, 15},
+	{0, 2, 0, 0, 0, 16707, -85, -10, 168, -1, 10},
+	{1, 0, 2, 2, 2, -7691, 0, 44, 3268, 0, 19},
+	{-2, 0, 2, 0, 0, -11024, 0, -14, 104, 0, 2},
+	{0, 1, 2, 0, 2, 7566, -21, -11, -3250, 0, -5},
+	{0, 0, 2, 2, 1, -6637, -11, 25, 3353, 0, 14},
+	{0, -1, 2, 0, 2, -7141, 21, 8, 3070, 0, 4},
+	// 41-50
+	{0, 0, 0, 2, 1, -6302, -11, 2, 3272, 0, 4},
+	{1, 0, 2, -2, 1, 5800, 10, 2, -3045, 0, -1},
+	{2, 0, 2, -2, 2, 6443, 0, -7, -2768, 0, -4},
+	{-2, 0, 0, 2, 1, -5774, -11, -15, 3041, 0, -5},
+	{2, 0, 2, 0, 1, -5350, 0, 21, 2695, 0, 12},
+	{0, -1, 2, -2, 1, -4752, -11, -3, 2719, 0, -3},
+	{0, 0, 0, -2, 1, -4940, -11, -21, 2720, 0, -9},
+	{-1, -1, 0, 2, 0, 7350, 0, -8, -51, 0, 4},
+	{2, 0, 0, -2, 1, 4065, 0, 6, -2206, 0, 1},
+	{1, 0, 0, 2, 0, 6579, 0, -24, -199, 0, 2},
+	// 51-60
+	{0, 1, 2, -2, 1, 3579, 0, 5, -1900, 0, 1},
+	{1, -1, 0, 0, 0, 4725, 0, -6, -41, 0, 3},
+	{-2, 0, 2, 0, 2, -3075, 0, -2, 1313, 0, -1},
+	{3, 0, 2, 0, 2, -2904, 0, 15, 1233, 0, 7},
+	{0, -1, 0, 2, 0, 4348, 0, -10, -81, 0, 2},
+	{1, -1, 2, 0, 2, -2878, 0, 8, 1232, 0, 4},
+	{0, 0, 0, 1, 0, -4230, 0, 5, -20, 0, -2},
+	{-1, -1, 2, 2, 2, -2819, 0, 7, 1207, 0, 3},
+	{-1, 0, 2, 0, 0, -4056, 0, 5, 40, 0, -2},
+	{0, -1, 2, 2, 2, -2647, 0, 11, 1129, 0, 5},
+	// 61-70
+	{-2, 0, 0, 0, 1, -2294, 0, -10, 1266, 0, -4},
+	{1, 1, 2, 0, 2, 2481, 0, -7, -1062, 0, -3},
+	{2, 0, 0, 0, 1, 2179, 0, -2, -1129, 0, -2},
+	{-1, 1, 0, 1, 0, 3276, 0, 1, -9, 0, 0},
+	{1, 1, 0, 0, 0, -3389, 0, 5, 35, 0, -2},
+	{1, 0, 2, 0, 0, 3339, 0, -13, -107, 0, 1},
+	{-1, 0, 2, -2, 1, -1987, 0, -6, 1073, 0, -2},
+	{1, 0, 0, 0, 2, -1981, 0, 0, 854, 0, 0},
+	{-1, 0, 0, 1, 0, 4026, 0, -353, -553, 0, -139},
+	{0, 0, 2, 1, 2, 1660, 0, -5, -710, 0, -2},
+	// 71-77
+	{-1, 0, 2, 4, 2, -1521, 0, 9, 647, 0, 4},
+	{-1, 1, 0, 1, 1, 1314, 0, 0, -700, 0, 0},
+	{0, -2, 2, -2, 1, -1283, 0, 0, 672, 0, 0},
+	{1, 0, 2, 2, 1, -1331, 0, 8, 663, 0, 4},
+	{-2, 0, 2, 2, 2, 1383, 0, -2, -594, 0, -2},
+	{-1, 0, 0, 0, 2, 1405, 0, 4, -610, 0, 2},
+	{1, 1, 2, -2, 2, 1290, 0, 0, -556, 0, 0},
+}
+
+// nutation2000 evaluates the IAU 2000B series and its fixed planetary offsets, giving the
+// nutation in longitude and obliquity in degrees within 1 mas of IAU 2000A from 1995 to 2050
+func nutation2000(t float64) (longitude, obliquity float64) {
+	arcsec := func(a float64) float64 { return math.Mod(a, 1296000) / 3600 * constants.Rad }
+	l := arcsec(485868.249036 + 1717915923.2178*t)
+	lp := arcsec(1287104.79305 + 129596581.0481*t)
+	f := arcsec(335779.526232 + 1739527262.8478*t)
+	d := arcsec(1072260.70369 + 1602961601.2090*t)
+	om := arcsec(450160.398036 - 6962890.5431*t)
+
+	// Summed from the smallest term up, as SOFA does, to keep rounding errors down
+	var dpsi, deps float64
+	for i := len(nutationTerms) - 1; i >= 0; i-- {
+		n := nutationTerms[i]
+		sin, cos := math.Sincos(n.l*l + n.lp*lp + n.f*f + n.d*d + n.om*om)
+		dpsi += (n.ps+n.pst*t)*sin + n.pc*cos
+		deps += (n.ec+n.ect*t)*cos + n.es*sin
+	}
+	dpsi = dpsi*1e-7 - 0.000135
+	deps = deps*1e-7 + 0.000388
+	return dpsi / 3600, deps / 3600
+}
@@ -0,0 +1,121 @@
+package coordinates
+
+import (
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"math"
+)
+
+// MinRefractionAltitude is the lowest true altitude in degrees for which refraction is applied
+const MinRefractionAltitude = -1.0
+
+// Standard atmosphere assumed by Refraction
+const (
+	StandardPressure    = 1010.0 // millibars
+	StandardTemperature = 10.0   // degrees Celsius
+)
+
+// Refraction returns the atmospheric refraction in degrees to add to a true (airless) altitude
+// in degrees, for a pressure of 1010 mbar and a temperature of 10 °C (Sæmundsson, Meeus 16.4,
+// with the correction making it vanish at the zenith).
+// It returns zero below MinRefractionAltitude.
+func Refraction(altitude float64) float64 {
+	if altitude < MinRefractionAltitude {
+		return 0
+	}
+	return (1.02/math.Tan((altitude+10.3/(altitude+5.11))*constants.Rad) + 0.0019279) / 60
+}
+
+// RefractionAt returns the refraction in degrees for a true altitude, scaled from the standard
+// atmosphere to a pressure in millibars and a temperature in degrees Celsius (Meeus 16)
+func RefractionAt(altitude, pressure, temperature float64) float64 {
+	return Refraction(altitude) * pressure / StandardPressure * (273 + StandardTemperature) / (273 + temperature)
+}
+
+// refractionIterations bounds the fixed-point iteration of TrueAltitude
+const refractionIterations = 20
+
+// TrueAltitude removes refraction from an observed (refracted) altitude in degrees, inverting
+// Refraction by fixed-point iteration
+func TrueAltitude(observed float64) float64 {
+	return TrueAltitudeAt(observed, StandardPressure, StandardTemperature)
+}
+
+// TrueAltitudeAt removes refraction from an observed altitude in degrees for a pressure in
+// millibars and a temperature in degrees Celsius, inverting RefractionAt
+func TrueAltitudeAt(observed, pressure, temperature float64) float64 {
+	return TrueAltitudeWith(Saemundsson{}, observed, pressure, temperature)
+}
+
+// TrueAltitudeWith removes refraction by a model from an observed altitude in degrees, by
+// fixed-point iteration
+func TrueAltitudeWith(model RefractionModel, observed, pressure, temperature float64) float64 {
+	altitude := observed
+	for i := 0; i < refractionIterations; i++ {
+		next := observed - model.Refraction(altitude, pressure, temperature)
+		if math.Abs(next-altitude) < 1e-12 {
+			return next
+		}
+		altitude = next
+	}
+	return altitude
+}
+
+// RefractionModel computes the atmospheric refraction of a true (airless) altitude, so rise/set
+// and apparent-place calculations can use the model suited to the observation
+type RefractionModel interface {
+	// Refraction returns the refraction in degrees to add to a true altitude in degrees, for a
+	// pressure in millibars and a temperature in degrees Celsius
+	Refraction(altitude, pressure, temperature float64) float64
+}
+
+// Saemundsson is the optical model of Refraction and RefractionAt, used when none is chosen
+type Saemundsson struct{}
+
+// Refraction implements RefractionModel
+func (Saemundsson) Refraction(altitude, pressure, temperature float64) float64 {
+	return RefractionAt(altitude, pressure, temperature)
+}
+
+// Bennett is Bennett's optical formula (Meeus 16.3), which takes the apparent altitude and is
+// solved here for the true one. It gives 34.5' at the horizon, the value behind the standard
+// rising altitudes, and agrees with Saemundsson to 0.1' above 5°.
+type Bennett struct{}
+
+// Refraction implements RefractionModel
+func (Bennett) Refraction(altitude, pressure, temperature float64) float64 {
+	if altitude < MinRefractionAltitude {
+		return 0
+	}
+	scale := pressure / StandardPressure * (273 + StandardTemperature) / (273 + temperature)
+	apparent := altitude
+	for i := 0; i < refractionIterations; i++ {
+		next := altitude + scale*(1/math.Tan((apparent+7.31/(apparent+4.4))*constants.Rad)+0.0013515)/60
+		if math.Abs(next-apparent) < 1e-12 {
+			break
+		}
+		apparent = next
+	}
+	return apparent - altitude
+}
+
+// Radio is the refraction of radio waves, which water vapour increases by up to a third over
+// the optical value: the optical model scaled by the ratio of radio to dry refractivity
+// (Smith-Weintraub). Humidity is the relative humidity from 0 to 1.
+type Radio struct {
+	Humidity float64
+}
+
+// Refraction implements RefractionModel
+func (r Radio) Refraction(altitude, pressure, temperature float64) float64 {
+	vapour := r.Humidity * 6.1094 * math.Exp(17.625*temperature/(temperature+243.04))
+	wet := 4810 * vapour / (pressure * (273.15 + temperature))
+	return RefractionAt(altitude, pressure, temperature) * (1 + wet)
+}
+
+// NoRefraction ignores the atmosphere, for airless bodies or altitudes already corrected
+type NoRefraction struct{}
+
+// Refraction implements RefractionModel
+func (NoRefraction) Refraction(altitude, pressure, temperature float64) float64 {
+	return 0
+}
@@ -0,0 +1,13 @@
+package corrections_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCorrections(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Corrections Suite")
+}
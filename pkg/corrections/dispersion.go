@@ -0,0 +1,51 @@
+package corrections
+
+import (
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"math"
+)
+
+// mmHgPerMillibar converts millibars to the millimetres of mercury used by Filippenko's formulae
+const mmHgPerMillibar = 0.750062
+
+// Conditions describes the air along the line of sight
+type Conditions struct {
+	Pressure    float64 // millibars
+	Temperature float64 // degrees Celsius
+	Humidity    float64 // relative humidity from 0 to 1
+}
+
+// ConditionsAt returns the observer's pressure and temperature, substituting the standard
+// atmosphere when none is set, with the given relative humidity
+func ConditionsAt(obs observer.Observer, humidity float64) Conditions {
+	pressure, temperature := obs.Conditions()
+	return Conditions{Pressure: pressure, Temperature: temperature, Humidity: humidity}
+}
+
+// waterVapourPressure returns the partial pressure of water vapour in millibars (Magnus formula)
+func (c Conditions) waterVapourPressure() float64 {
+	return c.Humidity * 6.1094 * math.Exp(17.625*c.Temperature/(c.Temperature+243.04))
+}
+
+// Refractivity returns n - 1 for air at a wavelength in nm (Edlén 1953 for standard dry air,
+// corrected for pressure, temperature and water vapour as in Filippenko 1982)
+func Refractivity(wavelength float64, c Conditions) float64 {
+	sigma2 := 1e6 / (wavelength * wavelength) // inverse square micrometres
+	standard := 64.328 + 29498.1/(146-sigma2) + 255.4/(41-sigma2)
+
+	pressure := c.Pressure * mmHgPerMillibar
+	scaled := standard * pressure * (1 + (1.049-0.0157*c.Temperature)*1e-6*pressure) /
+		(720.883 * (1 + 0.003661*c.Temperature))
+	vapour := (0.0624 - 0.000680*sigma2) / (1 + 0.003661*c.Temperature) * c.waterVapourPressure() * mmHgPerMillibar
+	return (scaled - vapour) * 1e-6
+}
+
+// AtmosphericDispersion returns the difference in arcseconds between the refraction of light at
+// two wavelengths in nm for a target at a zenith distance in degrees: the length of the spectrum
+// a star is drawn into, with the shorter wavelength displaced toward the zenith when the result
+// is positive. The plane-parallel approximation holds to a few percent down to 15° altitude.
+func AtmosphericDispersion(zenith, shortWavelength, longWavelength float64, c Conditions) float64 {
+	difference := Refractivity(shortWavelength, c) - Refractivity(longWavelength, c)
+	return difference * math.Tan(zenith*constants.Rad) * constants.Arcs
+}
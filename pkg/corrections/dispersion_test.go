@@ -0,0 +1,51 @@
+package corrections
+
+import (
+	"math"
+
+	"github.com/ocrosby/astronomy/pkg/observer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Atmospheric dispersion", func() {
+	standard := Conditions{Pressure: 1013.25, Temperature: 15}
+
+	It("should give the refractivity of standard air", func() {
+		// Edlén: (n - 1) × 10⁶ = 277.1 at the sodium D line
+		Expect(Refractivity(589.3, standard) * 1e6).To(BeNumerically("~", 277.1, 0.1))
+		Expect(Refractivity(400, standard)).To(BeNumerically(">", Refractivity(700, standard)))
+	})
+
+	It("should spread a star into a short spectrum", func() {
+		// about 1.9" between 350 and 550 nm at airmass 1.5 at sea level
+		Expect(AtmosphericDispersion(48.19, 350, 550, standard)).To(BeNumerically("~", 1.91, 0.01))
+		Expect(AtmosphericDispersion(0, 350, 550, standard)).To(Equal(0.0))
+		Expect(AtmosphericDispersion(45, 700, 400, standard)).To(BeNumerically("<", 0))
+	})
+
+	It("should grow with the tangent of the zenith distance", func() {
+		ratio := AtmosphericDispersion(60, 400, 700, standard) / AtmosphericDispersion(30, 400, 700, standard)
+		Expect(ratio).To(BeNumerically("~", 3, 1e-9))
+	})
+
+	It("should shrink at altitude and with water vapour", func() {
+		mountain := ConditionsAt(observer.NewObserver(19.8, -155.5, observer.WithPressure(615), observer.WithTemperature(2)), 0)
+		Expect(mountain.Pressure).To(Equal(615.0))
+		sea := AtmosphericDispersion(45, 400, 500, standard)
+		high := AtmosphericDispersion(45, 400, 500, mountain)
+		Expect(high / sea).To(BeNumerically("~", 615/1013.25*288.15/275.15, 0.01))
+
+		humid := standard
+		humid.Humidity = 1
+		Expect(Refractivity(500, humid)).To(BeNumerically("<", Refractivity(500, standard)))
+		Expect(math.Abs(AtmosphericDispersion(45, 400, 500, humid) - sea)).To(BeNumerically("<", 0.01*sea))
+	})
+
+	It("should default to the standard atmosphere for an observer without conditions", func() {
+		c := ConditionsAt(observer.Observer{Latitude: 50}, 0.5)
+		Expect(c.Pressure).To(Equal(1010.0))
+		Expect(c.Temperature).To(Equal(10.0))
+		Expect(c.Humidity).To(Equal(0.5))
+	})
+})
@@ -0,0 +1,13 @@
+package eclipses_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestEclipses(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Eclipses Suite")
+}
@@ -0,0 +1,84 @@
+// Package eclipses describes the geometry of eclipses.
+package eclipses
+
+import (
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/lunar"
+	"github.com/ocrosby/astronomy/pkg/solar"
+	"math"
+	"time"
+)
+
+// Shadow geometry constants
+const (
+	// ShadowEnlargement is the conventional enlargement of the shadow by the Earth's atmosphere
+	// (Chauvenet's 1/50)
+	ShadowEnlargement = 1.02
+	// ParallaxReduction reduces the Moon's equatorial horizontal parallax to the Earth's radius
+	// at latitude 45°, allowing for the oblate Earth (Meeus ch. 54)
+	ParallaxReduction = 0.998340
+)
+
+// Shadow is the cross-section of the Earth's shadow at the distance of the Moon, as seen from
+// the centre of the Earth. Angles are in degrees.
+type Shadow struct {
+	Center           coordinates.Equatorial // the anti-solar point, apparent of date
+	Umbra            float64                // radius of the umbra
+	Penumbra         float64                // radius of the penumbra
+	Moon             coordinates.Equatorial // the Moon's apparent geocentric position
+	MoonSemidiameter float64
+	Distance         float64 // distance of the Moon in km, the plane of the section
+}
+
+// EarthShadow returns the Earth's shadow at the Moon's distance at t, with the Moon, after
+// Meeus ch. 54, evaluating the theories in TT
+func EarthShadow(t time.Time) Shadow {
+	c := julian.Centuries(astrotime.TT(t))
+	sun := solar.ApparentPosition(c)
+	sunDistance := solar.Distance(c)
+	moonParallax := lunar.Parallax(c)
+	distance := lunar.Distance(c)
+
+	s := constants.SunSemidiameter / sunDistance
+	base := ParallaxReduction*moonParallax + constants.SunParallax/sunDistance
+	return Shadow{
+		Center:           coordinates.Equatorial{RA: angles.NormalizeDegrees(sun.RA + 180), Dec: -sun.Dec},
+		Umbra:            ShadowEnlargement * (base - s),
+		Penumbra:         ShadowEnlargement * (base + s),
+		Moon:             lunar.ApparentPosition(c),
+		MoonSemidiameter: math.Asin(constants.MoonRadius/distance) * constants.Deg,
+		Distance:         distance,
+	}
+}
+
+// Offset returns the position of a point relative to the shadow's centre on the tangent plane in
+// degrees, x towards the east and y towards the north, for drawing the Moon's path
+func (s Shadow) Offset(p coordinates.Equatorial) (x, y float64) {
+	sinDec, cosDec := math.Sincos(p.Dec * constants.Rad)
+	sinDec0, cosDec0 := math.Sincos(s.Center.Dec * constants.Rad)
+	sinRA, cosRA := math.Sincos((p.RA - s.Center.RA) * constants.Rad)
+	cosC := sinDec0*sinDec + cosDec0*cosDec*cosRA
+	x = cosDec * sinRA / cosC * constants.Deg
+	y = (cosDec0*sinDec - sinDec0*cosDec*cosRA) / cosC * constants.Deg
+	return x, y
+}
+
+// Separation returns the angle in degrees between the Moon's centre and the shadow axis
+func (s Shadow) Separation() float64 {
+	return coordinates.Separation(s.Moon, s.Center)
+}
+
+// UmbralMagnitude returns the fraction of the Moon's diameter inside the umbra, negative when
+// the Moon is clear of it and above 1 at totality
+func (s Shadow) UmbralMagnitude() float64 {
+	return (s.Umbra + s.MoonSemidiameter - s.Separation()) / (2 * s.MoonSemidiameter)
+}
+
+// PenumbralMagnitude returns the fraction of the Moon's diameter inside the penumbra
+func (s Shadow) PenumbralMagnitude() float64 {
+	return (s.Penumbra + s.MoonSemidiameter - s.Separation()) / (2 * s.MoonSemidiameter)
+}
@@ -0,0 +1,49 @@
+package eclipses
+
+import (
+	"math"
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EarthShadow", func() {
+	// Greatest eclipse of the total lunar eclipse of 2022 November 8, 10:59:11 TD
+	greatest := time.Date(2022, 11, 8, 10, 58, 2, 0, time.UTC)
+
+	It("should size the shadow at the Moon", func() {
+		s := EarthShadow(greatest)
+		Expect(s.Umbra).To(BeNumerically("~", 0.70, 0.05))
+		Expect(s.Penumbra).To(BeNumerically("~", 1.23, 0.05))
+		Expect(s.Penumbra - s.Umbra).To(BeNumerically("~", 2*ShadowEnlargement*constants.SunSemidiameter/0.99, 0.01))
+		Expect(s.MoonSemidiameter).To(BeNumerically("~", 0.25, 0.02))
+	})
+
+	It("should reproduce the eclipse magnitudes", func() {
+		s := EarthShadow(greatest)
+		Expect(s.UmbralMagnitude()).To(BeNumerically("~", 1.359, 0.005))
+		// The edge of the penumbra is ill-defined, and its published magnitudes depend on the
+		// enlargement convention by a few hundredths
+		Expect(s.PenumbralMagnitude()).To(BeNumerically("~", 2.415, 0.03))
+	})
+
+	It("should put the Moon clear of the shadow away from full moon", func() {
+		s := EarthShadow(greatest.Add(-7 * 24 * time.Hour))
+		Expect(s.PenumbralMagnitude()).To(BeNumerically("<", 0))
+	})
+
+	It("should project onto the tangent plane about the axis", func() {
+		s := EarthShadow(greatest)
+		x, y := s.Offset(s.Center)
+		Expect(x).To(BeNumerically("~", 0, 1e-12))
+		Expect(y).To(BeNumerically("~", 0, 1e-12))
+		x, y = s.Offset(s.Moon)
+		Expect(math.Hypot(x, y)).To(BeNumerically("~", s.Separation(), 1e-4))
+		x, y = s.Offset(coordinates.Equatorial{RA: s.Center.RA, Dec: s.Center.Dec + 0.5})
+		Expect(x).To(BeNumerically("~", 0, 1e-12))
+		Expect(y).To(BeNumerically("~", 0.5, 1e-4))
+	})
+})
@@ -0,0 +1,214 @@
+// Package ephem stores and serves precomputed ephemerides, so that positions from a slow theory
+// or an external source can be looked up and interpolated cheaply.
+package ephem
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/internal/mmap"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/vectors"
+	"io"
+	"math"
+	"os"
+	"time"
+)
+
+// Ephemeris cache layout: a fixed header followed by one little-endian record per sample, each
+// the rectangular equatorial position (x, y, z) in the distance unit of the source
+const (
+	CacheMagic      = "ASTREPH\x00"
+	CacheVersion    = 1
+	CacheHeaderSize = 64
+	CacheNameSize   = 24
+	CacheRecordSize = 3 * 8
+	cacheOrder      = 4 // points used by the Lagrange interpolation (cubic)
+)
+
+// SampleFunc returns the position of a body at a Julian date and its distance
+type SampleFunc func(jd float64) (coordinates.Equatorial, float64)
+
+// Series is a run of positions sampled at a fixed step, as stored in a cache
+type Series struct {
+	Body      string
+	Start     float64 // Julian date of the first sample
+	Step      float64 // days between samples
+	Positions []vectors.Vector3D
+}
+
+// Tabulate samples f every step days from start until end is covered
+func Tabulate(body string, f SampleFunc, start, end, step float64) Series {
+	n := int(math.Ceil((end-start)/step-1e-9)) + 1
+	s := Series{Body: body, Start: start, Step: step, Positions: make([]vectors.Vector3D, n)}
+	for i := range s.Positions {
+		eq, distance := f(start + float64(i)*step)
+		s.Positions[i] = eq.Vector().ScalarMultiply(distance)
+	}
+	return s
+}
+
+// WriteCache writes a series in the binary cache format. Body names longer than CacheNameSize
+// bytes are truncated.
+func WriteCache(w io.Writer, s Series) error {
+	bw := bufio.NewWriter(w)
+
+	header := make([]byte, CacheHeaderSize)
+	copy(header, CacheMagic)
+	binary.LittleEndian.PutUint16(header[8:], CacheVersion)
+	binary.LittleEndian.PutUint16(header[10:], CacheRecordSize)
+	binary.LittleEndian.PutUint64(header[16:], math.Float64bits(s.Start))
+	binary.LittleEndian.PutUint64(header[24:], math.Float64bits(s.Step))
+	binary.LittleEndian.PutUint64(header[32:], uint64(len(s.Positions)))
+	copy(header[40:40+CacheNameSize], s.Body)
+	if _, err := bw.Write(header); err != nil {
+		return err
+	}
+
+	record := make([]byte, CacheRecordSize)
+	for _, p := range s.Positions {
+		binary.LittleEndian.PutUint64(record[0:], math.Float64bits(p.X))
+		binary.LittleEndian.PutUint64(record[8:], math.Float64bits(p.Y))
+		binary.LittleEndian.PutUint64(record[16:], math.Float64bits(p.Z))
+		if _, err := bw.Write(record); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// SaveCache writes a series to path in the binary cache format
+func SaveCache(path string, s Series) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := WriteCache(f, s); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Cache interpolates positions from a binary cache held in memory, typically a mapped file or
+// embedded data; samples are decoded only when needed
+type Cache struct {
+	file  *mmap.File
+	data  []byte
+	body  string
+	start float64
+	step  float64
+	count int
+}
+
+// OpenCache maps a cache file
+func OpenCache(path string) (*Cache, error) {
+	file, err := mmap.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	c, err := NewCache(file.Bytes())
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	c.file = file
+	return c, nil
+}
+
+// NewCache reads a cache from data, which must not change while the cache is in use
+func NewCache(data []byte) (*Cache, error) {
+	if len(data) < CacheHeaderSize || string(data[:len(CacheMagic)]) != CacheMagic {
+		return nil, fmt.Errorf("not an ephemeris cache")
+	}
+	if version := binary.LittleEndian.Uint16(data[8:]); version != CacheVersion {
+		return nil, fmt.Errorf("unsupported ephemeris cache version %d", version)
+	}
+	if size := binary.LittleEndian.Uint16(data[10:]); size != CacheRecordSize {
+		return nil, fmt.Errorf("unexpected record size %d", size)
+	}
+	c := &Cache{
+		data:  data,
+		body:  string(bytes.TrimRight(data[40:40+CacheNameSize], "\x00")),
+		start: math.Float64frombits(binary.LittleEndian.Uint64(data[16:])),
+		step:  math.Float64frombits(binary.LittleEndian.Uint64(data[24:])),
+	}
+	count := binary.LittleEndian.Uint64(data[32:])
+	if uint64(len(data)-CacheHeaderSize)/CacheRecordSize < count {
+		return nil, fmt.Errorf("truncated ephemeris cache: header declares %d samples", count)
+	}
+	if count < 2 || !(c.step > 0) {
+		return nil, fmt.Errorf("ephemeris cache needs at least two samples at a positive step")
+	}
+	c.count = int(count)
+	return c, nil
+}
+
+// Body returns the name of the body the cache describes
+func (c *Cache) Body() string {
+	return c.body
+}
+
+// Len returns the number of samples
+func (c *Cache) Len() int {
+	return c.count
+}
+
+// Start returns the Julian date of the first sample
+func (c *Cache) Start() float64 {
+	return c.start
+}
+
+// End returns the Julian date of the last sample
+func (c *Cache) End() float64 {
+	return c.start + float64(c.count-1)*c.step
+}
+
+// sample decodes the position at index i
+func (c *Cache) sample(i int) vectors.Vector3D {
+	record := c.data[CacheHeaderSize+i*CacheRecordSize:]
+	return vectors.Vector3D{
+		X: math.Float64frombits(binary.LittleEndian.Uint64(record[0:])),
+		Y: math.Float64frombits(binary.LittleEndian.Uint64(record[8:])),
+		Z: math.Float64frombits(binary.LittleEndian.Uint64(record[16:])),
+	}
+}
+
+// Position returns the position and distance at t, making the cache a PositionProvider
+func (c *Cache) Position(t time.Time) (coordinates.Equatorial, float64, error) {
+	return c.PositionAt(julian.FromTime(t))
+}
+
+// PositionAt returns the position and distance at a Julian date by cubic Lagrange interpolation
+// of the samples around it. It fails outside the span of the cache.
+func (c *Cache) PositionAt(jd float64) (coordinates.Equatorial, float64, error) {
+	if jd < c.start || jd > c.End() {
+		return coordinates.Equatorial{}, 0, fmt.Errorf("JD %.5f outside the %s cache span [%.5f, %.5f]", jd, c.body, c.start, c.End())
+	}
+	x := (jd - c.start) / c.step
+	order := min(cacheOrder, c.count)
+	first := max(0, min(int(math.Floor(x))-(order/2-1), c.count-order))
+
+	var v vectors.Vector3D
+	for i := first; i < first+order; i++ {
+		weight := 1.0
+		for j := first; j < first+order; j++ {
+			if j != i {
+				weight *= (x - float64(j)) / float64(i-j)
+			}
+		}
+		v = v.Add(c.sample(i).ScalarMultiply(weight))
+	}
+	return coordinates.EquatorialFromVector(v), v.Magnitude(), nil
+}
+
+// Close releases the mapping of a cache opened from a file
+func (c *Cache) Close() error {
+	c.data = nil
+	if c.file == nil {
+		return nil
+	}
+	return c.file.Close()
+}
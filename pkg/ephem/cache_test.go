@@ -0,0 +1,82 @@
+package ephem
+
+import (
+	"bytes"
+	"path/filepath"
+
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/lunar"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// moon samples the Moon's apparent position and distance in km
+func moon(jd float64) (coordinates.Equatorial, float64) {
+	t := julian.Centuries(jd)
+	return lunar.ApparentPosition(t), lunar.Distance(t)
+}
+
+// cacheBytes encodes a series in the cache format
+func cacheBytes(s Series) []byte {
+	var buffer bytes.Buffer
+	Expect(WriteCache(&buffer, s)).To(Succeed())
+	return buffer.Bytes()
+}
+
+var _ = Describe("Cache", func() {
+	start := 2460371.5
+	series := Tabulate("Moon", moon, start, start+3, 1.0/24)
+
+	It("should sample the whole span", func() {
+		Expect(series.Positions).To(HaveLen(73))
+		eq, distance := moon(start + 1)
+		Expect(coordinates.EquatorialFromVector(series.Positions[24]).RA).To(BeNumerically("~", eq.RA, 1e-9))
+		Expect(series.Positions[24].Magnitude()).To(BeNumerically("~", distance, 1e-6))
+	})
+
+	It("should interpolate a mapped file to a few milliarcseconds", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "moon.eph")
+		Expect(SaveCache(path, series)).To(Succeed())
+		c, err := OpenCache(path)
+		Expect(err).NotTo(HaveOccurred())
+		defer c.Close()
+
+		Expect(c.Body()).To(Equal("Moon"))
+		Expect(c.Len()).To(Equal(73))
+		Expect(c.End()).To(BeNumerically("~", start+3, 1e-9))
+		for _, jd := range []float64{start, start + 0.01, start + 1.4791, start + 2.999} {
+			eq, distance, err := c.PositionAt(jd)
+			Expect(err).NotTo(HaveOccurred())
+			exact, exactDistance := moon(jd)
+			Expect(coordinates.Separation(eq, exact) * 3600).To(BeNumerically("<", 0.01))
+			Expect(distance).To(BeNumerically("~", exactDistance, 0.01))
+		}
+	})
+
+	It("should read embedded data and refuse dates outside it", func() {
+		var buffer bytes.Buffer
+		Expect(WriteCache(&buffer, series)).To(Succeed())
+		Expect(buffer.Len()).To(Equal(CacheHeaderSize + 73*CacheRecordSize))
+		c, err := NewCache(buffer.Bytes())
+		Expect(err).NotTo(HaveOccurred())
+		_, _, err = c.PositionAt(start - 0.1)
+		Expect(err).To(MatchError(ContainSubstring("outside the Moon cache")))
+		Expect(c.Close()).To(Succeed())
+	})
+
+	It("should reject foreign, truncated and future files", func() {
+		var buffer bytes.Buffer
+		Expect(WriteCache(&buffer, series)).To(Succeed())
+		data := buffer.Bytes()
+
+		_, err := NewCache(data[:CacheHeaderSize+10*CacheRecordSize])
+		Expect(err).To(MatchError(ContainSubstring("truncated")))
+		_, err = NewCache([]byte("ASTRCAT\x00 and more bytes"))
+		Expect(err).To(MatchError("not an ephemeris cache"))
+		future := bytes.Clone(data)
+		future[8] = 9
+		_, err = NewCache(future)
+		Expect(err).To(MatchError(ContainSubstring("version 9")))
+	})
+})
@@ -0,0 +1,13 @@
+package ephem_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestEphem(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Ephem Suite")
+}
@@ -0,0 +1,100 @@
+package ephem
+
+import (
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/lunar"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/planets"
+	"github.com/ocrosby/astronomy/pkg/riseset"
+	"github.com/ocrosby/astronomy/pkg/solar"
+	"time"
+)
+
+// PositionProvider is a source of apparent geocentric positions for one body: an analytic
+// theory, a cache, tabulated data or a remote service. Distances are in AU for the providers
+// of this package.
+type PositionProvider interface {
+	Position(t time.Time) (coordinates.Equatorial, float64, error)
+}
+
+// ProviderFunc adapts a function to a PositionProvider
+type ProviderFunc func(t time.Time) (coordinates.Equatorial, float64, error)
+
+// Position calls f
+func (f ProviderFunc) Position(t time.Time) (coordinates.Equatorial, float64, error) {
+	return f(t)
+}
+
+// Sun returns the analytic provider of the Sun's apparent position (Meeus ch. 25)
+func Sun() PositionProvider {
+	return ProviderFunc(func(t time.Time) (coordinates.Equatorial, float64, error) {
+		c := centuries(t)
+		return solar.ApparentPosition(c), solar.Distance(c), nil
+	})
+}
+
+// Moon returns the analytic provider of the Moon's apparent geocentric position (Meeus ch. 47)
+func Moon() PositionProvider {
+	return ProviderFunc(func(t time.Time) (coordinates.Equatorial, float64, error) {
+		c := centuries(t)
+		return lunar.ApparentPosition(c), lunar.Distance(c) / constants.AU, nil
+	})
+}
+
+// Planet returns the analytic provider of a planet's apparent geocentric position from the
+// mean elements, as planets.ApparentPosition gives it
+func Planet(p planets.Planet) PositionProvider {
+	return ProviderFunc(func(t time.Time) (coordinates.Equatorial, float64, error) {
+		c := centuries(t)
+		g := planets.Observe(p, c)
+		frame := coordinates.FrameAt(c)
+		return frame.Nutate(frame.PrecessFromJ2000(g.Equatorial())), g.Distance(), nil
+	})
+}
+
+// centuries returns the Julian centuries of TT since J2000.0 at a UTC instant, the time the
+// analytic theories take
+func centuries(t time.Time) float64 {
+	return julian.Centuries(astrotime.TT(t))
+}
+
+// Fixed returns a provider of an unmoving position at an unknown (zero) distance, for stars and
+// other targets whose motion does not matter. Its rates are computed analytically.
+func Fixed(position coordinates.Equatorial) RateProvider {
+	return fixed{position}
+}
+
+// Sampler adapts a provider to the Julian-date position functions taken by the rise/set, event
+// and pointing searches. A failed lookup yields a zero position and is kept in Err, which the
+// caller checks once the search is done.
+type Sampler struct {
+	Provider PositionProvider
+	Err      error // the first error returned by the provider
+}
+
+// Position returns the provider's position at a Julian date; the method value satisfies
+// riseset.PositionFunc
+func (s *Sampler) Position(jd float64) coordinates.Equatorial {
+	eq, _, err := s.Provider.Position(julian.ToTime(jd))
+	if err != nil && s.Err == nil {
+		s.Err = err
+	}
+	return eq
+}
+
+// RiseSet returns the rising and setting of a provider's body above standardAltitude on the
+// calendar day of date in date's location
+func RiseSet(p PositionProvider, standardAltitude float64, obs observer.Observer, date time.Time) (riseset.Result, error) {
+	if err := obs.Validate(); err != nil {
+		return riseset.Result{}, err
+	}
+	s := Sampler{Provider: p}
+	result := riseset.OnDay(s.Position, standardAltitude, obs, date)
+	if s.Err != nil {
+		return riseset.Result{}, s.Err
+	}
+	return result, nil
+}
@@ -0,0 +1,78 @@
+package ephem
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/lunar"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/planets"
+	"github.com/ocrosby/astronomy/pkg/riseset"
+	"github.com/ocrosby/astronomy/pkg/solar"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Providers", func() {
+	t := time.Date(2024, 3, 20, 3, 6, 0, 0, time.UTC)
+	c := julian.Centuries(astrotime.TT(t))
+
+	It("should wrap the analytic theories with distances in AU", func() {
+		eq, distance, err := Sun().Position(t)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(eq).To(Equal(solar.ApparentPosition(c)))
+		Expect(distance).To(BeNumerically("~", 0.996, 0.001))
+
+		eq, distance, _ = Moon().Position(t)
+		Expect(eq).To(Equal(lunar.ApparentPosition(c)))
+		Expect(distance * constants.AU).To(BeNumerically("~", lunar.Distance(c), 1e-6))
+
+		eq, distance, _ = Planet(planets.Jupiter).Position(t)
+		Expect(coordinates.Separation(eq, planets.ApparentPosition(planets.Jupiter, c)) * 3600).To(BeNumerically("<", 1e-6))
+		Expect(distance).To(BeNumerically("~", planets.Observe(planets.Jupiter, c).Distance(), 1e-12))
+	})
+
+	It("should serve a cache interchangeably with the theory it stores", func() {
+		var provider PositionProvider = Moon()
+		sample := func(jd float64) (coordinates.Equatorial, float64) {
+			eq, distance, _ := provider.Position(julian.ToTime(jd))
+			return eq, distance
+		}
+		jd := julian.FromTime(t)
+		data := Tabulate("Moon", sample, jd-1, jd+1, 1.0/24)
+		cache, err := NewCache(cacheBytes(data))
+		Expect(err).NotTo(HaveOccurred())
+
+		provider = cache
+		eq, _, err := provider.Position(t)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(coordinates.Separation(eq, lunar.ApparentPosition(c)) * 3600).To(BeNumerically("<", 0.01))
+	})
+
+	It("should drive rise and set from any provider", func() {
+		obs := observer.NewObserver(51.48, 0)
+		date := time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC)
+		result, err := RiseSet(Sun(), riseset.HorizonAltitude(obs, constants.SunSemidiameter), obs, date)
+		Expect(err).NotTo(HaveOccurred())
+		sun, err := solar.RiseSet(date, obs)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Rise).To(BeTemporally("~", sun.Rise, time.Second))
+
+		_, err = RiseSet(ProviderFunc(func(time.Time) (coordinates.Equatorial, float64, error) {
+			return coordinates.Equatorial{}, 0, errors.New("no data")
+		}), riseset.SunAltitude, obs, date)
+		Expect(err).To(MatchError("no data"))
+	})
+
+	It("should hold fixed targets still", func() {
+		vega := coordinates.Equatorial{RA: 279.23, Dec: 38.78}
+		eq, distance, err := Fixed(vega).Position(t)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(eq).To(Equal(vega))
+		Expect(distance).To(BeZero())
+	})
+})
@@ -0,0 +1,86 @@
+package ephem
+
+import (
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/pointing"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	"math"
+	"time"
+)
+
+// siderealRate is the rate of the hour angle of a fixed point in arcseconds per second
+const siderealRate = 360.98564736629 * 3600 / julian.SecondsPerDay
+
+// rangeStep is the half-width in days of the central difference used for the range rate
+const rangeStep = 30.0 / julian.SecondsPerDay
+
+// Rates are the rates of change of a body's coordinates in arcseconds per second, as
+// pointing.TrackingRates defines them, with the rate of its distance
+type Rates struct {
+	pointing.Rates
+	Range float64 // distance units per second, km/s for distances in km
+}
+
+// RateProvider is a PositionProvider that differentiates its positions itself, analytically or
+// by a method suited to its data
+type RateProvider interface {
+	PositionProvider
+	Rates(obs observer.Observer, t time.Time) (Rates, error)
+}
+
+// RatesOf returns the rates of a provider's body for an observer at t, from the provider itself
+// when it is a RateProvider and by central differences otherwise. The range rate converts the
+// provider's AU to km.
+func RatesOf(p PositionProvider, obs observer.Observer, t time.Time) (Rates, error) {
+	if rp, ok := p.(RateProvider); ok {
+		return rp.Rates(obs, t)
+	}
+
+	s := Sampler{Provider: p}
+	rates := Rates{Rates: pointing.TrackingRates(s.Position, obs, t)}
+	_, before, err := p.Position(t.Add(-secondsDuration(rangeStep)))
+	if err == nil {
+		var after float64
+		_, after, err = p.Position(t.Add(secondsDuration(rangeStep)))
+		rates.Range = (after - before) * constants.AU / (2 * rangeStep * julian.SecondsPerDay)
+	}
+	if s.Err != nil {
+		return Rates{}, s.Err
+	}
+	if err != nil {
+		return Rates{}, err
+	}
+	return rates, nil
+}
+
+// secondsDuration converts days to a duration
+func secondsDuration(days float64) time.Duration {
+	return time.Duration(days * julian.SecondsPerDay * float64(time.Second))
+}
+
+// fixed is an unmoving position whose horizontal rates follow from the Earth's rotation alone
+type fixed struct {
+	position coordinates.Equatorial
+}
+
+// Position returns the fixed position at an unknown (zero) distance
+func (f fixed) Position(time.Time) (coordinates.Equatorial, float64, error) {
+	return f.position, 0, nil
+}
+
+// Rates returns the horizontal rates of a fixed point analytically: with the hour angle
+// increasing at the sidereal rate, dh/dt = cos φ sin A dH/dt and
+// dA/dt = (sin φ - cos φ cos A tan h) dH/dt
+func (f fixed) Rates(obs observer.Observer, t time.Time) (Rates, error) {
+	jd := julian.FromTime(t)
+	h := f.position.ToHorizontal(obs.Latitude, sidereal.LocalMeanSiderealTime(jd, obs.Longitude))
+	sinLat, cosLat := math.Sincos(obs.Latitude * constants.Rad)
+	sinAz, cosAz := math.Sincos(h.Azimuth * constants.Rad)
+	return Rates{Rates: pointing.Rates{
+		Azimuth:  (sinLat - cosLat*cosAz*math.Tan(h.Altitude*constants.Rad)) * siderealRate,
+		Altitude: cosLat * sinAz * siderealRate,
+	}}, nil
+}
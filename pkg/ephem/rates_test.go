@@ -0,0 +1,36 @@
+package ephem
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rates", func() {
+	obs := observer.NewObserver(40, -75)
+	t := time.Date(2024, 3, 20, 3, 0, 0, 0, time.UTC)
+
+	It("should differentiate a fixed target analytically as the numeric fallback does", func() {
+		vega := coordinates.Equatorial{RA: 279.23, Dec: 38.78}
+		analytic, err := RatesOf(Fixed(vega), obs, t)
+		Expect(err).NotTo(HaveOccurred())
+		numeric, err := RatesOf(ProviderFunc(Fixed(vega).Position), obs, t)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(analytic.RA).To(BeZero())
+		Expect(analytic.Azimuth).To(BeNumerically("~", numeric.Azimuth, 1e-3))
+		Expect(analytic.Altitude).To(BeNumerically("~", numeric.Altitude, 1e-3))
+		Expect(numeric.RA).To(BeNumerically("~", 0, 1e-9))
+	})
+
+	It("should give the Moon's eastward drift and range rate", func() {
+		rates, err := RatesOf(Moon(), obs, t)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rates.RA).To(BeNumerically("~", 0.55, 0.15))
+		Expect(rates.Range).To(BeNumerically("<", 0.1))
+		Expect(rates.Range).To(BeNumerically(">", -0.1))
+	})
+})
@@ -0,0 +1,211 @@
+package ephem
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/vectors"
+	"math"
+	"sort"
+	"time"
+)
+
+// defaultTableOrder is the number of samples a Table's Lagrange interpolation uses (cubic)
+const defaultTableOrder = 4
+
+// ErrOutsideTable is returned for a time beyond the samples of a Table and its allowed
+// extrapolation
+var ErrOutsideTable = errors.New("ephem: time outside the table span")
+
+// Interpolation selects how a Table interpolates between its samples
+type Interpolation int
+
+const (
+	Lagrange Interpolation = iota // polynomial through the nearest samples
+	Hermite                       // cubic through the two bracketing samples matching their velocities
+)
+
+// String returns the name of the interpolation
+func (i Interpolation) String() string {
+	return [...]string{"Lagrange", "Hermite"}[i]
+}
+
+// Sample is one tabulated position at an arbitrary Julian date. Velocity, in distance units per
+// day, is used by Hermite interpolation; when every sample leaves it zero it is estimated from
+// the neighbouring samples.
+type Sample struct {
+	JD       float64
+	Position vectors.Vector3D
+	Velocity vectors.Vector3D
+}
+
+// SampleOf builds a sample from an equatorial direction and a distance
+func SampleOf(jd float64, position coordinates.Equatorial, distance float64) Sample {
+	return Sample{JD: jd, Position: position.Vector().ScalarMultiply(distance)}
+}
+
+// tableOptions holds the settings of a Table
+type tableOptions struct {
+	interpolation Interpolation
+	order         int
+	extrapolation float64
+}
+
+// TableOption configures NewTable
+type TableOption func(*tableOptions)
+
+// WithInterpolation selects Lagrange (the default) or Hermite interpolation
+func WithInterpolation(i Interpolation) TableOption {
+	return func(o *tableOptions) { o.interpolation = i }
+}
+
+// WithOrder sets the number of samples used by Lagrange interpolation, 4 (cubic) by default
+func WithOrder(points int) TableOption {
+	return func(o *tableOptions) { o.order = points }
+}
+
+// WithExtrapolation allows lookups up to days beyond the first and last samples; by default a
+// table refuses any time outside its span
+func WithExtrapolation(days float64) TableOption {
+	return func(o *tableOptions) { o.extrapolation = days }
+}
+
+// Table holds positions sampled at arbitrary, increasing Julian dates, such as rows exported
+// from an external ephemeris, and interpolates them. It is a PositionProvider, so the data can
+// be used wherever the analytic theories are.
+type Table struct {
+	body       string
+	samples    []Sample
+	options    tableOptions
+	velocities bool // whether the samples carry velocities
+}
+
+// NewTable creates a table from at least two samples in strictly increasing time order
+func NewTable(body string, samples []Sample, opts ...TableOption) (*Table, error) {
+	o := tableOptions{interpolation: Lagrange, order: defaultTableOrder}
+	for _, apply := range opts {
+		apply(&o)
+	}
+	if len(samples) < 2 {
+		return nil, fmt.Errorf("table %s needs at least 2 samples, got %d", body, len(samples))
+	}
+	if o.order < 2 {
+		return nil, fmt.Errorf("interpolation order %d is below 2", o.order)
+	}
+	if o.extrapolation < 0 {
+		return nil, fmt.Errorf("negative extrapolation %g days", o.extrapolation)
+	}
+
+	t := &Table{body: body, samples: append([]Sample(nil), samples...), options: o}
+	for i, s := range t.samples {
+		if i > 0 && !(s.JD > t.samples[i-1].JD) {
+			return nil, fmt.Errorf("table %s sample %d at JD %.5f does not follow JD %.5f", body, i, s.JD, t.samples[i-1].JD)
+		}
+		if s.Velocity != (vectors.Vector3D{}) {
+			t.velocities = true
+		}
+	}
+	return t, nil
+}
+
+// Body returns the name of the tabulated body
+func (t *Table) Body() string {
+	return t.body
+}
+
+// Len returns the number of samples
+func (t *Table) Len() int {
+	return len(t.samples)
+}
+
+// Start returns the Julian date of the first sample
+func (t *Table) Start() float64 {
+	return t.samples[0].JD
+}
+
+// End returns the Julian date of the last sample
+func (t *Table) End() float64 {
+	return t.samples[len(t.samples)-1].JD
+}
+
+// Position returns the position and distance at t, making the table a PositionProvider
+func (t *Table) Position(tm time.Time) (coordinates.Equatorial, float64, error) {
+	return t.PositionAt(julian.FromTime(tm))
+}
+
+// PositionAt returns the interpolated position and distance at a Julian date. It fails beyond
+// the span of the samples by more than the allowed extrapolation, where a polynomial quickly
+// diverges.
+func (t *Table) PositionAt(jd float64) (coordinates.Equatorial, float64, error) {
+	v, err := t.VectorAt(jd)
+	if err != nil {
+		return coordinates.Equatorial{}, 0, err
+	}
+	return coordinates.EquatorialFromVector(v), v.Magnitude(), nil
+}
+
+// VectorAt returns the interpolated rectangular position at a Julian date
+func (t *Table) VectorAt(jd float64) (vectors.Vector3D, error) {
+	if math.IsNaN(jd) || jd < t.Start()-t.options.extrapolation || jd > t.End()+t.options.extrapolation {
+		return vectors.Vector3D{}, fmt.Errorf("%w: JD %.5f beyond the %s table [%.5f, %.5f]", ErrOutsideTable, jd, t.body, t.Start(), t.End())
+	}
+	// index of the first sample after jd, kept inside the table
+	next := min(max(sort.Search(len(t.samples), func(i int) bool { return t.samples[i].JD > jd }), 1), len(t.samples)-1)
+	if t.options.interpolation == Hermite {
+		return t.hermite(next-1, jd), nil
+	}
+	return t.lagrange(next, jd), nil
+}
+
+// lagrange interpolates through the order samples centred on the interval ending at next
+func (t *Table) lagrange(next int, jd float64) vectors.Vector3D {
+	order := min(t.options.order, len(t.samples))
+	first := max(0, min(next-order/2, len(t.samples)-order))
+
+	var v vectors.Vector3D
+	for i := first; i < first+order; i++ {
+		weight := 1.0
+		for j := first; j < first+order; j++ {
+			if j != i {
+				weight *= (jd - t.samples[j].JD) / (t.samples[i].JD - t.samples[j].JD)
+			}
+		}
+		v = v.Add(t.samples[i].Position.ScalarMultiply(weight))
+	}
+	return v
+}
+
+// hermite interpolates the cubic matching positions and velocities at samples i and i+1
+func (t *Table) hermite(i int, jd float64) vectors.Vector3D {
+	a, b := t.samples[i], t.samples[i+1]
+	h := b.JD - a.JD
+	s := (jd - a.JD) / h
+	s2, s3 := s*s, s*s*s
+	h00, h10 := 2*s3-3*s2+1, s3-2*s2+s
+	h01, h11 := -2*s3+3*s2, s3-s2
+	return a.Position.ScalarMultiply(h00).
+		Add(t.velocity(i).ScalarMultiply(h10 * h)).
+		Add(b.Position.ScalarMultiply(h01)).
+		Add(t.velocity(i + 1).ScalarMultiply(h11 * h))
+}
+
+// velocity returns the velocity of sample i, estimated when the samples carry none by the
+// derivative of the parabola through it and its neighbours
+func (t *Table) velocity(i int) vectors.Vector3D {
+	if t.velocities {
+		return t.samples[i].Velocity
+	}
+	n := len(t.samples)
+	if n == 2 {
+		return t.samples[1].Position.Subtract(t.samples[0].Position).ScalarMultiply(1 / (t.samples[1].JD - t.samples[0].JD))
+	}
+	c := min(max(i, 1), n-2) // centre of the three samples used
+	x0, x1, x2 := t.samples[c-1].JD, t.samples[c].JD, t.samples[c+1].JD
+	p0, p1, p2 := t.samples[c-1].Position, t.samples[c].Position, t.samples[c+1].Position
+	x := t.samples[i].JD
+	w0 := (2*x - x1 - x2) / ((x0 - x1) * (x0 - x2))
+	w1 := (2*x - x0 - x2) / ((x1 - x0) * (x1 - x2))
+	w2 := (2*x - x0 - x1) / ((x2 - x0) * (x2 - x1))
+	return p0.ScalarMultiply(w0).Add(p1.ScalarMultiply(w1)).Add(p2.ScalarMultiply(w2))
+}
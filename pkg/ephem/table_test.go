@@ -0,0 +1,108 @@
+package ephem
+
+import (
+	"math"
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/vectors"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Table", func() {
+	start := 2460371.5
+	// irregular epochs about two hours apart, as an exported ephemeris might give
+	var samples []Sample
+	for i, jd := 0, start; jd < start+2; i++ {
+		eq, distance := moon(jd)
+		samples = append(samples, SampleOf(jd, eq, distance))
+		jd += 1.0/12 + 0.01*math.Sin(float64(i))
+	}
+
+	check := func(table *Table, tolerance float64) {
+		for jd := start + 0.3; jd < start+1.7; jd += 0.137 {
+			eq, distance, err := table.PositionAt(jd)
+			Expect(err).NotTo(HaveOccurred())
+			want, wantDistance := moon(jd)
+			Expect(coordinates.Separation(eq, want) * 3600).To(BeNumerically("<", tolerance))
+			Expect(distance).To(BeNumerically("~", wantDistance, 1))
+		}
+	}
+
+	It("should interpolate irregular samples with Lagrange polynomials", func() {
+		table, err := NewTable("Moon", samples)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(table.Body()).To(Equal("Moon"))
+		Expect(table.Len()).To(Equal(len(samples)))
+		Expect(table.Start()).To(Equal(start))
+		check(table, 0.05)
+
+		higher, err := NewTable("Moon", samples, WithOrder(8))
+		Expect(err).NotTo(HaveOccurred())
+		check(higher, 0.001)
+	})
+
+	It("should interpolate with Hermite cubics from estimated velocities", func() {
+		table, err := NewTable("Moon", samples, WithInterpolation(Hermite))
+		Expect(err).NotTo(HaveOccurred())
+		check(table, 0.5)
+	})
+
+	It("should use supplied velocities", func() {
+		// uniform circular motion of unit radius, one radian per day
+		var circle []Sample
+		for jd := 0.0; jd <= 2; jd += 0.5 {
+			circle = append(circle, Sample{
+				JD:       jd,
+				Position: vectors.Vector3D{X: math.Cos(jd), Y: math.Sin(jd)},
+				Velocity: vectors.Vector3D{X: -math.Sin(jd), Y: math.Cos(jd)},
+			})
+		}
+		table, err := NewTable("circle", circle, WithInterpolation(Hermite))
+		Expect(err).NotTo(HaveOccurred())
+		v, err := table.VectorAt(1.25)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(v.X).To(BeNumerically("~", math.Cos(1.25), 3e-4))
+		Expect(v.Y).To(BeNumerically("~", math.Sin(1.25), 3e-4))
+		Expect(Hermite.String()).To(Equal("Hermite"))
+	})
+
+	It("should guard against extrapolation", func() {
+		table, _ := NewTable("Moon", samples)
+		_, _, err := table.PositionAt(start - 0.01)
+		Expect(err).To(MatchError(ErrOutsideTable))
+		_, _, err = table.PositionAt(math.NaN())
+		Expect(err).To(MatchError(ErrOutsideTable))
+
+		lenient, _ := NewTable("Moon", samples, WithExtrapolation(0.05))
+		_, _, err = lenient.PositionAt(start - 0.01)
+		Expect(err).NotTo(HaveOccurred())
+		_, _, err = lenient.PositionAt(table.End() + 0.06)
+		Expect(err).To(MatchError(ErrOutsideTable))
+	})
+
+	It("should serve as a provider", func() {
+		table, _ := NewTable("Moon", samples)
+		var provider PositionProvider = table
+		t := julian.ToTime(start + 1)
+		eq, _, err := provider.Position(t)
+		Expect(err).NotTo(HaveOccurred())
+		want, _ := moon(julian.FromTime(t))
+		Expect(coordinates.Separation(eq, want) * 3600).To(BeNumerically("<", 0.05))
+		_, _, err = provider.Position(t.Add(72 * time.Hour))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject unusable samples", func() {
+		_, err := NewTable("Moon", samples[:1])
+		Expect(err).To(HaveOccurred())
+		_, err = NewTable("Moon", []Sample{samples[1], samples[0]})
+		Expect(err).To(HaveOccurred())
+		_, err = NewTable("Moon", samples, WithOrder(1))
+		Expect(err).To(HaveOccurred())
+		_, err = NewTable("Moon", samples, WithExtrapolation(-1))
+		Expect(err).To(HaveOccurred())
+	})
+})
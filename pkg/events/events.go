@@ -0,0 +1,42 @@
+package events
+
+import (
+	"sort"
+	"time"
+)
+
+// Kind identifies the type of an astronomical event
+type Kind int
+
+const (
+	Conjunction Kind = iota
+	Opposition
+	NewMoon      // Value is the illuminated fraction of the Moon
+	FirstQuarter // Value is the illuminated fraction of the Moon
+	FullMoon     // Value is the illuminated fraction of the Moon
+	LastQuarter  // Value is the illuminated fraction of the Moon
+	Rise         // Body crosses the horizon upward; Value is its azimuth in degrees
+	Set          // Body crosses the horizon downward; Value is its azimuth in degrees
+)
+
+// String returns a readable name for the event kind
+func (k Kind) String() string {
+	return [...]string{"Conjunction", "Opposition", "NewMoon", "FirstQuarter", "FullMoon", "LastQuarter",
+		"Rise", "Set"}[k]
+}
+
+// Event is an astronomical event found by a search
+type Event struct {
+	Kind  Kind
+	Time  time.Time
+	Body  string  // primary body involved, if any
+	Other string  // second body involved, if any
+	Value float64 // kind-specific value at the event, e.g. a separation in degrees
+}
+
+// Sort orders events chronologically
+func Sort(events []Event) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time.Before(events[j].Time)
+	})
+}
@@ -0,0 +1,117 @@
+package events
+
+import (
+	"math"
+)
+
+// The search engine works on functions of the Julian date: it samples the function at a fixed
+// step and refines every bracketed root or extremum. The step must be short enough that no two
+// events fall within one step.
+
+// Tolerance is the precision in days to which events are refined (about 0.01 s)
+const Tolerance = 1e-7
+
+// Func is a continuous function of the Julian date
+type Func func(jd float64) float64
+
+// Crossing is a root of a function
+type Crossing struct {
+	JD     float64
+	Rising bool // whether the function increases through zero
+}
+
+// Extremum is a local minimum or maximum of a function
+type Extremum struct {
+	JD      float64
+	Value   float64
+	Maximum bool
+}
+
+// FindCrossings returns the roots of f between start and end, sampling every step days
+func FindCrossings(f Func, start, end, step float64) []Crossing {
+	return findCrossings(f, start, end, step, math.Inf(1))
+}
+
+// FindAngleCrossings returns the times between start and end at which the angle f (degrees)
+// passes through target. Wrap-around discontinuities at ±180° from the target are ignored.
+func FindAngleCrossings(f Func, target, start, end, step float64) []Crossing {
+	wrapped := func(jd float64) float64 {
+		return math.Remainder(f(jd)-target, 360)
+	}
+	return findCrossings(wrapped, start, end, step, 180)
+}
+
+// findCrossings brackets sign changes whose jump is below maxJump and refines them
+func findCrossings(f Func, start, end, step, maxJump float64) []Crossing {
+	var crossings []Crossing
+	a, fa := start, f(start)
+	for a < end {
+		b := math.Min(a+step, end)
+		fb := f(b)
+		if fa == 0 {
+			crossings = append(crossings, Crossing{JD: a, Rising: fb > 0})
+		} else if fa*fb < 0 && math.Abs(fb-fa) < maxJump {
+			crossings = append(crossings, Crossing{JD: Bisect(f, a, b, fa), Rising: fb > fa})
+		}
+		a, fa = b, fb
+	}
+	return crossings
+}
+
+// Bisect refines a root of f bracketed by [a, b], where fa = f(a), to within Tolerance
+func Bisect(f Func, a, b, fa float64) float64 {
+	for b-a > Tolerance {
+		mid := (a + b) / 2
+		fm := f(mid)
+		if fm == 0 {
+			return mid
+		}
+		if (fm < 0) == (fa < 0) {
+			a, fa = mid, fm
+		} else {
+			b = mid
+		}
+	}
+	return (a + b) / 2
+}
+
+// FindExtrema returns the local minima and maxima of f strictly inside (start, end),
+// sampling every step days
+func FindExtrema(f Func, start, end, step float64) []Extremum {
+	var extrema []Extremum
+	a, b := start, math.Min(start+step, end)
+	fa, fb := f(a), f(b)
+	for b < end {
+		c := math.Min(b+step, end)
+		fc := f(c)
+		if fb > fa && fb >= fc {
+			jd := GoldenSection(func(jd float64) float64 { return -f(jd) }, a, c)
+			extrema = append(extrema, Extremum{JD: jd, Value: f(jd), Maximum: true})
+		} else if fb < fa && fb <= fc {
+			jd := GoldenSection(f, a, c)
+			extrema = append(extrema, Extremum{JD: jd, Value: f(jd)})
+		}
+		a, b, fa, fb = b, c, fb, fc
+	}
+	return extrema
+}
+
+// GoldenSection returns the minimum of f in [a, b] to within Tolerance, assuming f is unimodal
+func GoldenSection(f Func, a, b float64) float64 {
+	ratio := (math.Sqrt(5) - 1) / 2
+	c := b - ratio*(b-a)
+	d := a + ratio*(b-a)
+	fc, fd := f(c), f(d)
+	for b-a > Tolerance {
+		if fc < fd {
+			b, d, fd = d, c, fc
+			c = b - ratio*(b-a)
+			fc = f(c)
+		} else {
+			a, c, fc = c, d, fd
+			d = a + ratio*(b-a)
+			fd = f(d)
+		}
+	}
+	return (a + b) / 2
+}
@@ -0,0 +1,64 @@
+package events
+
+import (
+	"math"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Search", func() {
+	sine := func(jd float64) float64 { return math.Sin(jd) }
+
+	It("should find the roots of a function", func() {
+		crossings := FindCrossings(sine, 0.5, 10, 0.3)
+		Expect(crossings).To(HaveLen(3))
+		for i, c := range crossings {
+			Expect(c.JD).To(BeNumerically("~", float64(i+1)*math.Pi, 1e-6))
+		}
+		Expect(crossings[0].Rising).To(BeFalse())
+		Expect(crossings[1].Rising).To(BeTrue())
+	})
+
+	It("should report a root on a sample point once", func() {
+		line := func(jd float64) float64 { return jd - 1 }
+		crossings := FindCrossings(line, 0, 2, 0.5)
+		Expect(crossings).To(HaveLen(1))
+		Expect(crossings[0].JD).To(Equal(1.0))
+		Expect(crossings[0].Rising).To(BeTrue())
+	})
+
+	It("should find angle crossings without reporting wrap-around", func() {
+		// an angle increasing by 10 degrees per day from 0
+		angle := func(jd float64) float64 { return math.Mod(10*jd, 360) }
+		crossings := FindAngleCrossings(angle, 90, 0, 100, 1)
+		Expect(crossings).To(HaveLen(3))
+		Expect(crossings[0].JD).To(BeNumerically("~", 9, 1e-6))
+		Expect(crossings[1].JD).To(BeNumerically("~", 45, 1e-6))
+		Expect(crossings[2].JD).To(BeNumerically("~", 81, 1e-6))
+	})
+
+	It("should find the extrema of a function", func() {
+		extrema := FindExtrema(sine, 0, 7, 0.25)
+		Expect(extrema).To(HaveLen(2))
+		Expect(extrema[0].Maximum).To(BeTrue())
+		Expect(extrema[0].JD).To(BeNumerically("~", math.Pi/2, 1e-5))
+		Expect(extrema[0].Value).To(BeNumerically("~", 1, 1e-9))
+		Expect(extrema[1].Maximum).To(BeFalse())
+		Expect(extrema[1].JD).To(BeNumerically("~", 3*math.Pi/2, 1e-5))
+	})
+
+	It("should minimize with the golden section search", func() {
+		parabola := func(x float64) float64 { return (x - 2.5) * (x - 2.5) }
+		Expect(GoldenSection(parabola, 0, 10)).To(BeNumerically("~", 2.5, 1e-6))
+	})
+
+	It("should sort events chronologically", func() {
+		t := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		list := []Event{{Kind: Opposition, Time: t.Add(time.Hour)}, {Kind: Conjunction, Time: t}}
+		Sort(list)
+		Expect(list[0].Kind).To(Equal(Conjunction))
+		Expect(list[0].Kind.String()).To(Equal("Conjunction"))
+	})
+})
@@ -0,0 +1,110 @@
+package events
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Template keys of the sentences Summary builds; the placeholders {event}, {body}, {other},
+// {time}, {zone}, {illumination} and {value} are filled in after translation
+const (
+	PhaseTemplate   = "template.phase"   // lunar phases
+	PairTemplate    = "template.pair"    // conjunctions and oppositions of two bodies
+	HorizonTemplate = "template.horizon" // rising and setting of a body
+	LocalTimeKey    = "zone.local"       // name of the zone when it is not UTC
+	UTCKey          = "zone.utc"         // name of the zone when it is UTC
+)
+
+// defaultTimeFormat is the clock layout used when the locale gives none
+const defaultTimeFormat = "15:04"
+
+// Catalog supplies the localized text used by Summary: sentence templates, the zone names
+// and the name of each Kind and body, keyed by their String values
+type Catalog interface {
+	// Message returns the text for a key, or false when the catalog has no entry so that the
+	// English text is used
+	Message(key string) (string, bool)
+}
+
+// Messages is a Catalog held in a map
+type Messages map[string]string
+
+// Message returns the entry for a key
+func (m Messages) Message(key string) (string, bool) {
+	text, ok := m[key]
+	return text, ok
+}
+
+// English is the built-in catalog, used for any key another catalog lacks
+var English = Messages{
+	PhaseTemplate:   "{event} at {time} {zone}, {illumination} illuminated",
+	PairTemplate:    "{event} of {body} and {other} at {time} {zone}",
+	HorizonTemplate: "{body} {event} at {time} {zone}",
+	LocalTimeKey:    "local time",
+	UTCKey:          "UTC",
+	"Conjunction":   "Conjunction",
+	"Opposition":    "Opposition",
+	"NewMoon":       "New Moon",
+	"FirstQuarter":  "First Quarter",
+	"FullMoon":      "Full Moon",
+	"LastQuarter":   "Last Quarter",
+	"Rise":          "rises",
+	"Set":           "sets",
+}
+
+// Locale controls how Summary renders an event
+type Locale struct {
+	Location   *time.Location // zone of the clock time; time.Local when nil
+	TimeFormat string         // layout of the clock time; "15:04" when empty
+	Catalog    Catalog        // translations, falling back to English key by key; English when nil
+}
+
+// message returns the locale's text for a key, then the English text, then the key itself
+func (l Locale) message(key string) string {
+	if l.Catalog != nil {
+		if text, ok := l.Catalog.Message(key); ok {
+			return text
+		}
+	}
+	if text, ok := English[key]; ok {
+		return text
+	}
+	return key
+}
+
+// Summary returns a sentence describing an event for display, such as "Full Moon at 03:14
+// local time, 99.8% illuminated". The phrasing comes from the locale's catalog so an
+// application can translate it by supplying the templates and names alone.
+func Summary(event Event, locale Locale) string {
+	location := locale.Location
+	if location == nil {
+		location = time.Local
+	}
+	layout := locale.TimeFormat
+	if layout == "" {
+		layout = defaultTimeFormat
+	}
+	zone := locale.message(LocalTimeKey)
+	if location == time.UTC {
+		zone = locale.message(UTCKey)
+	}
+
+	template := HorizonTemplate
+	switch event.Kind {
+	case NewMoon, FirstQuarter, FullMoon, LastQuarter:
+		template = PhaseTemplate
+	case Conjunction, Opposition:
+		template = PairTemplate
+	}
+
+	return strings.NewReplacer(
+		"{event}", locale.message(event.Kind.String()),
+		"{body}", locale.message(event.Body),
+		"{other}", locale.message(event.Other),
+		"{time}", event.Time.In(location).Format(layout),
+		"{zone}", zone,
+		"{illumination}", fmt.Sprintf("%.1f%%", 100*event.Value),
+		"{value}", fmt.Sprintf("%.1f", event.Value),
+	).Replace(locale.message(template))
+}
@@ -0,0 +1,52 @@
+package events
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Summary", func() {
+	t := time.Date(2024, 4, 24, 0, 49, 0, 0, time.UTC)
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		newYork = time.FixedZone("EDT", -4*3600)
+	}
+
+	It("should describe a lunar phase in local time", func() {
+		full := Event{Kind: FullMoon, Time: t, Body: "Moon", Value: 0.998}
+		Expect(Summary(full, Locale{Location: newYork})).
+			To(Equal("Full Moon at 20:49 local time, 99.8% illuminated"))
+		Expect(Summary(full, Locale{Location: time.UTC, TimeFormat: "Jan 2 15:04"})).
+			To(Equal("Full Moon at Apr 24 00:49 UTC, 99.8% illuminated"))
+	})
+
+	It("should describe conjunctions and horizon events", func() {
+		pair := Event{Kind: Conjunction, Time: t, Body: "Venus", Other: "Jupiter"}
+		Expect(Summary(pair, Locale{Location: time.UTC})).To(Equal("Conjunction of Venus and Jupiter at 00:49 UTC"))
+		rise := Event{Kind: Rise, Time: t, Body: "Moon"}
+		Expect(Summary(rise, Locale{Location: time.UTC})).To(Equal("Moon rises at 00:49 UTC"))
+	})
+
+	It("should translate through a catalog, falling back to English", func() {
+		french := Messages{
+			PhaseTemplate: "{event} à {time} ({zone}), éclairée à {illumination}",
+			LocalTimeKey:  "heure locale",
+			"FullMoon":    "Pleine Lune",
+			"Moon":        "la Lune",
+		}
+		locale := Locale{Location: newYork, Catalog: french}
+		full := Event{Kind: FullMoon, Time: t, Value: 0.998}
+		Expect(Summary(full, locale)).To(Equal("Pleine Lune à 20:49 (heure locale), éclairée à 99.8%"))
+		set := Event{Kind: Set, Time: t, Body: "Moon"}
+		Expect(Summary(set, locale)).To(Equal("la Lune sets at 20:49 heure locale"))
+	})
+
+	It("should name every kind", func() {
+		for k := Conjunction; k <= Set; k++ {
+			_, ok := English[k.String()]
+			Expect(ok).To(BeTrue(), k.String())
+		}
+	})
+})
@@ -0,0 +1,327 @@
+package geodesy
+
+import (
+	"fmt"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// WGS84 ellipsoid and UTM projection constants
+const (
+	SemiMajorAxis   = 6378137.0         // WGS84 equatorial radius in meters
+	Flattening      = 1 / 298.257223563 // WGS84 flattening
+	ScaleFactor     = 0.9996            // UTM central meridian scale factor
+	FalseEasting    = 500000.0          // UTM false easting in meters
+	FalseNorthing   = 10000000.0        // UTM false northing for the southern hemisphere in meters
+	ZoneWidth       = 6.0               // width of a UTM zone in degrees of longitude
+	BandHeight      = 8.0               // height of a latitude band in degrees
+	MinUTMLatitude  = -80.0             // southern limit of the UTM grid
+	MaxUTMLatitude  = 84.0              // northern limit of the UTM grid
+	SquareSize      = 100000.0          // MGRS 100 km grid square size in meters
+	NorthingCycle   = 2000000.0         // MGRS row letters repeat every 2000 km
+	LatitudeBands   = "CDEFGHJKLMNPQRSTUVWXX"
+	MGRSColumns     = "ABCDEFGHJKLMNPQRSTUVWXYZ"
+	MGRSRows        = "ABCDEFGHJKLMNPQRSTUV"
+	MaxMGRSDigits   = 5
+	mgrsEvenZoneRow = 5
+)
+
+var (
+	eccSquared       = Flattening * (2 - Flattening)
+	eccPrimeSquared  = eccSquared / (1 - eccSquared)
+	meridianArcCoeff = 1 - eccSquared/4 - 3*eccSquared*eccSquared/64 - 5*eccSquared*eccSquared*eccSquared/256
+)
+
+// Geocentric returns the distance from the Earth's axis and the height above the equatorial
+// plane, both in meters, of a point at a geodetic latitude in degrees and a height in meters
+// above the WGS84 ellipsoid
+func Geocentric(lat, height float64) (axis, z float64) {
+	sinLat, cosLat := math.Sincos(lat * constants.Rad)
+	n := SemiMajorAxis / math.Sqrt(1-eccSquared*sinLat*sinLat)
+	return (n + height) * cosLat, (n*(1-eccSquared) + height) * sinLat
+}
+
+// UTM represents a position in the Universal Transverse Mercator grid
+type UTM struct {
+	Zone     int
+	Band     byte
+	Easting  float64
+	Northing float64
+}
+
+// IsNorthern reports whether the coordinate lies in the northern hemisphere
+func (u UTM) IsNorthern() bool {
+	return u.Band >= 'N'
+}
+
+// String returns the coordinate as "zone band easting northing"
+func (u UTM) String() string {
+	return fmt.Sprintf("%d%c %.0f %.0f", u.Zone, u.Band, u.Easting, u.Northing)
+}
+
+// LatLon converts the UTM coordinate back to geodetic latitude and longitude in degrees
+func (u UTM) LatLon() (lat, lon float64, err error) {
+	return UTMToLatLon(u)
+}
+
+// UTMZone returns the UTM zone number for a position, including the Norway and Svalbard exceptions
+func UTMZone(lat, lon float64) int {
+	lon = wrapLongitude(lon)
+	zone := int(math.Floor((lon+180.0)/ZoneWidth)) + 1
+	if zone > 60 {
+		zone = 60
+	}
+
+	if lat >= 56.0 && lat < 64.0 && lon >= 3.0 && lon < 12.0 {
+		return 32
+	}
+
+	if lat >= 72.0 && lat < 84.0 {
+		switch {
+		case lon >= 0.0 && lon < 9.0:
+			return 31
+		case lon >= 9.0 && lon < 21.0:
+			return 33
+		case lon >= 21.0 && lon < 33.0:
+			return 35
+		case lon >= 33.0 && lon < 42.0:
+			return 37
+		}
+	}
+
+	return zone
+}
+
+// LatitudeBand returns the UTM/MGRS latitude band letter for a latitude
+func LatitudeBand(lat float64) (byte, error) {
+	if lat < MinUTMLatitude || lat > MaxUTMLatitude {
+		return 0, fmt.Errorf("latitude %.6f outside UTM limits [%.0f, %.0f]", lat, MinUTMLatitude, MaxUTMLatitude)
+	}
+	index := int(math.Floor((lat - MinUTMLatitude) / BandHeight))
+	if index >= len(LatitudeBands) {
+		index = len(LatitudeBands) - 1
+	}
+	return LatitudeBands[index], nil
+}
+
+// CentralMeridian returns the central meridian of a UTM zone in degrees
+func CentralMeridian(zone int) float64 {
+	return float64(zone-1)*ZoneWidth - 180.0 + ZoneWidth/2
+}
+
+// LatLonToUTM converts geodetic latitude and longitude in degrees to UTM
+func LatLonToUTM(lat, lon float64) (UTM, error) {
+	band, err := LatitudeBand(lat)
+	if err != nil {
+		return UTM{}, err
+	}
+	zone := UTMZone(lat, lon)
+	easting, northing := project(lat, lon, CentralMeridian(zone))
+	if lat < 0 {
+		northing += FalseNorthing
+	}
+	return UTM{Zone: zone, Band: band, Easting: easting, Northing: northing}, nil
+}
+
+// project applies the transverse Mercator projection about the given central meridian
+func project(lat, lon, lon0 float64) (easting, northing float64) {
+	phi := lat * constants.Rad
+	sinPhi := math.Sin(phi)
+	cosPhi := math.Cos(phi)
+	tanPhi := math.Tan(phi)
+
+	n := SemiMajorAxis / math.Sqrt(1-eccSquared*sinPhi*sinPhi)
+	t := tanPhi * tanPhi
+	c := eccPrimeSquared * cosPhi * cosPhi
+	a := cosPhi * wrapLongitude(lon-lon0) * constants.Rad
+	m := meridianArc(phi)
+
+	easting = ScaleFactor*n*(a+(1-t+c)*a*a*a/6+
+		(5-18*t+t*t+72*c-58*eccPrimeSquared)*a*a*a*a*a/120) + FalseEasting
+	northing = ScaleFactor * (m + n*tanPhi*(a*a/2+(5-t+9*c+4*c*c)*a*a*a*a/24+
+		(61-58*t+t*t+600*c-330*eccPrimeSquared)*a*a*a*a*a*a/720))
+	return easting, northing
+}
+
+// meridianArc returns the distance along the meridian from the equator to latitude phi (radians)
+func meridianArc(phi float64) float64 {
+	e2 := eccSquared
+	e4 := e2 * e2
+	e6 := e4 * e2
+	return SemiMajorAxis * (meridianArcCoeff*phi -
+		(3*e2/8+3*e4/32+45*e6/1024)*math.Sin(2*phi) +
+		(15*e4/256+45*e6/1024)*math.Sin(4*phi) -
+		(35*e6/3072)*math.Sin(6*phi))
+}
+
+// UTMToLatLon converts a UTM coordinate to geodetic latitude and longitude in degrees
+func UTMToLatLon(u UTM) (lat, lon float64, err error) {
+	if u.Zone < 1 || u.Zone > 60 {
+		return 0, 0, fmt.Errorf("invalid UTM zone %d: must be between 1 and 60", u.Zone)
+	}
+	if !strings.ContainsRune(LatitudeBands, rune(u.Band)) {
+		return 0, 0, fmt.Errorf("invalid UTM latitude band '%c'", u.Band)
+	}
+
+	x := u.Easting - FalseEasting
+	y := u.Northing
+	if !u.IsNorthern() {
+		y -= FalseNorthing
+	}
+
+	e1 := (1 - math.Sqrt(1-eccSquared)) / (1 + math.Sqrt(1-eccSquared))
+	mu := y / ScaleFactor / (SemiMajorAxis * meridianArcCoeff)
+	phi1 := mu + (3*e1/2-27*e1*e1*e1/32)*math.Sin(2*mu) +
+		(21*e1*e1/16-55*e1*e1*e1*e1/32)*math.Sin(4*mu) +
+		(151*e1*e1*e1/96)*math.Sin(6*mu) +
+		(1097*e1*e1*e1*e1/512)*math.Sin(8*mu)
+
+	sinPhi1 := math.Sin(phi1)
+	cosPhi1 := math.Cos(phi1)
+	tanPhi1 := math.Tan(phi1)
+	n1 := SemiMajorAxis / math.Sqrt(1-eccSquared*sinPhi1*sinPhi1)
+	t1 := tanPhi1 * tanPhi1
+	c1 := eccPrimeSquared * cosPhi1 * cosPhi1
+	r1 := SemiMajorAxis * (1 - eccSquared) / math.Pow(1-eccSquared*sinPhi1*sinPhi1, 1.5)
+	d := x / (n1 * ScaleFactor)
+
+	phi := phi1 - (n1*tanPhi1/r1)*(d*d/2-
+		(5+3*t1+10*c1-4*c1*c1-9*eccPrimeSquared)*d*d*d*d/24+
+		(61+90*t1+298*c1+45*t1*t1-252*eccPrimeSquared-3*c1*c1)*d*d*d*d*d*d/720)
+	lambda := (d - (1+2*t1+c1)*d*d*d/6 +
+		(5-2*c1+28*t1-3*c1*c1+8*eccPrimeSquared+24*t1*t1)*d*d*d*d*d/120) / cosPhi1
+
+	lat = phi * constants.Deg
+	lon = wrapLongitude(CentralMeridian(u.Zone) + lambda*constants.Deg)
+	return lat, lon, nil
+}
+
+// wrapLongitude wraps a longitude into the range [-180, 180)
+func wrapLongitude(lon float64) float64 {
+	return lon - 360.0*math.Floor((lon+180.0)/360.0)
+}
+
+// LatLonToMGRS converts geodetic latitude and longitude to an MGRS reference
+// with the given number of digits per axis (1 = 10 km ... 5 = 1 m)
+func LatLonToMGRS(lat, lon float64, digits int) (string, error) {
+	u, err := LatLonToUTM(lat, lon)
+	if err != nil {
+		return "", err
+	}
+	return UTMToMGRS(u, digits)
+}
+
+// UTMToMGRS converts a UTM coordinate to an MGRS reference
+func UTMToMGRS(u UTM, digits int) (string, error) {
+	if digits < 0 || digits > MaxMGRSDigits {
+		return "", fmt.Errorf("invalid MGRS precision %d: must be between 0 and %d", digits, MaxMGRSDigits)
+	}
+
+	set := (u.Zone - 1) % 3
+	columnIndex := set*8 + int(math.Floor(u.Easting/SquareSize)) - 1
+	rowIndex := int(math.Floor(u.Northing/SquareSize)) % len(MGRSRows)
+	if u.Zone%2 == 0 {
+		rowIndex = (rowIndex + mgrsEvenZoneRow) % len(MGRSRows)
+	}
+	if columnIndex < 0 || columnIndex >= len(MGRSColumns) {
+		return "", fmt.Errorf("easting %.0f outside the MGRS grid for zone %d", u.Easting, u.Zone)
+	}
+
+	square := fmt.Sprintf("%02d%c%c%c", u.Zone, u.Band, MGRSColumns[columnIndex], MGRSRows[rowIndex])
+	if digits == 0 {
+		return square, nil
+	}
+
+	divisor := math.Pow(10, float64(MaxMGRSDigits-digits))
+	easting := int(math.Floor(math.Mod(u.Easting, SquareSize) / divisor))
+	northing := int(math.Floor(math.Mod(u.Northing, SquareSize) / divisor))
+	return fmt.Sprintf("%s%0*d%0*d", square, digits, easting, digits, northing), nil
+}
+
+// ParseMGRS parses an MGRS reference such as "31U DQ 48251 11932" into a UTM coordinate.
+// The returned position is the south-west corner of the referenced grid cell.
+func ParseMGRS(input string) (UTM, error) {
+	s := strings.ToUpper(strings.Join(strings.Fields(input), ""))
+
+	i := 0
+	for i < len(s) && i < 2 && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	if i == 0 {
+		return UTM{}, fmt.Errorf("invalid MGRS reference '%s': missing zone number", input)
+	}
+	zone, _ := strconv.Atoi(s[:i])
+	if zone < 1 || zone > 60 {
+		return UTM{}, fmt.Errorf("invalid MGRS reference '%s': zone %d out of range", input, zone)
+	}
+
+	rest := s[i:]
+	if len(rest) < 3 {
+		return UTM{}, fmt.Errorf("invalid MGRS reference '%s': missing band or square letters", input)
+	}
+	band := rest[0]
+	bandIndex := strings.IndexByte(LatitudeBands, band)
+	columnIndex := strings.IndexByte(MGRSColumns, rest[1])
+	rowIndex := strings.IndexByte(MGRSRows, rest[2])
+	if bandIndex < 0 || columnIndex < 0 || rowIndex < 0 {
+		return UTM{}, fmt.Errorf("invalid MGRS reference '%s': bad band or square letters", input)
+	}
+
+	numeric := rest[3:]
+	if len(numeric)%2 != 0 || len(numeric) > 2*MaxMGRSDigits {
+		return UTM{}, fmt.Errorf("invalid MGRS reference '%s': easting and northing must have equal length", input)
+	}
+	digits := len(numeric) / 2
+	scale := math.Pow(10, float64(MaxMGRSDigits-digits))
+	e, n := 0.0, 0.0
+	if digits > 0 {
+		ei, err := strconv.Atoi(numeric[:digits])
+		if err != nil {
+			return UTM{}, fmt.Errorf("invalid MGRS easting in '%s': %v", input, err)
+		}
+		ni, err := strconv.Atoi(numeric[digits:])
+		if err != nil {
+			return UTM{}, fmt.Errorf("invalid MGRS northing in '%s': %v", input, err)
+		}
+		e, n = float64(ei)*scale, float64(ni)*scale
+	}
+
+	set := (zone - 1) % 3
+	e100k := columnIndex - set*8 + 1
+	if e100k < 1 || e100k > 8 {
+		return UTM{}, fmt.Errorf("invalid MGRS reference '%s': column letter not valid for zone %d", input, zone)
+	}
+	if zone%2 == 0 {
+		rowIndex = (rowIndex - mgrsEvenZoneRow + len(MGRSRows)) % len(MGRSRows)
+	}
+
+	bandLatitude := MinUTMLatitude + float64(bandIndex)*BandHeight
+	_, bandNorthing := project(bandLatitude, CentralMeridian(zone), CentralMeridian(zone))
+	if bandLatitude < 0 {
+		bandNorthing += FalseNorthing
+	}
+	bandNorthing = math.Floor(bandNorthing/SquareSize) * SquareSize
+
+	northing := float64(rowIndex)*SquareSize + n
+	for northing < bandNorthing {
+		northing += NorthingCycle
+	}
+
+	return UTM{
+		Zone:     zone,
+		Band:     band,
+		Easting:  float64(e100k)*SquareSize + e,
+		Northing: northing,
+	}, nil
+}
+
+// MGRSToLatLon converts an MGRS reference to geodetic latitude and longitude in degrees
+func MGRSToLatLon(input string) (lat, lon float64, err error) {
+	u, err := ParseMGRS(input)
+	if err != nil {
+		return 0, 0, err
+	}
+	return UTMToLatLon(u)
+}
@@ -0,0 +1,13 @@
+package geodesy_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestGeodesy(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Geodesy Suite")
+}
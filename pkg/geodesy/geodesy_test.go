@@ -0,0 +1,161 @@
+package geodesy
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Geodesy", func() {
+	Describe("Geocentric", func() {
+		It("should give the equatorial radius on the equator", func() {
+			axis, z := Geocentric(0, 100)
+			Expect(axis).To(BeNumerically("~", SemiMajorAxis+100, 1e-6))
+			Expect(z).To(BeNumerically("~", 0, 1e-6))
+		})
+
+		It("should give the polar radius at the pole", func() {
+			axis, z := Geocentric(90, 0)
+			Expect(axis).To(BeNumerically("~", 0, 1e-6))
+			Expect(z).To(BeNumerically("~", SemiMajorAxis*(1-Flattening), 1e-6))
+		})
+	})
+
+	Describe("UTMZone", func() {
+		DescribeTable("selects the correct zone",
+			func(lat, lon float64, expected int) {
+				Expect(UTMZone(lat, lon)).To(Equal(expected))
+			},
+			Entry("prime meridian", 51.5, 0.0, 31),
+			Entry("New York", 40.7, -74.0, 18),
+			Entry("date line", 0.0, 179.9, 60),
+			Entry("Norway exception", 60.0, 5.0, 32),
+			Entry("Svalbard exception", 78.0, 15.0, 33),
+		)
+	})
+
+	Describe("LatitudeBand", func() {
+		DescribeTable("selects the correct band",
+			func(lat float64, expected byte) {
+				band, err := LatitudeBand(lat)
+				Expect(err).To(BeNil())
+				Expect(band).To(Equal(expected))
+			},
+			Entry("southern limit", -80.0, byte('C')),
+			Entry("equator", 0.0, byte('N')),
+			Entry("Paris", 48.85, byte('U')),
+			Entry("band X extends to 84", 83.5, byte('X')),
+		)
+
+		It("should reject latitudes outside the grid", func() {
+			_, err := LatitudeBand(85.0)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("outside UTM limits"))
+		})
+	})
+
+	Describe("LatLonToUTM", func() {
+		It("should place a point on the central meridian at the false easting", func() {
+			u, err := LatLonToUTM(0.0, 3.0)
+			Expect(err).To(BeNil())
+			Expect(u.Zone).To(Equal(31))
+			Expect(u.Easting).To(BeNumerically("~", FalseEasting, 1e-6))
+			Expect(u.Northing).To(BeNumerically("~", 0.0, 1e-6))
+		})
+
+		It("should convert the Eiffel Tower", func() {
+			u, err := LatLonToUTM(48.8583, 2.2945)
+			Expect(err).To(BeNil())
+			Expect(u.String()).To(Equal("31U 448252 5411944"))
+		})
+
+		It("should apply the false northing in the southern hemisphere", func() {
+			u, err := LatLonToUTM(-33.8568, 151.2153)
+			Expect(err).To(BeNil())
+			Expect(u.Zone).To(Equal(56))
+			Expect(u.IsNorthern()).To(BeFalse())
+			Expect(u.Northing).To(BeNumerically("~", 6252289, 1))
+		})
+	})
+
+	Describe("UTMToLatLon", func() {
+		DescribeTable("round-trips through UTM",
+			func(lat, lon float64) {
+				u, err := LatLonToUTM(lat, lon)
+				Expect(err).To(BeNil())
+				rlat, rlon, err := u.LatLon()
+				Expect(err).To(BeNil())
+				Expect(rlat).To(BeNumerically("~", lat, 1e-7))
+				Expect(rlon).To(BeNumerically("~", lon, 1e-7))
+			},
+			Entry("Paris", 48.8583, 2.2945),
+			Entry("Sydney", -33.8568, 151.2153),
+			Entry("New York", 40.689247, -74.044502),
+			Entry("zone edge", 10.0, -71.99),
+			Entry("high latitude", 78.0, 15.0),
+		)
+
+		It("should reject invalid zones", func() {
+			_, _, err := UTMToLatLon(UTM{Zone: 61, Band: 'N'})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("MGRS", func() {
+		It("should format the Eiffel Tower", func() {
+			m, err := LatLonToMGRS(48.8583, 2.2945, 5)
+			Expect(err).To(BeNil())
+			Expect(m).To(Equal("31UDQ4825111943"))
+		})
+
+		DescribeTable("formats with reduced precision",
+			func(digits int, expected string) {
+				m, err := LatLonToMGRS(48.8583, 2.2945, digits)
+				Expect(err).To(BeNil())
+				Expect(m).To(Equal(expected))
+			},
+			Entry("100 km", 0, "31UDQ"),
+			Entry("10 km", 1, "31UDQ41"),
+			Entry("1 km", 3, "31UDQ482119"),
+		)
+
+		It("should reject invalid precision", func() {
+			_, err := LatLonToMGRS(48.8583, 2.2945, 6)
+			Expect(err).To(HaveOccurred())
+		})
+
+		DescribeTable("round-trips through MGRS",
+			func(lat, lon float64) {
+				m, err := LatLonToMGRS(lat, lon, 5)
+				Expect(err).To(BeNil())
+				rlat, rlon, err := MGRSToLatLon(m)
+				Expect(err).To(BeNil())
+				Expect(rlat).To(BeNumerically("~", lat, 2e-5))
+				Expect(rlon).To(BeNumerically("~", lon, 2e-5))
+			},
+			Entry("Paris", 48.8583, 2.2945),
+			Entry("Sydney", -33.8568, 151.2153),
+			Entry("New York", 40.689247, -74.044502),
+			Entry("Norway", 60.0, 5.0),
+			Entry("Cape Horn", -55.98, -67.27),
+		)
+
+		It("should accept spaces and lower case", func() {
+			u, err := ParseMGRS("31u dq 48251 11943")
+			Expect(err).To(BeNil())
+			Expect(u.Zone).To(Equal(31))
+			Expect(u.Easting).To(Equal(448251.0))
+			Expect(u.Northing).To(Equal(5411943.0))
+		})
+
+		It("should reject unbalanced numeric parts", func() {
+			_, err := ParseMGRS("31UDQ482511194")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("equal length"))
+		})
+
+		It("should reject invalid square letters", func() {
+			_, err := ParseMGRS("31UIQ4825111943")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,105 @@
+// Package geomag converts between geographic and geomagnetic coordinates using the centred
+// dipole of the International Geomagnetic Reference Field (IGRF), and evaluates the full World
+// Magnetic Model for compass declination.
+package geomag
+
+import (
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"math"
+	"sort"
+)
+
+// LatestEpoch is the epoch of the last tabulated IGRF model; the secular variation carries the
+// coefficients forward from it
+const LatestEpoch = 2025.0
+
+// Dipole holds the first-degree Gauss coefficients of the IGRF in nT
+type Dipole struct {
+	Epoch         float64
+	G10, G11, H11 float64
+}
+
+// dipoles are the IGRF degree-1 coefficients at the model epochs used here
+var dipoles = []Dipole{
+	{1900, -31543, -2298, 5922},
+	{1950, -30554, -2250, 5815},
+	{1965, -30334, -2119, 5776},
+	{1970, -30220, -2068, 5737},
+	{1975, -30100, -2013, 5675},
+	{1980, -29992, -1956, 5604},
+	{1985, -29873, -1905, 5500},
+	{1990, -29775, -1848, 5406},
+	{1995, -29692, -1784, 5306},
+	{2000, -29619.4, -1728.2, 5186.1},
+	{2005, -29554.63, -1669.05, 5077.99},
+	{2010, -29496.57, -1586.42, 4944.26},
+	{2015, -29441.46, -1501.77, 4795.99},
+	{2020, -29404.8, -1450.9, 4652.5},
+	{2025, -29350.0, -1410.3, 4545.5},
+}
+
+// secularVariation is the predicted annual change of the coefficients after LatestEpoch
+var secularVariation = Dipole{G10: 12.6, G11: 10.0, H11: -21.5}
+
+// DipoleAt returns the dipole coefficients for a decimal year, interpolated linearly between
+// model epochs, held at the 1900 values before then and extrapolated with the secular
+// variation after LatestEpoch
+func DipoleAt(year float64) Dipole {
+	i := sort.Search(len(dipoles), func(i int) bool { return dipoles[i].Epoch >= year })
+	switch {
+	case i == 0:
+		d := dipoles[0]
+		d.Epoch = year
+		return d
+	case i == len(dipoles):
+		last := dipoles[i-1]
+		dt := year - last.Epoch
+		return Dipole{year, last.G10 + dt*secularVariation.G10, last.G11 + dt*secularVariation.G11,
+			last.H11 + dt*secularVariation.H11}
+	}
+	lo, hi := dipoles[i-1], dipoles[i]
+	f := (year - lo.Epoch) / (hi.Epoch - lo.Epoch)
+	return Dipole{year, lo.G10 + f*(hi.G10-lo.G10), lo.G11 + f*(hi.G11-lo.G11), lo.H11 + f*(hi.H11-lo.H11)}
+}
+
+// Moment returns the dipole field strength at the equator on the reference sphere in nT
+func (d Dipole) Moment() float64 {
+	return math.Sqrt(d.G10*d.G10 + d.G11*d.G11 + d.H11*d.H11)
+}
+
+// Pole returns the geographic latitude and longitude in degrees of the boreal geomagnetic pole,
+// where the dipole axis meets the surface in the northern hemisphere
+func (d Dipole) Pole() (lat, lon float64) {
+	lat = 90 - math.Acos(-d.G10/d.Moment())*constants.Deg
+	lon = math.Atan2(-d.H11, -d.G11) * constants.Deg
+	return lat, lon
+}
+
+// GeographicToGeomagnetic returns the geomagnetic latitude and longitude in degrees of a
+// geographic position for a decimal year. Geomagnetic longitude is counted from the meridian
+// through the geographic south pole, so the geographic north pole lies at 180°.
+func GeographicToGeomagnetic(lat, lon, year float64) (mlat, mlon float64) {
+	poleLat, poleLon := DipoleAt(year).Pole()
+	sinLat, cosLat := math.Sincos(lat * constants.Rad)
+	sinDelta, cosDelta := math.Sincos((lon - poleLon) * constants.Rad)
+	sinPole, cosPole := math.Sincos(poleLat * constants.Rad)
+
+	x, y, z := cosLat*cosDelta, cosLat*sinDelta, sinLat
+	mx := x*sinPole - z*cosPole
+	mz := x*cosPole + z*sinPole
+	return math.Asin(math.Max(-1, math.Min(1, mz))) * constants.Deg, math.Atan2(y, mx) * constants.Deg
+}
+
+// GeomagneticToGeographic inverts GeographicToGeomagnetic
+func GeomagneticToGeographic(mlat, mlon, year float64) (lat, lon float64) {
+	poleLat, poleLon := DipoleAt(year).Pole()
+	sinLat, cosLat := math.Sincos(mlat * constants.Rad)
+	sinLon, cosLon := math.Sincos(mlon * constants.Rad)
+	sinPole, cosPole := math.Sincos(poleLat * constants.Rad)
+
+	mx, y, mz := cosLat*cosLon, cosLat*sinLon, sinLat
+	x := mx*sinPole + mz*cosPole
+	z := -mx*cosPole + mz*sinPole
+	lon = math.Atan2(y, x)*constants.Deg + poleLon
+	return math.Asin(math.Max(-1, math.Min(1, z))) * constants.Deg, math.Remainder(lon, 360)
+}
@@ -0,0 +1,13 @@
+package geomag_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestGeomag(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Geomag Suite")
+}
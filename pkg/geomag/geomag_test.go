@@ -0,0 +1,55 @@
+package geomag
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Dipole", func() {
+	It("should place the IGRF-13 2020 dipole pole", func() {
+		lat, lon := DipoleAt(2020).Pole()
+		Expect(lat).To(BeNumerically("~", 80.59, 0.01))
+		Expect(lon).To(BeNumerically("~", -72.68, 0.01))
+		Expect(DipoleAt(2020).Moment()).To(BeNumerically("~", 29806, 1))
+	})
+
+	It("should interpolate and extrapolate the coefficients", func() {
+		Expect(DipoleAt(2017.5).G10).To(BeNumerically("~", (-29441.46-29404.8)/2, 1e-9))
+		Expect(DipoleAt(2027).H11).To(BeNumerically("~", 4545.5-43, 1e-9))
+		Expect(DipoleAt(1800).G10).To(Equal(-31543.0))
+		Expect(DipoleAt(1800).Epoch).To(Equal(1800.0))
+	})
+
+	It("should drift the pole north over the last century", func() {
+		old, _ := DipoleAt(1900).Pole()
+		now, _ := DipoleAt(2025).Pole()
+		Expect(now).To(BeNumerically(">", old))
+	})
+})
+
+var _ = Describe("Geomagnetic coordinates", func() {
+	It("should put the pole at 90° and the north pole at 180° longitude", func() {
+		poleLat, poleLon := DipoleAt(2020).Pole()
+		mlat, _ := GeographicToGeomagnetic(poleLat, poleLon, 2020)
+		Expect(mlat).To(BeNumerically("~", 90, 1e-5))
+		mlat, mlon := GeographicToGeomagnetic(90, 0, 2020)
+		Expect(mlat).To(BeNumerically("~", poleLat, 1e-9))
+		Expect(mlon).To(BeNumerically("~", 180, 1e-9))
+	})
+
+	It("should convert a mid-latitude station", func() {
+		// Boulder, Colorado: dipole latitude about 47.7° N
+		mlat, mlon := GeographicToGeomagnetic(40.0, -105.3, 2020)
+		Expect(mlat).To(BeNumerically("~", 47.7, 0.3))
+		Expect(mlon).To(BeNumerically("~", -38.5, 1.5))
+	})
+
+	It("should invert the conversion", func() {
+		for _, p := range [][2]float64{{40, -105.3}, {-33.9, 151.2}, {0, 179.9}, {-89, 10}} {
+			mlat, mlon := GeographicToGeomagnetic(p[0], p[1], 2005)
+			lat, lon := GeomagneticToGeographic(mlat, mlon, 2005)
+			Expect(lat).To(BeNumerically("~", p[0], 1e-9))
+			Expect(lon).To(BeNumerically("~", p[1], 1e-9))
+		}
+	})
+})
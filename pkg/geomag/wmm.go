@@ -0,0 +1,245 @@
+package geomag
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/geodesy"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ReferenceRadius is the radius in km of the sphere to which the Gauss coefficients refer
+const ReferenceRadius = 6371.2
+
+// maxDegree is the highest degree of the World Magnetic Model
+const maxDegree = 12
+
+//go:embed wmm2025.cof
+var wmm2025COF string
+
+var (
+	wmmOnce  sync.Once
+	wmmModel *Model
+)
+
+// Model is a spherical harmonic main-field model such as the World Magnetic Model: Gauss
+// coefficients in nT at an epoch and their annual rates of change
+type Model struct {
+	Name   string
+	Epoch  float64 // decimal year
+	degree int
+	g, h   [maxDegree + 1][maxDegree + 1]float64
+	gDot   [maxDegree + 1][maxDegree + 1]float64
+	hDot   [maxDegree + 1][maxDegree + 1]float64
+}
+
+// WMM returns the embedded World Magnetic Model 2025, valid from 2025.0 to 2030.0. Later dates
+// are extrapolated with its secular variation, which adds roughly a tenth of a degree of
+// declination error per year in mid-latitudes; load the successor's coefficient file with
+// ParseModel once it is released.
+func WMM() *Model {
+	wmmOnce.Do(func() {
+		model, err := ParseModel(strings.NewReader(wmm2025COF))
+		if err != nil {
+			panic(fmt.Sprintf("geomag: embedded WMM coefficients are invalid: %v", err))
+		}
+		wmmModel = model
+	})
+	return wmmModel
+}
+
+// ParseModel reads coefficients in the WMM.COF format distributed by NOAA: a header line with
+// the epoch and model name, then lines of n, m, g, h, ġ and ḣ, ended by a line of nines
+func ParseModel(r io.Reader) (*Model, error) {
+	scanner := bufio.NewScanner(r)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("empty coefficient file")
+	}
+	header := strings.Fields(scanner.Text())
+	if len(header) < 2 {
+		return nil, fmt.Errorf("invalid header '%s'", scanner.Text())
+	}
+	epoch, err := strconv.ParseFloat(header[0], 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid epoch '%s': %v", header[0], err)
+	}
+
+	model := &Model{Name: header[1], Epoch: epoch}
+	for line := 2; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if strings.HasPrefix(fields[0], "9999") {
+			break
+		}
+		if len(fields) != 6 {
+			return nil, fmt.Errorf("line %d: expected 6 fields, got %d", line, len(fields))
+		}
+		n, errN := strconv.Atoi(fields[0])
+		m, errM := strconv.Atoi(fields[1])
+		if errN != nil || errM != nil || n < 1 || n > maxDegree || m < 0 || m > n {
+			return nil, fmt.Errorf("line %d: invalid degree and order '%s %s'", line, fields[0], fields[1])
+		}
+		var values [4]float64
+		for i, field := range fields[2:] {
+			if values[i], err = strconv.ParseFloat(field, 64); err != nil {
+				return nil, fmt.Errorf("line %d: invalid coefficient '%s': %v", line, field, err)
+			}
+		}
+		model.g[n][m], model.h[n][m], model.gDot[n][m], model.hDot[n][m] = values[0], values[1], values[2], values[3]
+		model.degree = max(model.degree, n)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if model.degree == 0 {
+		return nil, fmt.Errorf("no coefficients in model %s", model.Name)
+	}
+	return model, nil
+}
+
+// Field is the main magnetic field in nT in the local north-east-down frame of the WGS84
+// ellipsoid
+type Field struct {
+	North, East, Down float64
+}
+
+// Horizontal returns the horizontal intensity H in nT
+func (f Field) Horizontal() float64 {
+	return math.Hypot(f.North, f.East)
+}
+
+// Total returns the total intensity F in nT
+func (f Field) Total() float64 {
+	return math.Sqrt(f.North*f.North + f.East*f.East + f.Down*f.Down)
+}
+
+// Declination returns the angle in degrees from true north to magnetic north, positive east
+func (f Field) Declination() float64 {
+	return math.Atan2(f.East, f.North) * constants.Deg
+}
+
+// Inclination returns the dip of the field below the horizontal in degrees, positive down
+func (f Field) Inclination() float64 {
+	return math.Atan2(f.Down, f.Horizontal()) * constants.Deg
+}
+
+// Field returns the main field at a geodetic latitude and longitude in degrees, a height in
+// meters above the WGS84 ellipsoid and a decimal year. Declination is undefined at the
+// geographic poles, where the east component is taken as zero.
+func (m *Model) Field(lat, lon, height, year float64) Field {
+	// geodetic to geocentric spherical coordinates, in km
+	p, z := geodesy.Geocentric(lat, height)
+	r := math.Hypot(p, z) / 1000
+	latC := math.Atan2(z, p)
+
+	// Schmidt semi-normalized associated Legendre functions of sin(latC) and their derivatives
+	// with respect to colatitude
+	x, s := math.Sincos(latC)
+	var pnm, dpnm [maxDegree + 1][maxDegree + 1]float64
+	pnm[0][0] = 1
+	for n := 1; n <= m.degree; n++ {
+		for k := 0; k <= n; k++ {
+			switch {
+			case k == n:
+				pnm[n][n] = s * pnm[n-1][n-1]
+				dpnm[n][n] = s*dpnm[n-1][n-1] + x*pnm[n-1][n-1]
+			case n == 1:
+				pnm[1][0] = x
+				dpnm[1][0] = -s
+			default:
+				kk := float64((n-1)*(n-1)-k*k) / float64((2*n-1)*(2*n-3))
+				pnm[n][k] = x*pnm[n-1][k] - kk*pnm[n-2][k]
+				dpnm[n][k] = x*dpnm[n-1][k] - s*pnm[n-1][k] - kk*dpnm[n-2][k]
+			}
+		}
+	}
+	schmidt := 1.0
+	for n := 1; n <= m.degree; n++ {
+		schmidt *= float64(2*n-1) / float64(n)
+		factor := schmidt
+		for k := 0; k <= n; k++ {
+			if k > 0 {
+				double := 1.0
+				if k == 1 {
+					double = 2
+				}
+				factor *= math.Sqrt(float64(n-k+1) * double / float64(n+k))
+			}
+			pnm[n][k] *= factor
+			dpnm[n][k] *= factor
+		}
+	}
+
+	dt := year - m.Epoch
+	var north, east, down float64
+	ratio := ReferenceRadius / r
+	power := ratio * ratio
+	for n := 1; n <= m.degree; n++ {
+		power *= ratio
+		for k := 0; k <= n; k++ {
+			g, hh := m.g[n][k]+dt*m.gDot[n][k], m.h[n][k]+dt*m.hDot[n][k]
+			sinL, cosL := math.Sincos(float64(k) * lon * constants.Rad)
+			term := g*cosL + hh*sinL
+			north += power * term * dpnm[n][k]
+			east += power * float64(k) * (g*sinL - hh*cosL) * pnm[n][k]
+			down -= power * float64(n+1) * term * pnm[n][k]
+		}
+	}
+	if s > 1e-12 {
+		east /= s
+	} else {
+		east = 0
+	}
+
+	// rotate from the geocentric to the geodetic vertical
+	sinPsi, cosPsi := math.Sincos(latC - lat*constants.Rad)
+	return Field{
+		North: north*cosPsi - down*sinPsi,
+		East:  east,
+		Down:  north*sinPsi + down*cosPsi,
+	}
+}
+
+// FieldAt returns the main field of the embedded World Magnetic Model at a geodetic position
+// with height in meters and a time
+func FieldAt(lat, lon, height float64, t time.Time) Field {
+	return WMM().Field(lat, lon, height, DecimalYear(t))
+}
+
+// Declination returns the magnetic declination in degrees, positive east, from the embedded
+// World Magnetic Model at a geodetic position with height in meters and a time
+func Declination(lat, lon, height float64, t time.Time) float64 {
+	return FieldAt(lat, lon, height, t).Declination()
+}
+
+// TrueToMagnetic converts a bearing from true north into a compass bearing given the
+// declination, both in degrees, returning [0, 360)
+func TrueToMagnetic(bearing, declination float64) float64 {
+	return angles.NormalizeDegrees(bearing - declination)
+}
+
+// MagneticToTrue converts a compass bearing into a bearing from true north given the
+// declination, both in degrees, returning [0, 360)
+func MagneticToTrue(bearing, declination float64) float64 {
+	return angles.NormalizeDegrees(bearing + declination)
+}
+
+// DecimalYear returns the year of t with the elapsed fraction of that year
+func DecimalYear(t time.Time) float64 {
+	t = t.UTC()
+	start := time.Date(t.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+	return float64(t.Year()) + t.Sub(start).Seconds()/end.Sub(start).Seconds()
+}
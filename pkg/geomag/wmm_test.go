@@ -0,0 +1,76 @@
+package geomag
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("World Magnetic Model", func() {
+	DescribeTable("should give the field at the WMM2025 test points at sea level",
+		func(year, lat, lon, declination, inclination float64) {
+			field := WMM().Field(lat, lon, 0, year)
+			Expect(field.Declination()).To(BeNumerically("~", declination, 0.01))
+			Expect(field.Inclination()).To(BeNumerically("~", inclination, 0.01))
+		},
+		Entry("high north", 2025.0, 80.0, 0.0, 1.28, 83.21),
+		Entry("equator", 2025.0, 0.0, 120.0, -0.16, -14.93),
+		Entry("high south", 2025.0, -80.0, 240.0, 68.78, -72.00),
+		Entry("high north later", 2027.5, 80.0, 0.0, 2.59, 83.24),
+	)
+
+	It("should give the field components", func() {
+		field := WMM().Field(80, 0, 0, 2025)
+		Expect(field.North).To(BeNumerically("~", 6521.6, 1))
+		Expect(field.East).To(BeNumerically("~", 145.9, 1))
+		Expect(field.Down).To(BeNumerically("~", 54791.5, 1))
+		Expect(field.Horizontal()).To(BeNumerically("<", field.Total()))
+	})
+
+	It("should be the current model", func() {
+		Expect(WMM().Name).To(Equal("WMM-2025"))
+		Expect(WMM().Epoch).To(Equal(LatestEpoch))
+	})
+
+	It("should agree with the IGRF dipole at the common epoch", func() {
+		dipole := DipoleAt(LatestEpoch)
+		Expect(WMM().g[1][0]).To(BeNumerically("~", dipole.G10, 5))
+		Expect(WMM().g[1][1]).To(BeNumerically("~", dipole.G11, 5))
+		Expect(WMM().h[1][1]).To(BeNumerically("~", dipole.H11, 5))
+	})
+
+	It("should give an easterly declination in Colorado", func() {
+		t := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+		Expect(Declination(40.0, -105.27, 1655, t)).To(BeNumerically("~", 7.7, 0.2))
+	})
+
+	It("should convert between true and magnetic bearings", func() {
+		Expect(TrueToMagnetic(5, 8)).To(Equal(357.0))
+		Expect(MagneticToTrue(357, 8)).To(Equal(5.0))
+		Expect(MagneticToTrue(TrueToMagnetic(123.4, -12.5), -12.5)).To(BeNumerically("~", 123.4, 1e-12))
+	})
+
+	It("should express times as decimal years", func() {
+		Expect(DecimalYear(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))).To(Equal(2024.0))
+		Expect(DecimalYear(time.Date(2024, 7, 2, 0, 0, 0, 0, time.UTC))).To(BeNumerically("~", 2024+183.0/366, 1e-12))
+	})
+
+	It("should parse coefficient files", func() {
+		model, err := ParseModel(strings.NewReader("2020.0 TEST 01/01/2020\n  1  0 -30000.0 0.0 10.0 0.0\n999999\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(model.Name).To(Equal("TEST"))
+		Expect(model.Epoch).To(Equal(2020.0))
+		field := model.Field(0, 0, 0, 2021)
+		Expect(field.North).To(BeNumerically(">", 0))
+		Expect(field.Declination()).To(BeNumerically("~", 0, 1e-12))
+	})
+
+	It("should reject malformed coefficient files", func() {
+		for _, text := range []string{"", "2020.0\n", "x TEST\n1 0 1 0 0 0\n", "2020.0 TEST\n1 0 1 0 0\n", "2020.0 TEST\n13 0 1 0 0 0\n", "2020.0 TEST\n999999\n"} {
+			_, err := ParseModel(strings.NewReader(text))
+			Expect(err).To(HaveOccurred(), text)
+		}
+	})
+})
@@ -0,0 +1,82 @@
+package bigmath
+
+import (
+	"math/big"
+)
+
+// Pi returns π to prec bits using Machin's formula π = 16·atan(1/5) − 4·atan(1/239)
+func Pi(prec uint) *big.Float {
+	work := prec + 16
+	a := atanInverse(5, work)
+	b := atanInverse(239, work)
+	pi := new(big.Float).SetPrec(work).Mul(a, big.NewFloat(16))
+	pi.Sub(pi, new(big.Float).SetPrec(work).Mul(b, big.NewFloat(4)))
+	return pi.SetPrec(prec)
+}
+
+// atanInverse returns atan(1/x) by its Taylor series
+func atanInverse(x int64, prec uint) *big.Float {
+	xf := new(big.Float).SetPrec(prec).SetInt64(x)
+	x2 := new(big.Float).SetPrec(prec).Mul(xf, xf)
+	power := new(big.Float).SetPrec(prec).Quo(big.NewFloat(1), xf)
+	sum := new(big.Float).SetPrec(prec).Set(power)
+	limit := epsilon(prec)
+	for n := int64(1); ; n++ {
+		power.Quo(power, x2)
+		term := new(big.Float).SetPrec(prec).Quo(power, big.NewFloat(float64(2*n+1)))
+		if term.Cmp(limit) < 0 {
+			return sum
+		}
+		if n%2 == 1 {
+			sum.Sub(sum, term)
+		} else {
+			sum.Add(sum, term)
+		}
+	}
+}
+
+// epsilon returns 2^-prec
+func epsilon(prec uint) *big.Float {
+	return new(big.Float).SetMantExp(big.NewFloat(1), -int(prec))
+}
+
+// Remainder returns x reduced into [-y/2, y/2] by subtracting the nearest multiple of y
+func Remainder(x, y *big.Float) *big.Float {
+	prec := x.Prec()
+	quotient := new(big.Float).SetPrec(prec).Quo(x, y)
+	quotient.Add(quotient, big.NewFloat(0.5).SetPrec(prec))
+	n, _ := quotient.Int(nil)
+	if quotient.Sign() < 0 && !quotient.IsInt() {
+		n.Sub(n, big.NewInt(1))
+	}
+	multiple := new(big.Float).SetPrec(prec).SetInt(n)
+	multiple.Mul(multiple, y)
+	return new(big.Float).SetPrec(prec).Sub(x, multiple)
+}
+
+// Sincos returns the sine and cosine of x in radians, at the precision of x
+func Sincos(x *big.Float) (sin, cos *big.Float) {
+	prec := x.Prec()
+	work := prec + 32
+	twoPi := Pi(work)
+	twoPi.Mul(twoPi, big.NewFloat(2))
+	r := Remainder(new(big.Float).SetPrec(work).Set(x), twoPi)
+	r2 := new(big.Float).SetPrec(work).Mul(r, r)
+	limit := epsilon(work)
+
+	sin = new(big.Float).SetPrec(work).Set(r)
+	cos = new(big.Float).SetPrec(work).SetInt64(1)
+	sinTerm := new(big.Float).SetPrec(work).Set(r)
+	cosTerm := new(big.Float).SetPrec(work).SetInt64(1)
+	for n := int64(1); ; n++ {
+		sinTerm.Mul(sinTerm, r2)
+		sinTerm.Quo(sinTerm, big.NewFloat(float64(-(2*n)*(2*n+1))))
+		cosTerm.Mul(cosTerm, r2)
+		cosTerm.Quo(cosTerm, big.NewFloat(float64(-(2*n-1)*(2*n))))
+		sin.Add(sin, sinTerm)
+		cos.Add(cos, cosTerm)
+		if new(big.Float).Abs(sinTerm).Cmp(limit) < 0 && new(big.Float).Abs(cosTerm).Cmp(limit) < 0 {
+			return sin.SetPrec(prec), cos.SetPrec(prec)
+		}
+	}
+}
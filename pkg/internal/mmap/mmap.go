@@ -0,0 +1,55 @@
+package mmap
+
+import (
+	"fmt"
+	"os"
+)
+
+// File is a read-only view of a file's contents, memory-mapped where the platform
+// supports it and read into memory otherwise
+type File struct {
+	data  []byte
+	unmap func([]byte) error
+}
+
+// Open maps the named file into memory
+func Open(path string) (*File, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := info.Size()
+	if size == 0 {
+		return &File{}, nil
+	}
+	if int64(int(size)) != size {
+		return nil, fmt.Errorf("file %s too large to map: %d bytes", path, size)
+	}
+	return mapFile(f, int(size))
+}
+
+// Bytes returns the mapped contents; the slice is invalid after Close
+func (f *File) Bytes() []byte {
+	return f.data
+}
+
+// Len returns the size of the mapped file in bytes
+func (f *File) Len() int {
+	return len(f.data)
+}
+
+// Close releases the mapping
+func (f *File) Close() error {
+	data := f.data
+	f.data = nil
+	if data == nil || f.unmap == nil {
+		return nil
+	}
+	return f.unmap(data)
+}
@@ -0,0 +1,17 @@
+//go:build !unix
+
+package mmap
+
+import (
+	"io"
+	"os"
+)
+
+// mapFile reads size bytes of f into memory on platforms without mmap
+func mapFile(f *os.File, size int) (*File, error) {
+	data := make([]byte, size)
+	if _, err := io.ReadFull(f, data); err != nil {
+		return nil, err
+	}
+	return &File{data: data}, nil
+}
@@ -0,0 +1,17 @@
+//go:build unix
+
+package mmap
+
+import (
+	"os"
+	"syscall"
+)
+
+// mapFile maps size bytes of f read-only
+func mapFile(f *os.File, size int) (*File, error) {
+	data, err := syscall.Mmap(int(f.Fd()), 0, size, syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, err
+	}
+	return &File{data: data, unmap: syscall.Munmap}, nil
+}
@@ -0,0 +1,40 @@
+package parallel
+
+import (
+	"runtime"
+	"sync"
+)
+
+// Workers resolves a requested worker count: zero or one runs serially and a negative count
+// uses one worker per CPU
+func Workers(n int) int {
+	if n < 0 {
+		return runtime.NumCPU()
+	}
+	return max(n, 1)
+}
+
+// For calls f for every index in [0, n), dividing the indices into contiguous blocks run by up
+// to workers goroutines. f must be safe to call concurrently for different indices.
+func For(n, workers int, f func(i int)) {
+	workers = min(Workers(workers), n)
+	if workers <= 1 {
+		for i := 0; i < n; i++ {
+			f(i)
+		}
+		return
+	}
+
+	var wg sync.WaitGroup
+	block := (n + workers - 1) / workers
+	for start := 0; start < n; start += block {
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			for i := start; i < end; i++ {
+				f(i)
+			}
+		}(start, min(start+block, n))
+	}
+	wg.Wait()
+}
@@ -0,0 +1,81 @@
+// Package timescale holds the leap-second table behind astrotime's TT, so that packages
+// astrotime itself depends on, such as planets, can convert UTC to TT as well.
+package timescale
+
+import (
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"time"
+)
+
+// TTMinusTAI is the constant offset between Terrestrial Time and TAI in seconds
+const TTMinusTAI = 32.184
+
+// TableVersion is the date of the last entry of the leap-second table
+const TableVersion = "2017-01-01"
+
+// leapSecond records the value of TAI-UTC from a given UTC date
+type leapSecond struct {
+	from   time.Time
+	offset int
+}
+
+// leapSeconds lists every change of TAI-UTC since the leap second system began in 1972
+var leapSeconds = []leapSecond{
+	{time.Date(1972, 1, 1, 0, 0, 0, 0, time.UTC), 10},
+	{time.Date(1972, 7, 1, 0, 0, 0, 0, time.UTC), 11},
+	{time.Date(1973, 1, 1, 0, 0, 0, 0, time.UTC), 12},
+	{time.Date(1974, 1, 1, 0, 0, 0, 0, time.UTC), 13},
+	{time.Date(1975, 1, 1, 0, 0, 0, 0, time.UTC), 14},
+	{time.Date(1976, 1, 1, 0, 0, 0, 0, time.UTC), 15},
+	{time.Date(1977, 1, 1, 0, 0, 0, 0, time.UTC), 16},
+	{time.Date(1978, 1, 1, 0, 0, 0, 0, time.UTC), 17},
+	{time.Date(1979, 1, 1, 0, 0, 0, 0, time.UTC), 18},
+	{time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC), 19},
+	{time.Date(1981, 7, 1, 0, 0, 0, 0, time.UTC), 20},
+	{time.Date(1982, 7, 1, 0, 0, 0, 0, time.UTC), 21},
+	{time.Date(1983, 7, 1, 0, 0, 0, 0, time.UTC), 22},
+	{time.Date(1985, 7, 1, 0, 0, 0, 0, time.UTC), 23},
+	{time.Date(1988, 1, 1, 0, 0, 0, 0, time.UTC), 24},
+	{time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC), 25},
+	{time.Date(1991, 1, 1, 0, 0, 0, 0, time.UTC), 26},
+	{time.Date(1992, 7, 1, 0, 0, 0, 0, time.UTC), 27},
+	{time.Date(1993, 7, 1, 0, 0, 0, 0, time.UTC), 28},
+	{time.Date(1994, 7, 1, 0, 0, 0, 0, time.UTC), 29},
+	{time.Date(1996, 1, 1, 0, 0, 0, 0, time.UTC), 30},
+	{time.Date(1997, 7, 1, 0, 0, 0, 0, time.UTC), 31},
+	{time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC), 32},
+	{time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC), 33},
+	{time.Date(2009, 1, 1, 0, 0, 0, 0, time.UTC), 34},
+	{time.Date(2012, 7, 1, 0, 0, 0, 0, time.UTC), 35},
+	{time.Date(2015, 7, 1, 0, 0, 0, 0, time.UTC), 36},
+	{time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), 37},
+}
+
+// TAIMinusUTC returns the number of seconds TAI is ahead of UTC at t. Dates before 1972
+// return the initial value of 10 s.
+func TAIMinusUTC(t time.Time) int {
+	offset := leapSeconds[0].offset
+	for _, l := range leapSeconds {
+		if t.Before(l.from) {
+			break
+		}
+		offset = l.offset
+	}
+	return offset
+}
+
+// TTMinusUTC returns the difference between Terrestrial Time and UTC in seconds at t
+func TTMinusUTC(t time.Time) float64 {
+	return float64(TAIMinusUTC(t)) + TTMinusTAI
+}
+
+// TT returns the Julian date in Terrestrial Time for a UTC instant
+func TT(t time.Time) float64 {
+	return julian.FromTime(t) + TTMinusUTC(t)/julian.SecondsPerDay
+}
+
+// TTFromJD returns the Julian date in Terrestrial Time for a Julian date in UTC, for the
+// position functions that searches call with UT Julian dates
+func TTFromJD(jd float64) float64 {
+	return jd + TTMinusUTC(julian.ToTime(jd))/julian.SecondsPerDay
+}
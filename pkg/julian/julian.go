@@ -0,0 +1,72 @@
+package julian
+
+import (
+	"math"
+	"time"
+)
+
+// Julian date reference constants
+const (
+	J2000             = 2451545.0     // Julian date of the J2000.0 epoch (2000 Jan 1.5)
+	UnixEpoch         = 2440587.5     // Julian date of 1970 Jan 1.0 UTC
+	ModifiedOffset    = 2400000.5     // offset between Julian and modified Julian dates
+	B1900             = 2415020.31352 // Julian date of the B1900.0 epoch
+	SecondsPerDay     = 86400.0
+	DaysPerJulianYear = 365.25
+	DaysPerCentury    = 36525.0
+	DaysPerBesselYear = 365.242198781
+	J2000Year         = 2000.0
+	B1900Year         = 1900.0
+)
+
+// FromTime returns the Julian date of t. The time scale is that of t (normally UTC);
+// dates use the proleptic Gregorian calendar as time.Time does.
+func FromTime(t time.Time) float64 {
+	seconds := t.Unix()
+	days := math.Floor(float64(seconds) / SecondsPerDay)
+	remainder := float64(seconds) - days*SecondsPerDay + float64(t.Nanosecond())/1e9
+	return UnixEpoch + days + remainder/SecondsPerDay
+}
+
+// ToTime converts a Julian date to a UTC time rounded to the nearest microsecond
+func ToTime(jd float64) time.Time {
+	days := math.Floor(jd - UnixEpoch)
+	fraction := (jd - UnixEpoch) - days
+	micros := math.Round(fraction * SecondsPerDay * 1e6)
+	return time.Unix(int64(days)*int64(SecondsPerDay), 0).Add(time.Duration(micros) * time.Microsecond).UTC()
+}
+
+// Modified returns the modified Julian date for a Julian date
+func Modified(jd float64) float64 {
+	return jd - ModifiedOffset
+}
+
+// Centuries returns Julian centuries elapsed since J2000.0
+func Centuries(jd float64) float64 {
+	return (jd - J2000) / DaysPerCentury
+}
+
+// CenturiesFromTime returns Julian centuries elapsed since J2000.0 at t
+func CenturiesFromTime(t time.Time) float64 {
+	return Centuries(FromTime(t))
+}
+
+// Epoch returns the Julian epoch (e.g. 2000.0) for a Julian date
+func Epoch(jd float64) float64 {
+	return J2000Year + (jd-J2000)/DaysPerJulianYear
+}
+
+// FromEpoch returns the Julian date of a Julian epoch
+func FromEpoch(epoch float64) float64 {
+	return J2000 + (epoch-J2000Year)*DaysPerJulianYear
+}
+
+// BesselianEpoch returns the Besselian epoch (e.g. 1950.0) for a Julian date
+func BesselianEpoch(jd float64) float64 {
+	return B1900Year + (jd-B1900)/DaysPerBesselYear
+}
+
+// FromBesselianEpoch returns the Julian date of a Besselian epoch
+func FromBesselianEpoch(epoch float64) float64 {
+	return B1900 + (epoch-B1900Year)*DaysPerBesselYear
+}
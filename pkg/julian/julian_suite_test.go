@@ -0,0 +1,13 @@
+package julian_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestJulian(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Julian Suite")
+}
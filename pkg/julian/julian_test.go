@@ -0,0 +1,56 @@
+package julian
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Julian", func() {
+	DescribeTable("FromTime matches published Julian dates",
+		func(t time.Time, expected float64) {
+			Expect(FromTime(t)).To(BeNumerically("~", expected, 1e-8))
+		},
+		Entry("J2000.0", time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC), 2451545.0),
+		Entry("1987 Jan 27.0", time.Date(1987, 1, 27, 0, 0, 0, 0, time.UTC), 2446822.5),
+		Entry("1988 Jun 19.5", time.Date(1988, 6, 19, 12, 0, 0, 0, time.UTC), 2447332.0),
+		Entry("1957 Oct 4.81 (Sputnik)", time.Date(1957, 10, 4, 19, 26, 24, 0, time.UTC), 2436116.31),
+		Entry("1600 Dec 31.0", time.Date(1600, 12, 31, 0, 0, 0, 0, time.UTC), 2305812.5),
+		Entry("Unix epoch", time.Unix(0, 0), UnixEpoch),
+	)
+
+	It("should honor the time zone of the input", func() {
+		est := time.FixedZone("EST", -5*3600)
+		Expect(FromTime(time.Date(2000, 1, 1, 7, 0, 0, 0, est))).To(BeNumerically("~", J2000, 1e-9))
+	})
+
+	It("should round-trip through ToTime", func() {
+		t := time.Date(2024, 4, 8, 18, 17, 16, 123456000, time.UTC)
+		Expect(ToTime(FromTime(t))).To(BeTemporally("~", t, time.Millisecond))
+	})
+
+	It("should convert dates before the Unix epoch", func() {
+		Expect(ToTime(2436116.31)).To(BeTemporally("~", time.Date(1957, 10, 4, 19, 26, 24, 0, time.UTC), time.Millisecond))
+	})
+
+	It("should compute modified Julian dates", func() {
+		Expect(Modified(2451545.0)).To(Equal(51544.5))
+	})
+
+	It("should compute Julian centuries", func() {
+		Expect(Centuries(2448908.5)).To(BeNumerically("~", -0.072183436, 1e-9))
+		Expect(CenturiesFromTime(time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC))).To(Equal(0.0))
+	})
+
+	DescribeTable("converts epochs",
+		func(jd, julianEpoch, besselianEpoch float64) {
+			Expect(Epoch(jd)).To(BeNumerically("~", julianEpoch, 1e-6))
+			Expect(BesselianEpoch(jd)).To(BeNumerically("~", besselianEpoch, 1e-6))
+			Expect(FromEpoch(julianEpoch)).To(BeNumerically("~", jd, 1e-4))
+			Expect(FromBesselianEpoch(besselianEpoch)).To(BeNumerically("~", jd, 1e-4))
+		},
+		Entry("J2000", J2000, 2000.0, 2000.0012775),
+		Entry("B1950", 2433282.4235, 1949.9997904, 1950.0),
+	)
+})
@@ -0,0 +1,59 @@
+package lunar
+
+import (
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/internal/parallel"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"time"
+)
+
+// batchOptions holds the settings of a batch computation
+type batchOptions struct {
+	workers int
+	deltaT  func(t time.Time) float64
+	model   coordinates.Model
+}
+
+// BatchOption configures Positions
+type BatchOption func(*batchOptions)
+
+// WithWorkers spreads the computation over n goroutines; a negative n uses one per CPU
+func WithWorkers(n int) BatchOption {
+	return func(o *batchOptions) { o.workers = n }
+}
+
+// WithDeltaT converts the times to TT with deltaT, which returns TT − UT in seconds, in place
+// of TT − UTC from the leap-second table
+func WithDeltaT(deltaT func(t time.Time) float64) BatchOption {
+	return func(o *batchOptions) { o.deltaT = deltaT }
+}
+
+// WithModel selects the precession-nutation model of the frames (default coordinates.Classical)
+func WithModel(m coordinates.Model) BatchOption {
+	return func(o *batchOptions) { o.model = m }
+}
+
+// Positions returns the Moon's apparent geocentric position at each of times, UTC instants
+// converted to TT by the leap-second table unless WithDeltaT is given, in the same order. The
+// nutation is shared between samples closer than half a day; the results agree with
+// ApparentPosition to well under 0.01".
+func Positions(times []time.Time, opts ...BatchOption) []coordinates.Equatorial {
+	o := batchOptions{deltaT: astrotime.TTMinusUTC}
+	for _, apply := range opts {
+		apply(&o)
+	}
+
+	ts := make([]float64, len(times))
+	for i, t := range times {
+		ts[i] = julian.Centuries(julian.FromTime(t) + o.deltaT(t)/julian.SecondsPerDay)
+	}
+	frames := coordinates.Frames(ts, coordinates.WithModel(o.model))
+	positions := make([]coordinates.Equatorial, len(times))
+	parallel.For(len(times), o.workers, func(i int) {
+		p := Position(ts[i])
+		p.Longitude += frames[i].NutationLongitude
+		positions[i] = p.ToEquatorial(frames[i].TrueObliquity())
+	})
+	return positions
+}
@@ -0,0 +1,55 @@
+package lunar
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Positions", func() {
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	times := make([]time.Time, 240)
+	for i := range times {
+		times[i] = start.Add(time.Duration(i) * 10 * time.Minute)
+	}
+
+	It("should match ApparentPosition sample by sample", func() {
+		positions := Positions(times)
+		Expect(positions).To(HaveLen(len(times)))
+		for i, t := range times {
+			exact := ApparentPosition(julian.Centuries(astrotime.TT(t)))
+			Expect(coordinates.Separation(positions[i], exact) * 3600).To(BeNumerically("<", 0.01))
+		}
+	})
+
+	It("should evaluate the theory in TT rather than UT", func() {
+		// ΔT of 69 s moves the Moon by about 38"
+		ut := ApparentPosition(julian.CenturiesFromTime(times[0]))
+		Expect(coordinates.Separation(Positions(times[:1])[0], ut) * 3600).To(BeNumerically(">", 30))
+	})
+
+	It("should convert the times to TT with a given ΔT", func() {
+		deltaT := func(time.Time) float64 { return 69.2 }
+		exact := ApparentPosition(julian.Centuries(julian.FromTime(times[0]) + 69.2/julian.SecondsPerDay))
+		Expect(coordinates.Separation(Positions(times[:1], WithDeltaT(deltaT))[0], exact) * 3600).To(BeNumerically("<", 0.01))
+		Expect(Positions(times[:1], WithDeltaT(astrotime.TTMinusUTC))).To(Equal(Positions(times[:1])))
+	})
+
+	It("should nutate with the selected model", func() {
+		frame := coordinates.FrameAt(julian.Centuries(astrotime.TT(times[0])), coordinates.WithModel(coordinates.IAU2006))
+		p := Position(frame.T)
+		p.Longitude += frame.NutationLongitude
+		exact := p.ToEquatorial(frame.TrueObliquity())
+		Expect(Positions(times[:1], WithModel(coordinates.IAU2006))[0]).To(Equal(exact))
+	})
+
+	It("should give the same results in parallel", func() {
+		Expect(Positions(times, WithWorkers(-1))).To(Equal(Positions(times)))
+		Expect(Positions(times[:1], WithWorkers(4))).To(HaveLen(1))
+		Expect(Positions(nil)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,121 @@
+package lunar
+
+import (
+	"errors"
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/riseset"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	"github.com/ocrosby/astronomy/pkg/solar"
+	"math"
+	"time"
+)
+
+// CrescentCategory is a visibility class of the Yallop criterion (NAO Technical Note 69)
+type CrescentCategory int
+
+const (
+	EasilyVisible      CrescentCategory = iota // A: q > +0.216
+	PerfectConditions                          // B: visible under perfect conditions
+	OpticalAidMayHelp                          // C: may need optical aid to find the crescent
+	OpticalAidNeeded                           // D: will need optical aid
+	TelescopeInvisible                         // E: not visible with a telescope
+	NotVisible                                 // F: below the Danjon limit
+)
+
+// yallopLimits are the lower q bounds of categories A to E
+var yallopLimits = [...]float64{0.216, -0.014, -0.160, -0.232, -0.293}
+
+// Letter returns Yallop's letter for the category
+func (c CrescentCategory) Letter() string {
+	return string(rune('A' + c))
+}
+
+// String returns a description of the category
+func (c CrescentCategory) String() string {
+	return [...]string{"Easily visible", "Visible under perfect conditions", "May need optical aid",
+		"Will need optical aid", "Not visible with a telescope", "Not visible"}[c]
+}
+
+// YallopCategory returns the category for a q value
+func YallopCategory(q float64) CrescentCategory {
+	for i, limit := range yallopLimits {
+		if q > limit {
+			return CrescentCategory(i)
+		}
+	}
+	return NotVisible
+}
+
+// YallopQ returns Yallop's q test value from the arc of vision and the topocentric crescent
+// width in arcminutes
+func YallopQ(arcv, width float64) float64 {
+	return (arcv - (11.8371 - 6.3226*width + 0.7319*width*width - 0.1018*width*width*width)) / 10
+}
+
+// ErrNoSunset is returned when the Sun or the Moon does not set on the requested evening
+var ErrNoSunset = errors.New("lunar: no sunset or moonset on this date")
+
+// Crescent describes the visibility of the young Moon on an evening
+type Crescent struct {
+	Sunset   time.Time
+	Moonset  time.Time
+	Lag      time.Duration // moonset minus sunset
+	BestTime time.Time     // sunset plus 4/9 of the lag, Yallop's best time
+	ARCL     float64       // geocentric elongation of the Moon from the Sun in degrees
+	ARCV     float64       // geocentric difference in airless altitude in degrees
+	DAZ      float64       // azimuth of the Sun minus azimuth of the Moon in degrees
+	Width    float64       // topocentric crescent width in arcminutes
+	Q        float64
+	Category CrescentCategory
+}
+
+// CrescentVisibility evaluates the Yallop first-crescent criterion for the evening of date in
+// date's location. When the Moon sets before the Sun the crescent is reported as NotVisible with
+// quantities evaluated at sunset.
+func CrescentVisibility(date time.Time, obs observer.Observer) (Crescent, error) {
+	sun, err := solar.RiseSet(date, obs)
+	if err != nil {
+		return Crescent{}, err
+	}
+	if sun.Set.IsZero() {
+		return Crescent{}, ErrNoSunset
+	}
+	moon := riseset.Find(apparentPositionAt, riseset.MoonHorizonAltitude(obs, Parallax(julian.Centuries(astrotime.TT(sun.Set)))),
+		obs, sun.Set.Add(-12*time.Hour))
+	if moon.Set.IsZero() {
+		return Crescent{}, ErrNoSunset
+	}
+
+	c := Crescent{Sunset: sun.Set, Moonset: moon.Set, Lag: moon.Set.Sub(sun.Set)}
+	c.BestTime = c.Sunset
+	if c.Lag > 0 {
+		c.BestTime = c.Sunset.Add(c.Lag * 4 / 9)
+	}
+
+	jd := julian.FromTime(c.BestTime)
+	t := julian.Centuries(astrotime.TT(c.BestTime))
+	lst := sidereal.LocalMeanSiderealTime(jd, obs.Longitude)
+	sunPosition, moonPosition := solar.ApparentPosition(t), ApparentPosition(t)
+	sunHorizontal := sunPosition.ToHorizontal(obs.Latitude, lst)
+	moonHorizontal := moonPosition.ToHorizontal(obs.Latitude, lst)
+
+	c.ARCL = coordinates.Separation(sunPosition, moonPosition)
+	c.ARCV = moonHorizontal.Altitude - sunHorizontal.Altitude
+	c.DAZ = math.Remainder(sunHorizontal.Azimuth-moonHorizontal.Azimuth, 360)
+
+	parallax := Parallax(t)
+	semiDiameter := 0.27245 * parallax * 60 // arcminutes
+	topocentric := semiDiameter * (1 + math.Sin(moonHorizontal.Altitude*constants.Rad)*math.Sin(parallax*constants.Rad))
+	c.Width = topocentric * (1 - math.Cos(c.ARCL*constants.Rad))
+
+	c.Q = YallopQ(c.ARCV, c.Width)
+	c.Category = YallopCategory(c.Q)
+	if c.Lag <= 0 {
+		c.Category = NotVisible
+	}
+	return c, nil
+}
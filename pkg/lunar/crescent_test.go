@@ -0,0 +1,65 @@
+package lunar
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/observer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Crescent", func() {
+	mecca := observer.Observer{Latitude: 21.4225, Longitude: 39.8262}
+	ast := time.FixedZone("AST", 3*3600)
+
+	DescribeTable("YallopCategory",
+		func(q float64, expected CrescentCategory, letter string) {
+			Expect(YallopCategory(q)).To(Equal(expected))
+			Expect(expected.Letter()).To(Equal(letter))
+		},
+		Entry("easily visible", 0.5, EasilyVisible, "A"),
+		Entry("perfect conditions", 0.0, PerfectConditions, "B"),
+		Entry("optical aid may help", -0.1, OpticalAidMayHelp, "C"),
+		Entry("optical aid needed", -0.2, OpticalAidNeeded, "D"),
+		Entry("not visible with a telescope", -0.25, TelescopeInvisible, "E"),
+		Entry("below the Danjon limit", -0.5, NotVisible, "F"),
+		Entry("boundary belongs to the lower class", 0.216, PerfectConditions, "B"),
+	)
+
+	It("should compute q from arc of vision and width", func() {
+		Expect(YallopQ(10, 0.5)).To(BeNumerically("~", 0.115395, 1e-6))
+	})
+
+	It("should not see the Moon on the evening of the April 2024 conjunction", func() {
+		c, err := CrescentVisibility(time.Date(2024, 4, 8, 12, 0, 0, 0, ast), mecca)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Category).To(Equal(NotVisible))
+	})
+
+	It("should see the crescent easily two evenings later", func() {
+		c, err := CrescentVisibility(time.Date(2024, 4, 10, 12, 0, 0, 0, ast), mecca)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Category).To(Equal(EasilyVisible))
+		Expect(c.Lag).To(BeNumerically(">", time.Hour))
+		Expect(c.BestTime.After(c.Sunset)).To(BeTrue())
+		Expect(c.BestTime.Before(c.Moonset)).To(BeTrue())
+		Expect(c.ARCL).To(BeNumerically(">", 20))
+		Expect(c.Width).To(BeNumerically(">", 0.5))
+	})
+
+	It("should improve from one evening to the next after conjunction", func() {
+		first, _ := CrescentVisibility(time.Date(2024, 4, 9, 12, 0, 0, 0, ast), mecca)
+		second, _ := CrescentVisibility(time.Date(2024, 4, 10, 12, 0, 0, 0, ast), mecca)
+		Expect(second.Q).To(BeNumerically(">", first.Q))
+		Expect(second.ARCL).To(BeNumerically(">", first.ARCL))
+	})
+
+	It("should fail without a sunset", func() {
+		_, err := CrescentVisibility(time.Date(2024, 6, 21, 12, 0, 0, 0, time.UTC), observer.Observer{Latitude: 75})
+		Expect(err).To(MatchError(ErrNoSunset))
+	})
+
+	It("should describe categories", func() {
+		Expect(OpticalAidNeeded.String()).To(Equal("Will need optical aid"))
+	})
+})
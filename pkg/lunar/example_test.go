@@ -0,0 +1,27 @@
+package lunar_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/lunar"
+)
+
+func ExampleNextPhase() {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for phase := lunar.NewMoon; phase <= lunar.LastQuarter; phase++ {
+		fmt.Println(phase, lunar.NextPhase(phase, start).Format("2006-01-02 15:04"))
+	}
+	// Output:
+	// New Moon 2024-01-11 11:57
+	// First Quarter 2024-01-18 03:52
+	// Full Moon 2024-01-25 17:54
+	// Last Quarter 2024-01-04 03:31
+}
+
+func ExampleLunationNumber() {
+	t := time.Date(2024, 4, 8, 18, 0, 0, 0, time.UTC)
+	fmt.Println(lunar.LunationNumber(t), lunar.LunationNumber(t, lunar.WithNumbering(lunar.Brown)))
+	// Output:
+	// 299 1252
+}
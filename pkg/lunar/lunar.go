@@ -0,0 +1,242 @@
+package lunar
+
+import (
+	"github.com/ocrosby/astronomy/pkg/accuracy"
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"math"
+)
+
+// The Moon's position follows Meeus, Astronomical Algorithms, ch. 47 (the principal terms of
+// ELP-2000/82), accurate to about 10" in longitude and 4" in latitude. The argument t is
+// Julian centuries of dynamical time since J2000.0; angles are in degrees.
+
+// Accuracy returns the expected error of the Moon's geocentric positions
+func Accuracy() accuracy.Accuracy {
+	return accuracy.Accuracy{Name: "ELP-2000/82 (Meeus)", Angle: 10, FirstYear: 1800, LastYear: 2200,
+		Source: "Meeus, Astronomical Algorithms, ch. 47: principal terms of ELP-2000/82"}
+}
+
+// MeanDistance is the constant term of the Earth-Moon distance series in km
+const MeanDistance = 385000.56
+
+// EarthEquatorialRadius is the equatorial radius used for the Moon's horizontal parallax in km
+const EarthEquatorialRadius = 6378.14
+
+// arguments are the fundamental arguments of the lunar theory in radians
+type arguments struct {
+	l, d, m, mp, f, e float64
+}
+
+// fundamentalArguments evaluates the Moon's mean longitude, the mean elongation, the Sun's and
+// Moon's mean anomalies, the argument of latitude and the eccentricity factor E
+func fundamentalArguments(t float64) arguments {
+	t2, t3, t4 := t*t, t*t*t, t*t*t*t
+	return arguments{
+		l:  (218.3164477 + 481267.88123421*t - 0.0015786*t2 + t3/538841 - t4/65194000) * constants.Rad,
+		d:  (297.8501921 + 445267.1114034*t - 0.0018819*t2 + t3/545868 - t4/113065000) * constants.Rad,
+		m:  (357.5291092 + 35999.0502909*t - 0.0001536*t2 + t3/24490000) * constants.Rad,
+		mp: (134.9633964 + 477198.8675055*t + 0.0087414*t2 + t3/69699 - t4/14712000) * constants.Rad,
+		f:  (93.2720950 + 483202.0175233*t - 0.0036539*t2 - t3/3526000 + t4/863310000) * constants.Rad,
+		e:  1 - 0.002516*t - 0.0000074*t2,
+	}
+}
+
+// term is one periodic term: multiples of D, M, M', F and the sine and cosine coefficients
+type term struct {
+	d, m, mp, f  float64
+	sine, cosine float64
+}
+
+// argument returns the term's angle and its eccentricity factor
+func (tm term) argument(a arguments) (float64, float64) {
+	x := tm.d*a.d + tm.m*a.m + tm.mp*a.mp + tm.f*a.f
+	switch math.Abs(tm.m) {
+	case 1:
+		return x, a.e
+	case 2:
+		return x, a.e * a.e
+	}
+	return x, 1
+}
+
+// longitudeDistanceTerms are Meeus table 47.A: coefficients of Σl in 1e-6 degree (sine) and
+// Σr in 1e-3 km (cosine)
+var longitudeDistanceTerms = []term{
+	{0, 0, 1, 0, 6288774, -20905355},
+	{2, 0, -1, 0, 1274027, -3699111},
+	{2, 0, 0, 0, 658314, -2955968},
+	{0, 0, 2, 0, 213618, -569925},
+	{0, 1, 0, 0, -185116, 48888},
+	{0, 0, 0, 2, -114332, -3149},
+	{2, 0, -2, 0, 58793, 246158},
+	{2, -1, -1, 0, 57066, -152138},
+	{2, 0, 1, 0, 53322, -170733},
+	{2, -1, 0, 0, 45758, -204586},
+	{0, 1, -1, 0, -40923, -129620},
+	{1, 0, 0, 0, -34720, 108743},
+	{0, 1, 1, 0, -30383, 104755},
+	{2, 0, 0, -2, 15327, 10321},
+	{0, 0, 1, 2, -12528, 0},
+	{0, 0, 1, -2, 10980, 79661},
+	{4, 0, -1, 0, 10675, -34782},
+	{0, 0, 3, 0, 10034, -23210},
+	{4, 0, -2, 0, 8548, -21636},
+	{2, 1, -1, 0, -7888, 24208},
+	{2, 1, 0, 0, -6766, 30824},
+	{1, 0, -1, 0, -5163, -8379},
+	{1, 1, 0, 0, 4987, -16675},
+	{2, -1, 1, 0, 4036, -12831},
+	{2, 0, 2, 0, 3994, -10445},
+	{4, 0, 0, 0, 3861, -11650},
+	{2, 0, -3, 0, 3665, 14403},
+	{0, 1, -2, 0, -2689, -7003},
+	{2, 0, -1, 2, -2602, 0},
+	{2, -1, -2, 0, 2390, 10056},
+	{1, 0, 1, 0, -2348, 6322},
+	{2, -2, 0, 0, 2236, -9884},
+	{0, 1, 2, 0, -2120, 5751},
+	{0, 2, 0, 0, -2069, 0},
+	{2, -2, -1, 0, 2048, -4950},
+	{2, 0, 1, -2, -1773, 4130},
+	{2, 0, 0, 2, -1595, 0},
+	{4, -1, -1, 0, 1215, -3958},
+	{0, 0, 2, 2, -1110, 0},
+	{3, 0, -1, 0, -892, 3258},
+	{2, 1, 1, 0, -810, 2616},
+	{4, -1, -2, 0, 759, -1897},
+	{0, 2, -1, 0, -713, -2117},
+	{2, 2, -1, 0, -700, 2354},
+	{2, 1, -2, 0, 691, 0},
+	{2, -1, 0, -2, 596, 0},
+	{4, 0, 1, 0, 549, -1423},
+	{0, 0, 4, 0, 537, -1117},
+	{4, -1, 0, 0, 520, -1571},
+	{1, 0, -2, 0, -487, -1739},
+	{2, 1, 0, -2, -399, 0},
+	{0, 0, 2, -2, -381, -4421},
+	{1, 1, 1, 0, 351, 0},
+	{3, 0, -2, 0, -340, 0},
+	{4, 0, -3, 0, 330, 0},
+	{2, -1, 2, 0, 327, 0},
+	{0, 2, 1, 0, -323, 1165},
+	{1, 1, -1, 0, 299, 0},
+	{2, 0, 3, 0, 294, 0},
+	{2, 0, -1, -2, 0, 8752},
+}
+
+// latitudeTerms are Meeus table 47.B: coefficients of Σb in 1e-6 degree
+var latitudeTerms = []term{
+	{0, 0, 0, 1, 5128122, 0},
+	{0, 0, 1, 1, 280602, 0},
+	{0, 0, 1, -1, 277693, 0},
+	{2, 0, 0, -1, 173237, 0},
+	{2, 0, -1, 1, 55413, 0},
+	{2, 0, -1, -1, 46271, 0},
+	{2, 0, 0, 1, 32573, 0},
+	{0, 0, 2, 1, 17198, 0},
+	{2, 0, 1, -1, 9266, 0},
+	{0, 0, 2, -1, 8822, 0},
+	{2, -1, 0, -1, 8216, 0},
+	{2, 0, -2, -1, 4324, 0},
+	{2, 0, 1, 1, 4200, 0},
+	{2, 1, 0, -1, -3359, 0},
+	{2, -1, -1, 1, 2463, 0},
+	{2, -1, 0, 1, 2211, 0},
+	{2, -1, -1, -1, 2065, 0},
+	{0, 1, -1, -1, -1870, 0},
+	{4, 0, -1, -1, 1828, 0},
+	{0, 1, 0, 1, -1794, 0},
+	{0, 0, 0, 3, -1749, 0},
+	{0, 1, -1, 1, -1565, 0},
+	{1, 0, 0, 1, -1491, 0},
+	{0, 1, 1, 1, -1475, 0},
+	{0, 1, 1, -1, -1410, 0},
+	{0, 1, 0, -1, -1344, 0},
+	{1, 0, 0, -1, -1335, 0},
+	{0, 0, 3, 1, 1107, 0},
+	{4, 0, 0, -1, 1021, 0},
+	{4, 0, -1, 1, 833, 0},
+	{0, 0, 1, -3, 777, 0},
+	{4, 0, -2, 1, 671, 0},
+	{2, 0, 0, -3, 607, 0},
+	{2, 0, 2, -1, 596, 0},
+	{2, -1, 1, -1, 491, 0},
+	{2, 0, -2, 1, -451, 0},
+	{0, 0, 3, -1, 439, 0},
+	{2, 0, 2, 1, 422, 0},
+	{2, 0, -3, -1, 421, 0},
+	{2, 1, -1, 1, -366, 0},
+	{2, 1, 0, 1, -351, 0},
+	{4, 0, 0, 1, 331, 0},
+	{2, -1, 1, 1, 315, 0},
+	{2, -2, 0, -1, 302, 0},
+	{0, 0, 1, 3, -283, 0},
+	{2, 1, 1, -1, -229, 0},
+	{1, 1, 0, -1, 223, 0},
+	{1, 1, 0, 1, 223, 0},
+	{0, 1, -2, -1, -220, 0},
+	{2, 1, -1, -1, -220, 0},
+	{1, 0, 1, 1, -185, 0},
+	{2, -1, -2, -1, 181, 0},
+	{0, 1, 2, 1, -177, 0},
+	{4, 0, -2, -1, 176, 0},
+	{4, -1, -1, -1, 166, 0},
+	{1, 0, 1, -1, -164, 0},
+	{4, 0, 1, -1, 132, 0},
+	{1, 0, -1, -1, -119, 0},
+	{4, -1, 0, -1, 115, 0},
+	{2, -2, 0, 1, 107, 0},
+}
+
+// series evaluates Σl, Σb (1e-6 degree) and Σr (1e-3 km) including the additive terms
+func series(t float64) (sumL, sumB, sumR float64) {
+	a := fundamentalArguments(t)
+	for _, tm := range longitudeDistanceTerms {
+		x, factor := tm.argument(a)
+		sumL += tm.sine * factor * math.Sin(x)
+		sumR += tm.cosine * factor * math.Cos(x)
+	}
+	for _, tm := range latitudeTerms {
+		x, factor := tm.argument(a)
+		sumB += tm.sine * factor * math.Sin(x)
+	}
+
+	a1 := (119.75 + 131.849*t) * constants.Rad
+	a2 := (53.09 + 479264.290*t) * constants.Rad
+	a3 := (313.45 + 481266.484*t) * constants.Rad
+	sumL += 3958*math.Sin(a1) + 1962*math.Sin(a.l-a.f) + 318*math.Sin(a2)
+	sumB += -2235*math.Sin(a.l) + 382*math.Sin(a3) + 175*math.Sin(a1-a.f) + 175*math.Sin(a1+a.f) +
+		127*math.Sin(a.l-a.mp) - 115*math.Sin(a.l+a.mp)
+	return sumL, sumB, sumR
+}
+
+// Position returns the Moon's geocentric ecliptic position referred to the mean equinox of date
+func Position(t float64) coordinates.Ecliptic {
+	sumL, sumB, _ := series(t)
+	return coordinates.Ecliptic{
+		Longitude: angles.NormalizeDegrees(fundamentalArguments(t).l*constants.Deg + sumL/1e6),
+		Latitude:  sumB / 1e6,
+	}
+}
+
+// Distance returns the distance between the centers of the Earth and the Moon in km
+func Distance(t float64) float64 {
+	_, _, sumR := series(t)
+	return MeanDistance + sumR/1000
+}
+
+// Parallax returns the Moon's equatorial horizontal parallax in degrees
+func Parallax(t float64) float64 {
+	return math.Asin(EarthEquatorialRadius/Distance(t)) * constants.Deg
+}
+
+// ApparentPosition returns the Moon's apparent geocentric right ascension and declination,
+// corrected for nutation
+func ApparentPosition(t float64) coordinates.Equatorial {
+	longitude, obliquity := coordinates.Nutation(t)
+	p := Position(t)
+	p.Longitude += longitude
+	return p.ToEquatorial(coordinates.MeanObliquity(t) + obliquity)
+}
@@ -0,0 +1,13 @@
+package lunar_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestLunar(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Lunar Suite")
+}
@@ -0,0 +1,53 @@
+package lunar
+
+import (
+	"github.com/ocrosby/astronomy/pkg/testutil/reference"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Lunar", func() {
+	// Meeus, Astronomical Algorithms, example 47.a: 1992 April 12.0 TD
+	const t = -0.077221081451
+
+	It("should evaluate the periodic series", func() {
+		sumL, sumB, sumR := series(t)
+		Expect(sumL).To(BeNumerically("~", -1127527, 1))
+		Expect(sumB).To(BeNumerically("~", -3229126, 1))
+		Expect(sumR).To(BeNumerically("~", -16590875, 1))
+	})
+
+	It("should compute the geometric position", func() {
+		p := Position(t)
+		Expect(p.Longitude).To(BeNumerically("~", 133.162655, 1e-6))
+		Expect(p.Latitude).To(BeNumerically("~", -3.229126, 1e-6))
+		Expect(Distance(t)).To(BeNumerically("~", 368409.7, 0.1))
+		Expect(Parallax(t)).To(BeNumerically("~", 0.991990, 1e-6))
+	})
+
+	It("should state the accuracy of the truncated theory", func() {
+		Expect(Accuracy().Angle).To(Equal(10.0))
+		Expect(Accuracy().Covers(2024)).To(BeTrue())
+	})
+
+	It("should compute the apparent position", func() {
+		p := ApparentPosition(t)
+		Expect(p.RA).To(BeNumerically("~", 134.688470, 2e-4))
+		Expect(p.Dec).To(BeNumerically("~", 13.768368, 2e-4))
+	})
+})
+
+var _ = Describe("Reference positions", func() {
+	for _, v := range reference.Positions("Moon") {
+		It("should match "+v.Source, func() {
+			t := v.Centuries()
+			p := Position(t)
+			Expect(p.Longitude).To(BeNumerically("~", v.Longitude, 1e-5))
+			Expect(p.Latitude).To(BeNumerically("~", v.Latitude, 1e-5))
+			Expect(Distance(t)).To(BeNumerically("~", v.Distance, 0.1))
+			apparent := ApparentPosition(t)
+			Expect(apparent.RA).To(BeNumerically("~", v.RA, 2e-4))
+			Expect(apparent.Dec).To(BeNumerically("~", v.Dec, 2e-4))
+		})
+	}
+})
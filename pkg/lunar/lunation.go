@@ -0,0 +1,106 @@
+package lunar
+
+import (
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"math"
+	"time"
+)
+
+// Lunation numbering constants
+const (
+	// MeanNewMoonEpoch is the Julian Ephemeris Day of the mean new moon of 2000 January 6, the
+	// lunation numbered 0 by Meeus (ch. 49)
+	MeanNewMoonEpoch = 2451550.09766
+	// BrownOffset is the Brown Lunation Number of the lunation Meeus numbers 0; Brown's lunation
+	// 1 began with the new moon of 1923 January 17
+	BrownOffset = 953
+)
+
+// Numbering selects a lunation numbering convention
+type Numbering int
+
+const (
+	Meeus Numbering = iota // k of Astronomical Algorithms, 0 from the new moon of 2000 January 6
+	Brown                  // Brown Lunation Number, 1 from the new moon of 1923 January 17
+)
+
+// String returns the name of the convention
+func (n Numbering) String() string {
+	return [...]string{"Meeus", "Brown"}[n]
+}
+
+// offset returns the number the convention gives the Meeus lunation 0
+func (n Numbering) offset() int {
+	if n == Brown {
+		return BrownOffset
+	}
+	return 0
+}
+
+// lunationOptions holds the settings of a lunation calculation
+type lunationOptions struct {
+	numbering Numbering
+}
+
+// LunationOption configures the lunation functions
+type LunationOption func(*lunationOptions)
+
+// WithNumbering selects the lunation numbering convention (default Meeus)
+func WithNumbering(n Numbering) LunationOption {
+	return func(o *lunationOptions) { o.numbering = n }
+}
+
+// numberingOf returns the numbering the options select, Meeus when none does
+func numberingOf(options []LunationOption) Numbering {
+	o := lunationOptions{numbering: Meeus}
+	for _, apply := range options {
+		apply(&o)
+	}
+	return o.numbering
+}
+
+// Lunation is the interval from one new moon to the next
+type Lunation struct {
+	Number int
+	Start  time.Time // the new moon that begins the lunation
+	End    time.Time // the next new moon
+}
+
+// NewMoonOf returns the instant of the new moon that begins Meeus lunation k
+func NewMoonOf(k int) time.Time {
+	mean := MeanNewMoonEpoch + SynodicMonth*float64(k)
+	return NextPhase(NewMoon, julian.ToTime(mean-3))
+}
+
+// LunationNumber returns the number of the lunation containing t under the numbering, Meeus
+// unless WithNumbering selects another
+func LunationNumber(t time.Time, options ...LunationOption) int {
+	k := int(math.Floor((julian.FromTime(t) - MeanNewMoonEpoch) / SynodicMonth))
+	switch {
+	case !NewMoonOf(k).After(t) && NewMoonOf(k+1).After(t):
+	case NewMoonOf(k).After(t):
+		k--
+	default:
+		k++
+	}
+	return k + numberingOf(options).offset()
+}
+
+// LunationByNumber returns the dates of a lunation numbered under the numbering, Meeus unless
+// WithNumbering selects another
+func LunationByNumber(number int, options ...LunationOption) Lunation {
+	k := number - numberingOf(options).offset()
+	return Lunation{Number: number, Start: NewMoonOf(k), End: NewMoonOf(k + 1)}
+}
+
+// Lunations returns the lunations overlapping [start, end) in chronological order
+func Lunations(start, end time.Time, options ...LunationOption) []Lunation {
+	var lunations []Lunation
+	for n := LunationNumber(start, options...); ; n++ {
+		l := LunationByNumber(n, options...)
+		if !l.Start.Before(end) {
+			return lunations
+		}
+		lunations = append(lunations, l)
+	}
+}
@@ -0,0 +1,41 @@
+package lunar
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Lunations", func() {
+	utc := func(y int, m time.Month, d, h, min int) time.Time { return time.Date(y, m, d, h, min, 0, 0, time.UTC) }
+
+	It("should number the lunation of 2000 January 6 zero", func() {
+		Expect(NewMoonOf(0)).To(BeTemporally("~", utc(2000, 1, 6, 18, 14), 3*time.Minute))
+		Expect(LunationNumber(utc(2000, 1, 10, 0, 0))).To(Equal(0))
+		Expect(LunationNumber(utc(2000, 1, 6, 12, 0))).To(Equal(-1))
+	})
+
+	It("should follow Brown's numbering", func() {
+		Expect(LunationNumber(utc(1923, 1, 20, 0, 0), WithNumbering(Brown))).To(Equal(1))
+		Expect(LunationByNumber(1, WithNumbering(Brown)).Start).To(BeTemporally("~", utc(1923, 1, 17, 2, 41), 5*time.Minute))
+		// Meeus example 49.a: the new moon of 1977 February 18 is k = -283
+		Expect(LunationNumber(utc(1977, 2, 20, 0, 0))).To(Equal(-283))
+		Expect(LunationNumber(utc(1977, 2, 20, 0, 0), WithNumbering(Brown))).To(Equal(-283 + BrownOffset))
+		Expect(Brown.String()).To(Equal("Brown"))
+	})
+
+	It("should map lunations to date ranges", func() {
+		l := LunationByNumber(LunationNumber(utc(2024, 4, 20, 0, 0)))
+		Expect(l.Start).To(BeTemporally("~", utc(2024, 4, 8, 18, 21), 3*time.Minute))
+		Expect(l.End).To(BeTemporally("~", utc(2024, 5, 8, 3, 22), 3*time.Minute))
+
+		year := Lunations(utc(2024, 1, 1, 0, 0), utc(2025, 1, 1, 0, 0), WithNumbering(Brown))
+		Expect(year).To(HaveLen(14))
+		Expect(year[0].Start).To(BeTemporally("<", utc(2024, 1, 1, 0, 0)))
+		for i := 1; i < len(year); i++ {
+			Expect(year[i].Number).To(Equal(year[i-1].Number + 1))
+			Expect(year[i].Start).To(Equal(year[i-1].End))
+		}
+	})
+})
@@ -0,0 +1,99 @@
+package lunar
+
+import (
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/events"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/solar"
+	"math"
+	"time"
+)
+
+// SynodicMonth is the mean length of a lunation in days
+const SynodicMonth = 29.530588861
+
+// Phase identifies a principal lunar phase by the Moon's elongation in longitude from the Sun
+type Phase int
+
+const (
+	NewMoon Phase = iota
+	FirstQuarter
+	FullMoon
+	LastQuarter
+)
+
+// String returns the name of the phase
+func (p Phase) String() string {
+	return [...]string{"New Moon", "First Quarter", "Full Moon", "Last Quarter"}[p]
+}
+
+// Elongation returns the difference in degrees between the Moon's and Sun's apparent
+// longitudes
+func (p Phase) Elongation() float64 {
+	return float64(p) * 90
+}
+
+// PhaseLongitude returns the Moon's apparent longitude minus the Sun's apparent longitude in
+// degrees, in [0, 360), for Julian centuries t of dynamical time
+func PhaseLongitude(t float64) float64 {
+	return angles.NormalizeDegrees(Position(t).Longitude - solar.TrueLongitude(t) + solarAberration)
+}
+
+// solarAberration is the annual aberration of the Sun's longitude in degrees; nutation is
+// common to both bodies and cancels in the phase longitude
+const solarAberration = 0.00569
+
+// NextPhase returns the first instant at or after t at which the Moon reaches phase, accurate
+// to a couple of minutes
+func NextPhase(phase Phase, t time.Time) time.Time {
+	offset := astrotime.TT(t) - julian.FromTime(t) // TT - UTC in days
+	elongation := func(jd float64) float64 {
+		return PhaseLongitude(julian.Centuries(jd + offset))
+	}
+
+	from := julian.FromTime(t)
+	ahead := math.Mod(phase.Elongation()-elongation(from)+360, 360)
+	estimate := from + ahead/360*SynodicMonth
+	for _, c := range events.FindAngleCrossings(elongation, phase.Elongation(), math.Max(from, estimate-3), estimate+3, 0.5) {
+		if c.JD >= from {
+			return julian.ToTime(c.JD)
+		}
+	}
+	return julian.ToTime(estimate)
+}
+
+// Kind returns the event kind of the phase
+func (p Phase) Kind() events.Kind {
+	return events.NewMoon + events.Kind(p)
+}
+
+// Phases returns the instants of phase between start and end in chronological order
+func Phases(phase Phase, start, end time.Time) []time.Time {
+	var found []time.Time
+	for t := NextPhase(phase, start); t.Before(end); t = NextPhase(phase, t.Add(time.Hour)) {
+		found = append(found, t)
+	}
+	return found
+}
+
+// PhaseEvents returns the four principal phases between start and end as events of the Moon in
+// chronological order, each with the illuminated fraction of the disk at that instant, for
+// listing with other events or describing with events.Summary
+func PhaseEvents(start, end time.Time) []events.Event {
+	var found []events.Event
+	for phase := NewMoon; phase <= LastQuarter; phase++ {
+		for _, t := range Phases(phase, start, end) {
+			elongation := PhaseLongitude(julian.Centuries(astrotime.TT(t)))
+			found = append(found, events.Event{
+				Kind:  phase.Kind(),
+				Time:  t,
+				Body:  "Moon",
+				Value: (1 - math.Cos(elongation*constants.Rad)) / 2,
+			})
+		}
+	}
+	events.Sort(found)
+	return found
+}
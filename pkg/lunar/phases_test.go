@@ -0,0 +1,54 @@
+package lunar
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/events"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Phases", func() {
+	utc := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	DescribeTable("NextPhase matches published times",
+		func(phase Phase, after, expected time.Time) {
+			Expect(NextPhase(phase, after)).To(BeTemporally("~", expected, 3*time.Minute))
+		},
+		Entry("total solar eclipse new moon", NewMoon, utc(2024, 4, 1, 0, 0), utc(2024, 4, 8, 18, 21)),
+		Entry("first quarter", FirstQuarter, utc(2024, 4, 8, 0, 0), utc(2024, 4, 15, 19, 13)),
+		Entry("full moon", FullMoon, utc(2024, 4, 8, 0, 0), utc(2024, 4, 23, 23, 49)),
+		Entry("last quarter", LastQuarter, utc(2024, 4, 8, 0, 0), utc(2024, 5, 1, 11, 27)),
+		Entry("Meeus example 49.a", NewMoon, utc(1977, 2, 1, 0, 0), utc(1977, 2, 18, 3, 37)),
+	)
+
+	It("should list phases in a range", func() {
+		moons := Phases(NewMoon, utc(2024, 1, 1, 0, 0), utc(2025, 1, 1, 0, 0))
+		Expect(moons).To(HaveLen(13))
+		for i := 1; i < len(moons); i++ {
+			days := moons[i].Sub(moons[i-1]).Hours() / 24
+			Expect(days).To(BeNumerically("~", SynodicMonth, 0.6))
+		}
+	})
+
+	It("should emit the phases as events", func() {
+		found := PhaseEvents(utc(2024, 4, 3, 0, 0), utc(2024, 5, 1, 0, 0))
+		Expect(found).To(HaveLen(3))
+		Expect(found[0].Kind).To(Equal(events.NewMoon))
+		Expect(found[0].Time).To(Equal(NextPhase(NewMoon, utc(2024, 4, 3, 0, 0))))
+		Expect(found[0].Body).To(Equal("Moon"))
+		Expect(found[0].Value).To(BeNumerically("~", 0, 1e-4))
+		Expect(found[1].Kind).To(Equal(events.FirstQuarter))
+		Expect(found[1].Value).To(BeNumerically("~", 0.5, 1e-3))
+		Expect(found[2].Kind).To(Equal(events.FullMoon))
+		Expect(found[2].Value).To(BeNumerically("~", 1, 1e-4))
+		Expect(events.Summary(found[2], events.Locale{Location: time.UTC})).To(Equal("Full Moon at 23:49 UTC, 100.0% illuminated"))
+	})
+
+	It("should name phases", func() {
+		Expect(FullMoon.String()).To(Equal("Full Moon"))
+		Expect(LastQuarter.Elongation()).To(Equal(270.0))
+	})
+})
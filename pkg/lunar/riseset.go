@@ -0,0 +1,27 @@
+package lunar
+
+import (
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/internal/timescale"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/riseset"
+	"time"
+)
+
+// RiseSet returns the times and azimuths of moonrise and moonset on the calendar day of date in
+// date's location, when the upper limb meets the horizon under the observer's refraction model
+// and atmosphere. An observer whose latitude or longitude is out of range is reported.
+func RiseSet(date time.Time, obs observer.Observer) (riseset.Result, error) {
+	if err := obs.Validate(); err != nil {
+		return riseset.Result{}, err
+	}
+	parallax := Parallax(julian.Centuries(astrotime.TT(date)))
+	return riseset.OnDay(apparentPositionAt, riseset.MoonHorizonAltitude(obs, parallax), obs, date), nil
+}
+
+// apparentPositionAt returns the Moon's apparent position at a Julian date in UT
+func apparentPositionAt(jd float64) coordinates.Equatorial {
+	return ApparentPosition(julian.Centuries(timescale.TTFromJD(jd)))
+}
@@ -0,0 +1,46 @@
+package lunar
+
+import (
+	"math"
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/riseset"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	"github.com/ocrosby/astronomy/pkg/solar"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RiseSet", func() {
+	newYork := observer.Observer{Latitude: 40.7128, Longitude: -74.0060}
+	edt := time.FixedZone("EDT", -4*3600)
+
+	It("should find the Moon at its standard altitude when it rises", func() {
+		r, err := RiseSet(time.Date(2024, 4, 8, 0, 0, 0, 0, time.UTC), newYork)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r.Rise.IsZero()).To(BeFalse())
+		jd := julian.FromTime(r.Rise)
+		t := julian.Centuries(astrotime.TT(r.Rise))
+		h := ApparentPosition(t).ToHorizontal(newYork.Latitude, sidereal.LocalMeanSiderealTime(jd, newYork.Longitude))
+		Expect(h.Altitude).To(BeNumerically("~", riseset.MoonAltitude(Parallax(t)), 0.01))
+		Expect(h.Azimuth).To(BeNumerically("~", r.RiseAzimuth, 1e-6))
+
+		// the Moon moves 0.5" a second, so evaluating it in UT would misplace it by some 35"
+		ut := ApparentPosition(julian.Centuries(jd)).ToHorizontal(newYork.Latitude, sidereal.LocalMeanSiderealTime(jd, newYork.Longitude))
+		Expect(math.Abs(ut.Altitude-h.Altitude) * 3600).To(BeNumerically(">", 10))
+	})
+
+	It("should rise near sunset at full moon opposite the Sun", func() {
+		// full moon of 2024 March 25
+		date := time.Date(2024, 3, 25, 0, 0, 0, 0, edt)
+		sun, err := solar.RiseSet(date, newYork)
+		Expect(err).NotTo(HaveOccurred())
+		moon, err := RiseSet(date, newYork)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(moon.Rise).To(BeTemporally("~", sun.Set, 90*time.Minute))
+		Expect(math.Abs(moon.RiseAzimuth - (sun.SetAzimuth - 180))).To(BeNumerically("<", 8))
+	})
+})
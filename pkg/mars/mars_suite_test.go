@@ -0,0 +1,13 @@
+package mars_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestMars(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Mars Suite")
+}
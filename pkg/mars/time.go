@@ -0,0 +1,148 @@
+// Package mars keeps time on Mars with the Mars24 algorithm of Allison and McEwen: Mars Sol
+// Dates, Coordinated Mars Time, local solar times and the sol counts of landed missions.
+package mars
+
+import (
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"math"
+	"time"
+)
+
+// Martian time units
+const (
+	SolLength   = 1.0274912517 // mean solar day of Mars in Earth days
+	HoursPerSol = 24.0         // Mars time divides the sol into 24 "hours" of 15°
+)
+
+// Mars24 constants (Allison & McEwen 2000, as revised for the Mars24 Sunclock)
+const (
+	msdEpoch      = 2451549.5 // Julian date (TT) of 2000 Jan 6.0, from which Mars Sol Dates are counted
+	msdOffset     = 44796.0   // Mars Sol Date at msdEpoch, numbering sol 0 from 1873 Dec 29
+	msdAdjustment = 0.0009626 // brings midnight at the prime meridian to the Airy-0 crater
+)
+
+// perturbation is a planetary perturbation of the true anomaly of Mars (Mars24 B-3): amplitude
+// in degrees, period in Julian years and phase in degrees
+type perturbation struct {
+	amplitude, period, phase float64
+}
+
+// perturbations are the seven terms of Mars24 B-3, from Jupiter, Earth and Venus
+var perturbations = [...]perturbation{
+	{0.0071, 2.2353, 49.409},
+	{0.0057, 2.7543, 168.173},
+	{0.0039, 1.1177, 191.837},
+	{0.0037, 15.7866, 21.736},
+	{0.0021, 2.1354, 15.704},
+	{0.0020, 2.4694, 95.528},
+	{0.0018, 32.8493, 49.095},
+}
+
+// daysSinceJ2000 returns the days of Terrestrial Time elapsed since J2000.0 at a UTC instant
+func daysSinceJ2000(t time.Time) float64 {
+	return astrotime.TT(t) - julian.J2000
+}
+
+// SolDate returns the Mars Sol Date at t: the mean solar days elapsed at the prime meridian,
+// the Martian counterpart of the Julian date
+func SolDate(t time.Time) float64 {
+	return (astrotime.TT(t)-msdEpoch)/SolLength + msdOffset - msdAdjustment
+}
+
+// CoordinatedMarsTime returns Coordinated Mars Time at t in hours, in [0, 24): the mean solar
+// time of the prime meridian, Mars' counterpart of UTC
+func CoordinatedMarsTime(t time.Time) float64 {
+	return hoursOfSol(SolDate(t))
+}
+
+// anomalies returns the mean anomaly of Mars and the equation of center, its true anomaly
+// less the mean one, in degrees (Mars24 B-1 and B-4)
+func anomalies(days float64) (mean, center float64) {
+	mean = angles.NormalizeDegrees(19.3871 + 0.52402073*days)
+	pbs := 0.0
+	for _, p := range perturbations {
+		pbs += p.amplitude * math.Cos((0.985626*days/p.period+p.phase)*constants.Rad)
+	}
+	m := mean * constants.Rad
+	center = (10.691+3.0e-7*days)*math.Sin(m) + 0.623*math.Sin(2*m) + 0.050*math.Sin(3*m) +
+		0.005*math.Sin(4*m) + 0.0005*math.Sin(5*m) + pbs
+	return mean, center
+}
+
+// SolarLongitude returns the areocentric longitude of the Sun, Ls, in degrees at t: the season
+// of Mars, 0° at the northern spring equinox, 90° at the summer solstice and so on
+func SolarLongitude(t time.Time) float64 {
+	days := daysSinceJ2000(t)
+	_, center := anomalies(days)
+	fictitiousMeanSun := 270.3871 + 0.524038496*days
+	return angles.NormalizeDegrees(fictitiousMeanSun + center)
+}
+
+// EquationOfTime returns true less mean solar time on Mars at t in degrees, of which there are
+// 15 to the Martian hour; it reaches about ±50 minutes, against 16 on Earth
+func EquationOfTime(t time.Time) float64 {
+	_, center := anomalies(daysSinceJ2000(t))
+	ls := SolarLongitude(t) * constants.Rad
+	return 2.861*math.Sin(2*ls) - 0.071*math.Sin(4*ls) + 0.002*math.Sin(6*ls) - center
+}
+
+// LocalMeanSolarTime returns the local mean solar time at t in hours, in [0, 24), at a
+// planetocentric longitude in degrees, positive eastwards as in the Mars 2000 frame
+func LocalMeanSolarTime(t time.Time, longitude float64) float64 {
+	return hoursOfSol(localSolDate(t, longitude))
+}
+
+// LocalTrueSolarTime returns the local true solar time at t in hours, in [0, 24), at a
+// longitude positive eastwards: the time a sundial would show, 12 when the Sun crosses the
+// meridian
+func LocalTrueSolarTime(t time.Time, longitude float64) float64 {
+	return hoursOfSol(localSolDate(t, longitude+EquationOfTime(t)))
+}
+
+// SubsolarLongitude returns the longitude, positive eastwards, at which the Sun is overhead at t
+func SubsolarLongitude(t time.Time) float64 {
+	return angles.NormalizeDegrees(180 - CoordinatedMarsTime(t)*angles.DegreesPerHour - EquationOfTime(t))
+}
+
+// localSolDate returns the Mars Sol Date counted in local mean solar time at a longitude
+// positive eastwards
+func localSolDate(t time.Time, longitude float64) float64 {
+	return SolDate(t) + longitude/360
+}
+
+// hoursOfSol returns the time of day of a sol date in hours, in [0, 24)
+func hoursOfSol(solDate float64) float64 {
+	return (solDate - math.Floor(solDate)) * HoursPerSol
+}
+
+// Mission is a lander or rover, whose team counts sols from its landing in the local mean
+// solar time of its site
+type Mission struct {
+	Name      string
+	Landing   time.Time // UTC of the landing
+	Longitude float64   // planetocentric longitude of the site, positive eastwards
+	FirstSol  int       // number of the landing sol: 0 for Curiosity and Perseverance, 1 for earlier rovers
+}
+
+// Missions that count sols, with their landing sites
+var (
+	Spirit       = Mission{Name: "Spirit", Landing: time.Date(2004, 1, 4, 4, 35, 0, 0, time.UTC), Longitude: 175.4729, FirstSol: 1}
+	Opportunity  = Mission{Name: "Opportunity", Landing: time.Date(2004, 1, 25, 5, 5, 0, 0, time.UTC), Longitude: 354.4734, FirstSol: 1}
+	Curiosity    = Mission{Name: "Curiosity", Landing: time.Date(2012, 8, 6, 5, 17, 57, 0, time.UTC), Longitude: 137.4417, FirstSol: 0}
+	Perseverance = Mission{Name: "Perseverance", Landing: time.Date(2021, 2, 18, 20, 55, 0, 0, time.UTC), Longitude: 77.4509, FirstSol: 0}
+)
+
+// Sol returns the mission's sol number at t: the local mean solar days begun since the
+// landing sol, which has the number FirstSol
+func (m Mission) Sol(t time.Time) int {
+	landing := math.Floor(localSolDate(m.Landing, m.Longitude))
+	return int(math.Floor(localSolDate(t, m.Longitude))-landing) + m.FirstSol
+}
+
+// LocalMeanSolarTime returns the local mean solar time at the mission's site at t in hours
+func (m Mission) LocalMeanSolarTime(t time.Time) float64 {
+	return LocalMeanSolarTime(t, m.Longitude)
+}
@@ -0,0 +1,65 @@
+package mars
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Mars time", func() {
+	// Mars24 worked example: 2000 Jan 6 0h UTC
+	example := time.Date(2000, 1, 6, 0, 0, 0, 0, time.UTC)
+
+	Describe("Mars24 worked example", func() {
+		It("should give the Mars Sol Date and Coordinated Mars Time", func() {
+			Expect(SolDate(example)).To(BeNumerically("~", 44795.99976, 1e-5))
+			Expect(CoordinatedMarsTime(example)).To(BeNumerically("~", 23.99425, 1e-5))
+		})
+
+		It("should give the solar longitude and equation of time", func() {
+			Expect(SolarLongitude(example)).To(BeNumerically("~", 277.18758, 1e-5))
+			Expect(EquationOfTime(example)).To(BeNumerically("~", -5.18774, 1e-5))
+		})
+
+		It("should give the local solar times at the prime meridian", func() {
+			Expect(LocalMeanSolarTime(example, 0)).To(BeNumerically("~", 23.99425, 1e-5))
+			Expect(LocalTrueSolarTime(example, 0)).To(BeNumerically("~", 23.64840, 1e-5))
+		})
+	})
+
+	It("should advance local time by an hour per 15° eastwards", func() {
+		Expect(LocalMeanSolarTime(example, 15)).To(BeNumerically("~", 0.99425, 1e-5))
+		Expect(LocalMeanSolarTime(example, -90)).To(BeNumerically("~", 17.99425, 1e-5))
+	})
+
+	It("should put the Sun overhead where local true solar time is noon", func() {
+		longitude := SubsolarLongitude(example)
+		Expect(LocalTrueSolarTime(example, longitude)).To(BeNumerically("~", 12, 1e-9))
+	})
+
+	It("should advance the sol date by one every sol", func() {
+		later := example.Add(time.Duration(SolLength * 24 * float64(time.Hour)))
+		Expect(SolDate(later) - SolDate(example)).To(BeNumerically("~", 1, 1e-9))
+	})
+
+	Describe("mission sols", func() {
+		It("should number the landing sol", func() {
+			Expect(Curiosity.Sol(Curiosity.Landing)).To(Equal(0))
+			Expect(Opportunity.Sol(Opportunity.Landing)).To(Equal(1))
+		})
+
+		It("should have landed Curiosity in mid-afternoon", func() {
+			Expect(Curiosity.LocalMeanSolarTime(Curiosity.Landing)).To(BeNumerically("~", 15, 0.1))
+		})
+
+		It("should count the sols of the last contacts", func() {
+			Expect(Spirit.Sol(time.Date(2010, 3, 22, 12, 0, 0, 0, time.UTC))).To(Equal(2210))
+			Expect(Opportunity.Sol(time.Date(2018, 6, 10, 12, 0, 0, 0, time.UTC))).To(Equal(5111))
+		})
+
+		It("should reach Perseverance's thousandth sol in December 2023", func() {
+			Expect(Perseverance.Sol(time.Date(2023, 12, 12, 18, 0, 0, 0, time.UTC))).To(Equal(1000))
+		})
+	})
+})
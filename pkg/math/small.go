@@ -0,0 +1,49 @@
+package math
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrSmallAngle is returned when an angle is too large for the small-angle approximations
+var ErrSmallAngle = errors.New("small-angle approximation exceeds tolerance")
+
+// SmallAngleSin returns sin x for x in radians, approximated by x when |x| is below threshold
+// and computed exactly otherwise
+func SmallAngleSin(x, threshold float64) float64 {
+	if math.Abs(x) < threshold {
+		return x
+	}
+	return math.Sin(x)
+}
+
+// SmallAngleTan returns tan x for x in radians, approximated by x when |x| is below threshold
+// and computed exactly otherwise
+func SmallAngleTan(x, threshold float64) float64 {
+	if math.Abs(x) < threshold {
+		return x
+	}
+	return math.Tan(x)
+}
+
+// SmallAngleThreshold returns the largest angle in radians for which both x ≈ sin x and
+// x ≈ tan x are within the relative tolerance. The tangent is the worse, with a relative error
+// close to x²/3, so a tolerance of 1e-6 allows angles up to 5.9' (1.7e-3 rad).
+func SmallAngleThreshold(tolerance float64) float64 {
+	x := math.Sqrt(3 * tolerance)
+	// one Newton step on x²/3 + x⁴/45 = tolerance removes the truncation of the series
+	return x - (x*x/3+x*x*x*x/45-tolerance)/(2*x/3+4*x*x*x/45)
+}
+
+// CheckSmallAngle returns ErrSmallAngle when replacing sin x or tan x by x would give a relative
+// error greater than tolerance
+func CheckSmallAngle(x, tolerance float64) error {
+	if x == 0 {
+		return nil
+	}
+	if e := 1 - x/math.Tan(x); math.Abs(e) > tolerance || math.Abs(x) >= math.Pi/2 {
+		return fmt.Errorf("%w: %g rad gives a relative error of %.2g", ErrSmallAngle, x, math.Abs(e))
+	}
+	return nil
+}
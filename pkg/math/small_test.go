@@ -0,0 +1,37 @@
+package math
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Small angles", func() {
+	arcsecond := math.Pi / 648000
+
+	It("should use the approximation only below the threshold", func() {
+		parallax := 0.7687 * arcsecond
+		Expect(SmallAngleSin(parallax, 1e-3)).To(Equal(parallax))
+		Expect(SmallAngleTan(-parallax, 1e-3)).To(Equal(-parallax))
+		Expect(SmallAngleSin(0.5, 1e-3)).To(Equal(math.Sin(0.5)))
+		Expect(SmallAngleTan(0.5, 1e-3)).To(Equal(math.Tan(0.5)))
+	})
+
+	It("should derive the threshold from a tolerance", func() {
+		for _, tolerance := range []float64{1e-9, 1e-6, 1e-3} {
+			x := SmallAngleThreshold(tolerance)
+			Expect(1 - x/math.Tan(x)).To(BeNumerically("~", tolerance, tolerance*1e-3))
+			Expect(x/math.Sin(x) - 1).To(BeNumerically("<", tolerance))
+		}
+		Expect(SmallAngleThreshold(1e-6) / arcsecond / 60).To(BeNumerically("~", 5.95, 0.01))
+	})
+
+	It("should flag angles too large for the tolerance", func() {
+		Expect(CheckSmallAngle(0, 1e-12)).To(Succeed())
+		Expect(CheckSmallAngle(10*arcsecond, 1e-9)).To(Succeed())
+		Expect(CheckSmallAngle(1e-2, 1e-6)).To(MatchError(ErrSmallAngle))
+		Expect(CheckSmallAngle(-1e-2, 1e-6)).To(MatchError(ErrSmallAngle))
+		Expect(CheckSmallAngle(2, 10)).To(MatchError(ErrSmallAngle))
+	})
+})
@@ -0,0 +1,107 @@
+package navigation
+
+import (
+	"errors"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/events"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/planets"
+	"math"
+	"time"
+)
+
+// LunarDistanceBodies are the bodies whose distances from the Moon the almanacs tabulated for
+// the lunar-distance method: the Sun, the bright planets and the zodiacal stars (Markab, not in
+// the bright-star list, is omitted)
+var LunarDistanceBodies = []string{
+	"Sun", "Venus", "Mars", "Jupiter", "Saturn",
+	"Hamal", "Aldebaran", "Pollux", "Regulus", "Spica", "Antares", "Altair", "Fomalhaut",
+}
+
+// lunarSearch is the half-width of the interval searched for a matching distance, and its step
+var lunarSearch, lunarStep = 12 * time.Hour, 1.0 / 24
+
+// ErrNoLunarMatch is returned when the predicted distance does not pass through the cleared one
+// near the estimated time
+var ErrNoLunarMatch = errors.New("the predicted lunar distance does not reach the cleared distance near the estimate")
+
+// BodyDistance is the geocentric angular distance between the centres of the Moon and a body
+type BodyDistance struct {
+	Body     string
+	Distance float64 // degrees
+}
+
+// Body returns the almanac position of the Sun, a planet or a navigational star at t by name
+func Body(name string, t time.Time) (Position, error) {
+	if name == "Sun" {
+		return Sun(t), nil
+	}
+	for p := planets.Mercury; p <= planets.Neptune; p++ {
+		if p != planets.Earth && p.String() == name {
+			return Planet(p, t), nil
+		}
+	}
+	return Star(name, t)
+}
+
+// LunarDistance returns the predicted geocentric distances at t between the Moon and each of
+// LunarDistanceBodies, as the almanac tabulated them
+func LunarDistance(t time.Time) []BodyDistance {
+	moon := Moon(t).Equatorial()
+	distances := make([]BodyDistance, 0, len(LunarDistanceBodies))
+	for _, name := range LunarDistanceBodies {
+		body, err := Body(name, t)
+		if err != nil {
+			continue
+		}
+		distances = append(distances, BodyDistance{Body: name, Distance: coordinates.Separation(moon, body.Equatorial())})
+	}
+	return distances
+}
+
+// ClearDistance clears an observed lunar distance of refraction and parallax. The distance and
+// the apparent altitudes are those of the centres, in degrees, after dip and semidiameter; the
+// horizontal parallaxes come from the almanac (zero for stars). The triangle formed with the
+// zenith keeps its azimuth difference while each altitude is corrected, which gives the
+// rigorous relation cos D = (cos d - sin h sin h') cos H cos H' / (cos h cos h') + sin H sin H'.
+func ClearDistance(distance, moonAltitude, bodyAltitude, moonHP, bodyHP float64) float64 {
+	trueAltitude := func(apparent, hp float64) float64 {
+		h := apparent - Refraction(apparent)
+		return h + hp*math.Cos(h*constants.Rad)
+	}
+	moonTrue, bodyTrue := trueAltitude(moonAltitude, moonHP), trueAltitude(bodyAltitude, bodyHP)
+
+	rad := constants.Rad
+	cosD := (math.Cos(distance*rad)-math.Sin(moonAltitude*rad)*math.Sin(bodyAltitude*rad))*
+		math.Cos(moonTrue*rad)*math.Cos(bodyTrue*rad)/(math.Cos(moonAltitude*rad)*math.Cos(bodyAltitude*rad)) +
+		math.Sin(moonTrue*rad)*math.Sin(bodyTrue*rad)
+	return math.Acos(math.Max(-1, math.Min(1, cosD))) * constants.Deg
+}
+
+// TimeFromLunarDistance returns the UTC instant within twelve hours of estimate at which the
+// predicted distance between the Moon and a body equals a cleared distance. Comparing it with
+// local apparent time gives the longitude, 15° per hour of difference.
+func TimeFromLunarDistance(body string, cleared float64, estimate time.Time) (time.Time, error) {
+	if _, err := Body(body, estimate); err != nil {
+		return time.Time{}, err
+	}
+	difference := func(jd float64) float64 {
+		t := julian.ToTime(jd)
+		p, _ := Body(body, t)
+		return coordinates.Separation(Moon(t).Equatorial(), p.Equatorial()) - cleared
+	}
+
+	center := julian.FromTime(estimate)
+	half := lunarSearch.Hours() / 24
+	best, found := 0.0, false
+	for _, c := range events.FindCrossings(difference, center-half, center+half, lunarStep) {
+		if !found || math.Abs(c.JD-center) < math.Abs(best-center) {
+			best, found = c.JD, true
+		}
+	}
+	if !found {
+		return time.Time{}, ErrNoLunarMatch
+	}
+	return julian.ToTime(best), nil
+}
@@ -0,0 +1,59 @@
+package navigation
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Lunar distances", func() {
+	t := time.Date(2024, 1, 20, 18, 0, 0, 0, time.UTC)
+
+	It("should predict distances to the standard bodies", func() {
+		distances := LunarDistance(t)
+		Expect(distances).To(HaveLen(len(LunarDistanceBodies)))
+		Expect(distances[0].Body).To(Equal("Sun"))
+		Expect(distances[0].Distance).To(BeNumerically("~", coordinates.Separation(Moon(t).Equatorial(), Sun(t).Equatorial()), 1e-12))
+		for _, d := range distances {
+			Expect(d.Distance).To(BeNumerically(">=", 0))
+			Expect(d.Distance).To(BeNumerically("<=", 180))
+		}
+	})
+
+	It("should find bodies by name", func() {
+		jupiter, err := Body("Jupiter", t)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(jupiter.HP).To(BeNumerically(">", 0))
+		_, err = Body("Earth", t)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should correct a vertical distance by the altitude corrections alone", func() {
+		// Two bodies on the same vertical
+		Expect(ClearDistance(30, 30, 60, 0, 0)).To(BeNumerically("~", 30+Refraction(30)-Refraction(60), 1e-9))
+	})
+
+	It("should remove the Moon's parallax", func() {
+		// Parallax depresses the Moon, so a star above it is nearer its geocentric place
+		cleared := ClearDistance(50, 20, 70, 0.95, 0)
+		Expect(cleared).To(BeNumerically("<", 49.3))
+		Expect(cleared).To(BeNumerically(">", 49))
+	})
+
+	It("should recover the time of an observation", func() {
+		var spica BodyDistance
+		for _, d := range LunarDistance(t) {
+			if d.Body == "Spica" {
+				spica = d
+			}
+		}
+		found, err := TimeFromLunarDistance("Spica", spica.Distance, t.Add(3*time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTemporally("~", t, time.Second))
+
+		_, err = TimeFromLunarDistance("Spica", 200, t)
+		Expect(err).To(MatchError(ErrNoLunarMatch))
+	})
+})
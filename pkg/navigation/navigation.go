@@ -0,0 +1,101 @@
+// Package navigation provides the nautical almanac quantities and sight reduction of celestial
+// navigation. Angles are in degrees, with longitudes positive to the east.
+package navigation
+
+import (
+	"fmt"
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/astrometry"
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/catalog"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/lunar"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/planets"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	"github.com/ocrosby/astronomy/pkg/solar"
+	"math"
+	"strings"
+	"time"
+)
+
+// Position is a body's entry in the nautical almanac for one instant
+type Position struct {
+	GHA float64 // Greenwich hour angle, westward from the Greenwich meridian
+	Dec float64 // declination, north positive
+	SHA float64 // sidereal hour angle, 360° minus the right ascension
+	HP  float64 // horizontal parallax, zero for stars
+	SD  float64 // semidiameter, zero for stars and planets
+}
+
+// LHA returns the local hour angle at an east-positive longitude
+func (p Position) LHA(longitude float64) float64 {
+	return angles.NormalizeDegrees(p.GHA + longitude)
+}
+
+// Equatorial returns the apparent right ascension and declination of date
+func (p Position) Equatorial() coordinates.Equatorial {
+	return coordinates.Equatorial{RA: angles.NormalizeDegrees(360 - p.SHA), Dec: p.Dec}
+}
+
+// Aries returns the Greenwich hour angle of the first point of Aries, the Greenwich apparent
+// sidereal time, at t (UTC)
+func Aries(t time.Time) float64 {
+	return sidereal.GAST(julian.FromTime(t))
+}
+
+// positionOf converts an apparent position of date to almanac form
+func positionOf(eq coordinates.Equatorial, t time.Time) Position {
+	return Position{
+		GHA: angles.NormalizeDegrees(Aries(t) - eq.RA),
+		Dec: eq.Dec,
+		SHA: angles.NormalizeDegrees(360 - eq.RA),
+	}
+}
+
+// centuries returns the dynamical time of t in Julian centuries, so the Moon is placed to the
+// tenth of an arcminute the almanac tabulates
+func centuries(t time.Time) float64 {
+	return julian.Centuries(astrotime.TT(t))
+}
+
+// Sun returns the almanac position of the Sun at t
+func Sun(t time.Time) Position {
+	c := centuries(t)
+	p := positionOf(solar.ApparentPosition(c), t)
+	distance := solar.Distance(c)
+	p.HP, p.SD = constants.SunParallax/distance, constants.SunSemidiameter/distance
+	return p
+}
+
+// Moon returns the almanac position of the Moon at t
+func Moon(t time.Time) Position {
+	c := centuries(t)
+	p := positionOf(lunar.ApparentPosition(c), t)
+	p.HP = lunar.Parallax(c)
+	p.SD = math.Asin(constants.MoonRadius/lunar.Distance(c)) * constants.Deg
+	return p
+}
+
+// Planet returns the almanac position of a planet at t, without the annual aberration (under
+// 0.4')
+func Planet(planet planets.Planet, t time.Time) Position {
+	c := centuries(t)
+	p := positionOf(planets.ApparentPosition(planet, c), t)
+	p.HP = constants.SunParallax / planets.Observe(planet, c).Distance()
+	return p
+}
+
+// Star returns the almanac position at t of a navigational star from catalog.BrightStars,
+// found by name regardless of case
+func Star(name string, t time.Time) (Position, error) {
+	for _, s := range catalog.BrightStars() {
+		if strings.EqualFold(s.Name, name) {
+			place := astrometry.ApparentPlace(astrometry.FromStar(s), t, observer.Observer{})
+			return positionOf(place.Apparent, t), nil
+		}
+	}
+	return Position{}, fmt.Errorf("unknown navigational star '%s'", name)
+}
@@ -0,0 +1,48 @@
+package navigation
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/planets"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Almanac", func() {
+	t := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	arcminute := 1.0 / 60
+
+	It("should give the GHA of Aries", func() {
+		// Nautical Almanac 2024 January 1, 0h: 100°09.1'
+		Expect(Aries(t)).To(BeNumerically("~", 100+9.1/60, 0.1*arcminute))
+	})
+
+	It("should tabulate the Sun and Moon with parallax and semidiameter", func() {
+		sun := Sun(t)
+		Expect(sun.GHA).To(BeNumerically("~", 179+13.5/60, 0.5*arcminute))
+		Expect(sun.SD * 60).To(BeNumerically("~", 16.3, 0.1))
+		Expect(sun.LHA(-179.0)).To(BeNumerically("~", sun.GHA-179, 1e-9))
+
+		moon := Moon(t)
+		Expect(moon.HP * 60).To(BeNumerically("~", 54.2, 0.5))
+		Expect(moon.SD / moon.HP).To(BeNumerically("~", 0.2725, 0.001))
+	})
+
+	It("should relate GHA and SHA through Aries", func() {
+		venus := Planet(planets.Venus, t)
+		Expect(venus.GHA).To(BeNumerically("~", angles.NormalizeDegrees(Aries(t)+venus.SHA), 1e-9))
+		Expect(venus.SD).To(BeZero())
+	})
+
+	It("should look up navigational stars", func() {
+		sirius, err := Star("sirius", t)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sirius.SHA).To(BeNumerically("~", 258+27.6/60, 2*arcminute))
+		Expect(sirius.Dec).To(BeNumerically("~", -(16 + 45.2/60), 1*arcminute))
+		Expect(sirius.HP).To(BeZero())
+
+		_, err = Star("Planet X", t)
+		Expect(err).To(MatchError(ContainSubstring("unknown navigational star")))
+	})
+})
@@ -0,0 +1,75 @@
+package navigation
+
+import (
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/riseset"
+	"math"
+)
+
+// Limb selects the part of a body's disk brought to the horizon with the sextant
+type Limb int
+
+const (
+	Center Limb = iota
+	LowerLimb
+	UpperLimb
+)
+
+// String returns the name of the limb
+func (l Limb) String() string {
+	return [...]string{"Center", "Lower Limb", "Upper Limb"}[l]
+}
+
+// Dip returns the dip of the sea horizon in degrees for a height of eye in metres, including
+// terrestrial refraction (1.76' √h)
+func Dip(heightOfEye float64) float64 {
+	return riseset.HorizonDip(heightOfEye)
+}
+
+// Refraction returns the mean refraction in degrees at an apparent altitude in degrees, by
+// Bennett's formula as used in the Nautical Almanac
+func Refraction(apparent float64) float64 {
+	return 1 / math.Tan((apparent+7.31/(apparent+4.4))*constants.Rad) / 60
+}
+
+// ObservedAltitude corrects a sextant altitude to the observed altitude Ho of the body's centre:
+// index error (added as a correction, so on-the-arc errors are negative), dip, refraction,
+// semidiameter and parallax in altitude
+func ObservedAltitude(sextant, indexCorrection, heightOfEye float64, body Position, limb Limb) float64 {
+	apparent := sextant + indexCorrection - Dip(heightOfEye)
+	h := apparent - Refraction(apparent)
+	switch limb {
+	case LowerLimb:
+		h += body.SD
+	case UpperLimb:
+		h -= body.SD
+	}
+	return h + body.HP*math.Cos(h*constants.Rad)
+}
+
+// Sight is the reduction of one observation from an assumed position
+type Sight struct {
+	Computed  float64 // computed altitude Hc in degrees
+	Azimuth   float64 // true azimuth Zn in degrees
+	Intercept float64 // Ho - Hc in nautical miles, positive towards the body
+}
+
+// Reduce reduces an observed altitude of a body from an assumed position, giving the intercept
+// and azimuth that define the line of position
+func Reduce(latitude, longitude float64, body Position, observed float64) Sight {
+	computed, azimuth := ComputedAltitude(latitude, longitude, body)
+	return Sight{Computed: computed, Azimuth: azimuth, Intercept: (observed - computed) * 60}
+}
+
+// ComputedAltitude returns the altitude Hc and true azimuth Zn of a body from an assumed
+// position
+func ComputedAltitude(latitude, longitude float64, body Position) (altitude, azimuth float64) {
+	sinLat, cosLat := math.Sincos(latitude * constants.Rad)
+	sinDec, cosDec := math.Sincos(body.Dec * constants.Rad)
+	sinLHA, cosLHA := math.Sincos(body.LHA(longitude) * constants.Rad)
+
+	altitude = math.Asin(sinLat*sinDec+cosLat*cosDec*cosLHA) * constants.Deg
+	azimuth = math.Atan2(-cosDec*sinLHA, sinDec*cosLat-cosDec*cosLHA*sinLat) * constants.Deg
+	return altitude, angles.NormalizeDegrees(azimuth)
+}
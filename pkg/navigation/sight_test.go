@@ -0,0 +1,44 @@
+package navigation
+
+import (
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sight reduction", func() {
+	It("should correct a sextant altitude", func() {
+		Expect(Dip(2) * 60).To(BeNumerically("~", 2.49, 0.01))
+		Expect(Refraction(10) * 60).To(BeNumerically("~", 5.4, 0.05))
+		Expect(Refraction(90) * 60).To(BeNumerically("~", 0, 0.01))
+
+		sun := Position{HP: 0.0024, SD: 16.3 / 60}
+		ho := ObservedAltitude(40, 0, 0, sun, LowerLimb)
+		Expect((ho - 40) * 60).To(BeNumerically("~", 16.3-1.15+0.11, 0.05))
+		Expect(ObservedAltitude(40, 0, 0, sun, UpperLimb)).To(BeNumerically("<", ObservedAltitude(40, 0, 0, sun, Center)))
+		Expect(ObservedAltitude(40, -2.0/60, 0, sun, Center)).To(BeNumerically("~", ObservedAltitude(40, 0, 0, sun, Center)-2.0/60, 0.001))
+		Expect(LowerLimb.String()).To(Equal("Lower Limb"))
+	})
+
+	It("should agree with the horizontal coordinate conversion", func() {
+		body := Position{GHA: 75.5, Dec: 22.3}
+		latitude, longitude := 38.2, -60.0
+		altitude, azimuth := ComputedAltitude(latitude, longitude, body)
+
+		lst := 100.0
+		eq := coordinates.Equatorial{RA: lst - body.LHA(longitude), Dec: body.Dec}
+		h := eq.ToHorizontal(latitude, lst)
+		Expect(altitude).To(BeNumerically("~", h.Altitude, 1e-9))
+		Expect(azimuth).To(BeNumerically("~", h.Azimuth, 1e-9))
+	})
+
+	It("should give the intercept towards the body", func() {
+		body := Position{GHA: 10, Dec: 20}
+		Expect(ComputedAltitude(20, -10, body)).To(BeNumerically("~", 90, 1e-6))
+
+		sight := Reduce(19, -10, body, 89.5)
+		Expect(sight.Azimuth).To(BeNumerically("~", 0, 1e-6))
+		Expect(sight.Computed).To(BeNumerically("~", 89, 1e-6))
+		Expect(sight.Intercept).To(BeNumerically("~", 30, 1e-4))
+	})
+})
@@ -0,0 +1,125 @@
+package observer
+
+import (
+	"fmt"
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"sync"
+)
+
+// defaultAtmosphere holds the conditions NewObserver gives and Conditions falls back on
+var defaultAtmosphere = struct {
+	sync.RWMutex
+	pressure, temperature float64
+}{pressure: coordinates.StandardPressure, temperature: coordinates.StandardTemperature}
+
+// SetDefaultAtmosphere changes the pressure in millibars and temperature in degrees Celsius
+// that NewObserver gives and Conditions substitutes for a zero Pressure, the standard
+// atmosphere unless changed, as astronomy.SetGlobal does. It is safe for concurrent use.
+func SetDefaultAtmosphere(pressure, temperature float64) {
+	defaultAtmosphere.Lock()
+	defer defaultAtmosphere.Unlock()
+	defaultAtmosphere.pressure, defaultAtmosphere.temperature = pressure, temperature
+}
+
+// DefaultAtmosphere returns the pressure and temperature set by SetDefaultAtmosphere
+func DefaultAtmosphere() (pressure, temperature float64) {
+	defaultAtmosphere.RLock()
+	defer defaultAtmosphere.RUnlock()
+	return defaultAtmosphere.pressure, defaultAtmosphere.temperature
+}
+
+// Observer is a location on the Earth's surface with its local atmospheric conditions. A zero
+// Pressure selects the default atmosphere and Temperature is then ignored.
+type Observer struct {
+	Latitude    float64                     // geodetic latitude in degrees, north positive
+	Longitude   float64                     // longitude in degrees, east positive
+	Elevation   float64                     // height above sea level in meters
+	Pressure    float64                     // atmospheric pressure in millibars
+	Temperature float64                     // air temperature in degrees Celsius
+	Refraction  coordinates.RefractionModel // nil selects coordinates.Saemundsson
+}
+
+// Option configures an Observer at construction
+type Option func(*Observer)
+
+// WithElevation sets the height above sea level in meters
+func WithElevation(meters float64) Option {
+	return func(o *Observer) { o.Elevation = meters }
+}
+
+// WithPressure sets the atmospheric pressure in millibars
+func WithPressure(millibars float64) Option {
+	return func(o *Observer) { o.Pressure = millibars }
+}
+
+// WithTemperature sets the air temperature in degrees Celsius
+func WithTemperature(celsius float64) Option {
+	return func(o *Observer) { o.Temperature = celsius }
+}
+
+// WithRefraction sets the refraction model, e.g. coordinates.Radio for radio observations or
+// coordinates.NoRefraction for airless altitudes
+func WithRefraction(model coordinates.RefractionModel) Option {
+	return func(o *Observer) { o.Refraction = model }
+}
+
+// NewObserver creates an observer at sea level under the default atmosphere unless options say
+// otherwise
+func NewObserver(latitude, longitude float64, options ...Option) Observer {
+	o := Observer{Latitude: latitude, Longitude: longitude}
+	o.Pressure, o.Temperature = DefaultAtmosphere()
+	for _, option := range options {
+		option(&o)
+	}
+	return o
+}
+
+// NewObserverAt creates an observer from validated latitude and longitude values, which cannot
+// be swapped or out of range
+func NewObserverAt(latitude angles.Latitude, longitude angles.Longitude, options ...Option) Observer {
+	return NewObserver(latitude.Degrees(), longitude.Degrees(), options...)
+}
+
+// Validate reports a latitude or longitude outside its range, which NewObserver accepts
+// without checking
+func (o Observer) Validate() error {
+	if _, err := angles.NewLatitude(o.Latitude); err != nil {
+		return fmt.Errorf("observer: %w", err)
+	}
+	if _, err := angles.NewLongitude(o.Longitude); err != nil {
+		return fmt.Errorf("observer: %w", err)
+	}
+	return nil
+}
+
+// Conditions returns the pressure and temperature to use for refraction, substituting the
+// default atmosphere when Pressure is zero
+func (o Observer) Conditions() (pressure, temperature float64) {
+	if o.Pressure == 0 {
+		return DefaultAtmosphere()
+	}
+	return o.Pressure, o.Temperature
+}
+
+// RefractionModel returns the observer's refraction model, substituting coordinates.Saemundsson
+// when none is set
+func (o Observer) RefractionModel() coordinates.RefractionModel {
+	if o.Refraction == nil {
+		return coordinates.Saemundsson{}
+	}
+	return o.Refraction
+}
+
+// RefractionAt returns the refraction in degrees to add to a true altitude in degrees under the
+// observer's model and atmosphere
+func (o Observer) RefractionAt(altitude float64) float64 {
+	pressure, temperature := o.Conditions()
+	return o.RefractionModel().Refraction(altitude, pressure, temperature)
+}
+
+// TrueAltitude removes the observer's refraction from an observed altitude in degrees
+func (o Observer) TrueAltitude(observed float64) float64 {
+	pressure, temperature := o.Conditions()
+	return coordinates.TrueAltitudeWith(o.RefractionModel(), observed, pressure, temperature)
+}
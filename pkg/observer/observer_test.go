@@ -0,0 +1,66 @@
+package observer
+
+import (
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Observer", func() {
+	It("should default to sea level and the standard atmosphere", func() {
+		o := NewObserver(51.48, 0)
+		Expect(o).To(Equal(Observer{Latitude: 51.48, Pressure: coordinates.StandardPressure,
+			Temperature: coordinates.StandardTemperature}))
+	})
+
+	It("should build from typed latitude and longitude", func() {
+		lat, _ := angles.ParseLatitude("19°49'N")
+		lon, _ := angles.ParseLongitude("155°28'W")
+		o := NewObserverAt(lat, lon, WithElevation(4205))
+		Expect(o.Latitude).To(BeNumerically("~", 19+49/60.0, 1e-12))
+		Expect(o.Longitude).To(BeNumerically("~", -(155 + 28/60.0), 1e-12))
+		Expect(o.Elevation).To(Equal(4205.0))
+		Expect(o.Validate()).To(Succeed())
+	})
+
+	It("should report out-of-range coordinates", func() {
+		Expect(NewObserver(200, 0).Validate()).To(MatchError(angles.ErrLatitudeRange))
+		Expect(NewObserver(0, 270).Validate()).To(MatchError(angles.ErrLongitudeRange))
+	})
+
+	It("should apply options", func() {
+		o := NewObserver(19.82, -155.47, WithElevation(4205), WithPressure(615), WithTemperature(-2))
+		Expect(o.Elevation).To(Equal(4205.0))
+		p, t := o.Conditions()
+		Expect(p).To(Equal(615.0))
+		Expect(t).To(Equal(-2.0))
+	})
+
+	It("should refract with the chosen model", func() {
+		o := NewObserver(51.48, 0)
+		Expect(o.RefractionModel()).To(Equal(coordinates.Saemundsson{}))
+		Expect(o.RefractionAt(10)).To(Equal(coordinates.RefractionAt(10, coordinates.StandardPressure, coordinates.StandardTemperature)))
+		Expect(o.TrueAltitude(10 + o.RefractionAt(10))).To(BeNumerically("~", 10, 1e-9))
+
+		airless := NewObserver(51.48, 0, WithRefraction(coordinates.NoRefraction{}))
+		Expect(airless.RefractionAt(0)).To(BeZero())
+		Expect(airless.TrueAltitude(5)).To(Equal(5.0))
+	})
+
+	It("should substitute the standard atmosphere when no pressure is set", func() {
+		p, t := Observer{Temperature: 30}.Conditions()
+		Expect(p).To(Equal(coordinates.StandardPressure))
+		Expect(t).To(Equal(coordinates.StandardTemperature))
+	})
+
+	It("should follow a changed default atmosphere", func() {
+		SetDefaultAtmosphere(600, -20)
+		DeferCleanup(SetDefaultAtmosphere, coordinates.StandardPressure, coordinates.StandardTemperature)
+		Expect(NewObserver(0, 0).Pressure).To(Equal(600.0))
+		p, t := Observer{}.Conditions()
+		Expect(p).To(Equal(600.0))
+		Expect(t).To(Equal(-20.0))
+		Expect(Observer{}.RefractionAt(10)).To(BeNumerically("<", NewObserver(0, 0, WithPressure(1010), WithTemperature(10)).RefractionAt(10)))
+	})
+})
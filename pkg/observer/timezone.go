@@ -0,0 +1,153 @@
+package observer
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//go:embed timezones.csv
+var timeZonesCSV string
+
+// nauticalZoneDistance is the distance in kilometres from the nearest reference point beyond
+// which a position is taken to be at sea and given the nautical zone of its longitude
+const nauticalZoneDistance = 1000
+
+var (
+	zonesOnce sync.Once
+	zones     []zonePoint
+)
+
+// zonePoint is a reference point of the embedded time-zone data
+type zonePoint struct {
+	latitude, longitude float64
+	zone                string
+}
+
+// TimeZoneProvider maps a position in degrees to an IANA time-zone name such as
+// "Europe/Paris". Implementations backed by boundary polygons or an online service can replace
+// the built-in CoarseTimeZones.
+type TimeZoneProvider interface {
+	TimeZone(latitude, longitude float64) (string, error)
+}
+
+// CoarseTimeZones is a TimeZoneProvider built on an embedded table of some 500 cities and
+// stations, taking the zone of the nearest. It is right well inside a zone but may be wrong
+// within a few hundred kilometres of a boundary; more than 1000 km from land it returns the
+// nautical zone of the longitude, such as "Etc/GMT+5".
+var CoarseTimeZones TimeZoneProvider = coarseTimeZones{}
+
+// coarseTimeZones is the nearest-neighbour provider over the embedded table
+type coarseTimeZones struct{}
+
+// TimeZone returns the zone of the nearest reference point
+func (coarseTimeZones) TimeZone(latitude, longitude float64) (string, error) {
+	if latitude < -90 || latitude > 90 || math.IsNaN(longitude) || math.IsInf(longitude, 0) {
+		return "", fmt.Errorf("invalid position %v, %v", latitude, longitude)
+	}
+	zonesOnce.Do(func() {
+		points, err := parseTimeZones(timeZonesCSV)
+		if err != nil {
+			panic(fmt.Sprintf("observer: embedded time-zone data is invalid: %v", err))
+		}
+		zones = points
+	})
+
+	nearest, best := "", math.Inf(1)
+	for _, p := range zones {
+		if d := centralAngle(latitude, longitude, p.latitude, p.longitude); d < best {
+			nearest, best = p.zone, d
+		}
+	}
+	if best*constants.EarthRadius > nauticalZoneDistance {
+		return nauticalZone(longitude), nil
+	}
+	return nearest, nil
+}
+
+// locationOptions holds the settings of a time-zone lookup
+type locationOptions struct {
+	provider TimeZoneProvider
+}
+
+// LocationOption configures Location
+type LocationOption func(*locationOptions)
+
+// WithTimeZoneProvider looks the zone up with p (default CoarseTimeZones)
+func WithTimeZoneProvider(p TimeZoneProvider) LocationOption {
+	return func(o *locationOptions) { o.provider = p }
+}
+
+// Location returns the observer's time zone as found by a provider, CoarseTimeZones unless
+// WithTimeZoneProvider gives another, so results can be shown in local time when only the
+// position is known. The zone is loaded with time.LoadLocation; programs that may run without a
+// system time-zone database should import time/tzdata.
+func (o Observer) Location(options ...LocationOption) (*time.Location, error) {
+	var lookup locationOptions
+	for _, apply := range options {
+		apply(&lookup)
+	}
+	if lookup.provider == nil {
+		lookup.provider = CoarseTimeZones
+	}
+	name, err := lookup.provider.TimeZone(o.Latitude, o.Longitude)
+	if err != nil {
+		return nil, fmt.Errorf("observer: %v", err)
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("observer: %v", err)
+	}
+	return location, nil
+}
+
+// nauticalZone returns the Etc zone of a longitude, whose name has the sign of the offset
+// reversed, as "Etc/GMT+5" for five hours behind UTC
+func nauticalZone(longitude float64) string {
+	offset := int(math.Round(math.Remainder(longitude, 360) / 15))
+	switch {
+	case offset > 0:
+		return fmt.Sprintf("Etc/GMT-%d", offset)
+	case offset < 0:
+		return fmt.Sprintf("Etc/GMT+%d", -offset)
+	}
+	return "Etc/GMT"
+}
+
+// centralAngle returns the angle in radians between two positions in degrees
+func centralAngle(lat1, lon1, lat2, lon2 float64) float64 {
+	sinLat1, cosLat1 := math.Sincos(lat1 * constants.Rad)
+	sinLat2, cosLat2 := math.Sincos(lat2 * constants.Rad)
+	cos := sinLat1*sinLat2 + cosLat1*cosLat2*math.Cos((lon2-lon1)*constants.Rad)
+	return math.Acos(math.Max(-1, math.Min(1, cos)))
+}
+
+// parseTimeZones parses the embedded time-zone CSV data
+func parseTimeZones(data string) ([]zonePoint, error) {
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.Comment = '#'
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]zonePoint, 0, len(records))
+	for _, r := range records[1:] {
+		latitude, err := strconv.ParseFloat(r[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("record %v: %v", r, err)
+		}
+		longitude, err := strconv.ParseFloat(r[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("record %v: %v", r, err)
+		}
+		points = append(points, zonePoint{latitude: latitude, longitude: longitude, zone: r[2]})
+	}
+	return points, nil
+}
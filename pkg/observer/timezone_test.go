@@ -0,0 +1,68 @@
+package observer
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fixedZone is a provider that always answers with one zone or error
+type fixedZone struct {
+	name string
+	err  error
+}
+
+func (f fixedZone) TimeZone(float64, float64) (string, error) {
+	return f.name, f.err
+}
+
+var _ = Describe("Time zones", func() {
+	DescribeTable("should infer the zone of well-known places",
+		func(latitude, longitude float64, zone string) {
+			Expect(CoarseTimeZones.TimeZone(latitude, longitude)).To(Equal(zone))
+		},
+		Entry("Mauna Kea", 19.82, -155.47, "Pacific/Honolulu"),
+		Entry("Kitt Peak", 31.96, -111.60, "America/Phoenix"),
+		Entry("Greenwich", 51.48, 0.0, "Europe/London"),
+		Entry("Paranal", -24.63, -70.40, "America/Santiago"),
+		Entry("Siding Spring", -31.27, 149.07, "Australia/Sydney"),
+		Entry("La Palma", 28.76, -17.88, "Atlantic/Canary"),
+		Entry("mid-Pacific at sea", 10.0, -140.0, "Etc/GMT+9"),
+		Entry("Indian Ocean at sea", -35.0, 80.0, "Etc/GMT-5"),
+	)
+
+	It("should give an observer a loadable location", func() {
+		location, err := NewObserver(48.85, 2.35).Location()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(location.String()).To(Equal("Europe/Paris"))
+
+		t := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC).In(location)
+		_, offset := t.Zone()
+		Expect(offset).To(Equal(2 * 3600))
+	})
+
+	It("should use a provider when one is given", func() {
+		location, err := NewObserver(0, 0).Location(WithTimeZoneProvider(fixedZone{name: "Asia/Tokyo"}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(location.String()).To(Equal("Asia/Tokyo"))
+
+		_, err = NewObserver(0, 0).Location(WithTimeZoneProvider(fixedZone{err: errors.New("offline")}))
+		Expect(err).To(MatchError("observer: offline"))
+		_, err = NewObserver(0, 0).Location(WithTimeZoneProvider(fixedZone{name: "Mars/Olympus_Mons"}))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject impossible positions", func() {
+		_, err := CoarseTimeZones.TimeZone(91, 0)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should name nautical zones with the reversed sign", func() {
+		Expect(nauticalZone(-75)).To(Equal("Etc/GMT+5"))
+		Expect(nauticalZone(135)).To(Equal("Etc/GMT-9"))
+		Expect(nauticalZone(5)).To(Equal("Etc/GMT"))
+		Expect(nauticalZone(345)).To(Equal("Etc/GMT+1"))
+	})
+})
@@ -0,0 +1,41 @@
+package optics
+
+import (
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"math"
+)
+
+// SiderealRate is the apparent motion of stars on the celestial equator in arcseconds per second
+// of time
+const SiderealRate = 15.041067
+
+// MaxUntrackedExposure returns the longest exposure in seconds on a fixed tripod before stars at
+// a declination in degrees trail visibly, by the NPF rule (35 N + 30 p) / f for a lens of focal
+// length f in mm at focal ratio N with pixels of pitch p in µm. Stars away from the equator move
+// more slowly, so the limit is divided by cos(declination) and is infinite at the poles.
+func MaxUntrackedExposure(focalLength, focalRatio, pixelPitch, declination float64) float64 {
+	return atDeclination((35*focalRatio+30*pixelPitch)/focalLength, declination)
+}
+
+// Rule500 returns the exposure limit in seconds of the older 500 rule, 500 / (f × crop factor),
+// scaled for declination as MaxUntrackedExposure is. It ignores the pixel pitch and so allows
+// visible trails on modern high-resolution sensors.
+func Rule500(focalLength, cropFactor, declination float64) float64 {
+	return atDeclination(500/(focalLength*cropFactor), declination)
+}
+
+// atDeclination scales an exposure limit on the equator to a declination in degrees: +Inf at
+// the poles, where cos(declination) in floating point would leave a finite limit
+func atDeclination(limit, declination float64) float64 {
+	if math.Abs(declination) >= 90 {
+		return math.Inf(1)
+	}
+	return limit / math.Cos(declination*constants.Rad)
+}
+
+// TrailLength returns the length in pixels of a star trail over an untracked exposure of the
+// given seconds, for a focal length in mm, pixel pitch in µm and declination in degrees
+func TrailLength(exposure, focalLength, pixelPitch, declination float64) float64 {
+	drift := SiderealRate * exposure * math.Cos(declination*constants.Rad)
+	return drift / PixelScale(pixelPitch, focalLength)
+}
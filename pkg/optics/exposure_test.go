@@ -0,0 +1,34 @@
+package optics
+
+import (
+	"math"
+
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Untracked exposure", func() {
+	It("should apply the NPF rule on the celestial equator", func() {
+		// 14 mm f/2.8 with 4.3 µm pixels
+		Expect(MaxUntrackedExposure(14, 2.8, 4.3, 0)).To(BeNumerically("~", 16.21, 0.01))
+		Expect(Rule500(14, 1, 0)).To(BeNumerically("~", 35.71, 0.01))
+		Expect(Rule500(14, 1.5, 0)).To(BeNumerically("~", 23.81, 0.01))
+	})
+
+	It("should allow longer exposures toward the pole", func() {
+		polaris := coordinates.Equatorial{RA: 37.95, Dec: 89.264}
+		deneb := coordinates.Equatorial{RA: 310.358, Dec: 45.280}
+		equator := MaxUntrackedExposure(24, 1.4, 5.9, 0)
+		Expect(MaxUntrackedExposure(24, 1.4, 5.9, deneb.Dec)).To(BeNumerically("~", equator/0.7039, 0.01))
+		Expect(MaxUntrackedExposure(24, 1.4, 5.9, polaris.Dec)).To(BeNumerically(">", 70*equator))
+		Expect(math.IsInf(MaxUntrackedExposure(24, 1.4, 5.9, 90), 1)).To(BeTrue())
+		Expect(math.IsInf(MaxUntrackedExposure(24, 1.4, 5.9, -90), 1)).To(BeTrue())
+		Expect(math.IsInf(Rule500(24, 1, 90), 1)).To(BeTrue())
+	})
+
+	It("should measure trails in pixels", func() {
+		Expect(TrailLength(30, 50, 4.3, 0)).To(BeNumerically("~", 25.4, 0.1))
+		Expect(TrailLength(30, 50, 4.3, 60)).To(BeNumerically("~", 12.7, 0.1))
+	})
+})
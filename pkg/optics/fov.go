@@ -0,0 +1,91 @@
+package optics
+
+import (
+	"github.com/ocrosby/astronomy/pkg/catalog"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"math"
+)
+
+// Field is a rectangular field of view in degrees
+type Field struct {
+	Width  float64
+	Height float64
+}
+
+// Diagonal returns the angular size of the field's diagonal in degrees
+func (f Field) Diagonal() float64 {
+	return math.Hypot(f.Width, f.Height)
+}
+
+// Fits reports whether an object of the given major and minor axes in arcminutes fits within
+// the field with its major axis along the field's longer side, leaving margin (a fraction such as
+// 0.1 for 10%) of the field free on each axis
+func (f Field) Fits(majorAxis, minorAxis, margin float64) bool {
+	long, short := math.Max(f.Width, f.Height), math.Min(f.Width, f.Height)
+	usable := (1 - margin) * 60
+	return math.Max(majorAxis, minorAxis) <= long*usable && math.Min(majorAxis, minorAxis) <= short*usable
+}
+
+// FitsObject reports whether a catalog object fits within the field as Fits does
+func (f Field) FitsObject(o catalog.DeepSkyObject, margin float64) bool {
+	return f.Fits(o.MajorAxis, o.MinorAxis, margin)
+}
+
+// Magnification returns the magnification of a telescope and eyepiece
+func Magnification(focalLength, eyepieceFocalLength float64) float64 {
+	return focalLength / eyepieceFocalLength
+}
+
+// TrueFOV returns the true field of view in degrees of an eyepiece with the given apparent field
+// in degrees, using the approximation true = apparent / magnification
+func TrueFOV(focalLength, eyepieceFocalLength, apparentFOV float64) float64 {
+	return apparentFOV / Magnification(focalLength, eyepieceFocalLength)
+}
+
+// FieldStopFOV returns the true field of view in degrees from the eyepiece's field stop
+// diameter in mm, which is more accurate than TrueFOV for wide-angle eyepieces
+func FieldStopFOV(focalLength, fieldStop float64) float64 {
+	return fieldStop / focalLength * constants.Deg
+}
+
+// ExitPupil returns the diameter in mm of the beam leaving the eyepiece
+func ExitPupil(aperture, focalLength, eyepieceFocalLength float64) float64 {
+	return aperture / Magnification(focalLength, eyepieceFocalLength)
+}
+
+// Sensor is a camera's imaging area, with dimensions in mm and pixel size in µm
+type Sensor struct {
+	Width     float64
+	Height    float64
+	PixelSize float64
+}
+
+// Field returns the field of view of the sensor at a focal length in mm
+func (s Sensor) Field(focalLength float64) Field {
+	return Field{Width: sensorAngle(s.Width, focalLength), Height: sensorAngle(s.Height, focalLength)}
+}
+
+// PixelScale returns the pixel scale in arcseconds at a focal length in mm
+func (s Sensor) PixelScale(focalLength float64) float64 {
+	return PixelScale(s.PixelSize, focalLength)
+}
+
+// MaxFocalLength returns the longest focal length in mm at which an object of the given major and
+// minor axes in arcminutes fits on the sensor as Field.Fits decides, leaving margin free
+func (s Sensor) MaxFocalLength(majorAxis, minorAxis, margin float64) float64 {
+	long, short := math.Max(s.Width, s.Height), math.Min(s.Width, s.Height)
+	major, minor := math.Max(majorAxis, minorAxis), math.Min(majorAxis, minorAxis)
+	limit := func(side, size float64) float64 {
+		if size <= 0 {
+			return math.Inf(1)
+		}
+		angle := size / 60 / (1 - margin) * constants.Rad
+		return side / (2 * math.Tan(angle/2))
+	}
+	return math.Min(limit(long, major), limit(short, minor))
+}
+
+// sensorAngle returns the angle in degrees subtended by a length in mm at a focal length in mm
+func sensorAngle(length, focalLength float64) float64 {
+	return 2 * math.Atan(length/(2*focalLength)) * constants.Deg
+}
@@ -0,0 +1,44 @@
+package optics
+
+import (
+	"github.com/ocrosby/astronomy/pkg/catalog"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Field of view", func() {
+	fullFrame := Sensor{Width: 36, Height: 24, PixelSize: 5.9}
+	m31, _ := catalog.MessierObject(31)
+
+	It("should compute eyepiece fields", func() {
+		Expect(Magnification(1200, 25)).To(Equal(48.0))
+		Expect(TrueFOV(1200, 25, 52)).To(BeNumerically("~", 1.0833, 1e-4))
+		Expect(FieldStopFOV(1200, 21.2)).To(BeNumerically("~", 1.0122, 1e-4))
+		Expect(ExitPupil(200, 1200, 25)).To(BeNumerically("~", 4.1667, 1e-4))
+	})
+
+	It("should compute sensor fields", func() {
+		field := fullFrame.Field(50)
+		Expect(field.Width).To(BeNumerically("~", 39.60, 0.01))
+		Expect(field.Height).To(BeNumerically("~", 26.99, 0.01))
+		Expect(fullFrame.Field(1000).Diagonal()).To(BeNumerically("~", 2.479, 0.001))
+		Expect(fullFrame.PixelScale(1000)).To(BeNumerically("~", 1.217, 0.001))
+	})
+
+	It("should decide whether M31 fits in the frame", func() {
+		Expect(m31.MajorAxis).To(BeNumerically(">", 150))
+		Expect(fullFrame.Field(400).FitsObject(m31, 0.1)).To(BeTrue())
+		Expect(fullFrame.Field(1000).FitsObject(m31, 0.1)).To(BeFalse())
+
+		longest := fullFrame.MaxFocalLength(m31.MajorAxis, m31.MinorAxis, 0.1)
+		Expect(fullFrame.Field(longest*0.999).FitsObject(m31, 0.1)).To(BeTrue())
+		Expect(fullFrame.Field(longest*1.001).FitsObject(m31, 0.1)).To(BeFalse())
+	})
+
+	It("should orient the object along the longer side", func() {
+		portrait := Field{Width: 1, Height: 2}
+		Expect(portrait.Fits(100, 50, 0)).To(BeTrue())
+		Expect(portrait.Fits(50, 100, 0)).To(BeTrue())
+		Expect(portrait.Fits(130, 50, 0)).To(BeFalse())
+	})
+})
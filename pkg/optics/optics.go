@@ -0,0 +1,67 @@
+package optics
+
+import (
+	"github.com/ocrosby/astronomy/pkg/constants"
+)
+
+// The calculators take apertures and focal lengths in millimetres, pixel sizes in micrometres and
+// wavelengths in nanometres, and return angles in arcseconds.
+
+// DefaultWavelength is the wavelength in nm at the peak sensitivity of the eye, used for visual
+// resolution figures
+const DefaultWavelength = 550.0
+
+// DawesCoefficient is Dawes' empirical limit for resolving equal double stars, in arcseconds
+// times millimetres of aperture
+const DawesCoefficient = 116.0
+
+// airyFirstMinimum is the radius of the first dark ring of the Airy pattern in units of λ/D
+const airyFirstMinimum = 1.21967
+
+// DawesLimit returns the smallest separation in arcseconds at which a telescope of the given
+// aperture splits a pair of equal, moderately bright stars
+func DawesLimit(aperture float64) float64 {
+	return DawesCoefficient / aperture
+}
+
+// RayleighCriterion returns the angular resolution in arcseconds of a circular aperture at a
+// wavelength in nm: the radius of the first dark ring of the Airy pattern, 1.22 λ/D
+func RayleighCriterion(aperture, wavelength float64) float64 {
+	return airyFirstMinimum * wavelength * 1e-6 / aperture * constants.Arcs
+}
+
+// AiryDiskDiameter returns the angular diameter in arcseconds of the Airy disk, out to its first
+// dark ring
+func AiryDiskDiameter(aperture, wavelength float64) float64 {
+	return 2 * RayleighCriterion(aperture, wavelength)
+}
+
+// AiryDiskSize returns the diameter in micrometres of the Airy disk projected onto the focal
+// plane, 2.44 λ N, which depends only on the focal ratio
+func AiryDiskSize(focalRatio, wavelength float64) float64 {
+	return 2 * airyFirstMinimum * wavelength * 1e-3 * focalRatio
+}
+
+// FocalRatio returns the focal ratio (f-number) of a telescope
+func FocalRatio(focalLength, aperture float64) float64 {
+	return focalLength / aperture
+}
+
+// PixelScale returns the sky angle in arcseconds covered by one pixel of the given size at a
+// focal length
+func PixelScale(pixelSize, focalLength float64) float64 {
+	return pixelSize * 1e-3 / focalLength * constants.Arcs
+}
+
+// Sampling returns the number of pixels across the full width at half maximum of a star image,
+// given the seeing FWHM and the pixel scale in arcseconds. Values of 2 to 3 sample the image
+// well; lower values are undersampled and blocky, higher ones spread light over needless pixels.
+func Sampling(seeing, pixelScale float64) float64 {
+	return seeing / pixelScale
+}
+
+// CriticalFocalLength returns the focal length in mm at which pixels of the given size sample
+// the seeing FWHM with the given number of pixels, e.g. 2 for Nyquist sampling
+func CriticalFocalLength(pixelSize, seeing, pixels float64) float64 {
+	return pixelSize * 1e-3 * constants.Arcs * pixels / seeing
+}
@@ -0,0 +1,43 @@
+package optics
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Optics", func() {
+	Describe("resolution", func() {
+		It("should give the Dawes limit of common apertures", func() {
+			Expect(DawesLimit(100)).To(Equal(1.16))
+			Expect(DawesLimit(200)).To(Equal(0.58))
+		})
+
+		It("should give the Rayleigh criterion and Airy disk", func() {
+			// 1.22 λ/D for 100 mm at 550 nm is 1.38"
+			Expect(RayleighCriterion(100, DefaultWavelength)).To(BeNumerically("~", 1.384, 1e-3))
+			Expect(AiryDiskDiameter(100, DefaultWavelength)).To(BeNumerically("~", 2.768, 1e-3))
+			Expect(RayleighCriterion(200, 700)).To(BeNumerically("~", RayleighCriterion(100, 350), 1e-12))
+		})
+
+		It("should project the Airy disk onto the focal plane", func() {
+			Expect(AiryDiskSize(10, DefaultWavelength)).To(BeNumerically("~", 13.42, 0.01))
+			// the linear size equals the angular size times the focal length
+			Expect(AiryDiskSize(FocalRatio(1000, 100), DefaultWavelength)).To(
+				BeNumerically("~", AiryDiskDiameter(100, DefaultWavelength)/PixelScale(1, 1000), 1e-9))
+		})
+	})
+
+	Describe("sampling", func() {
+		It("should compute the pixel scale", func() {
+			// 3.76 µm pixels at 800 mm
+			Expect(PixelScale(3.76, 800)).To(BeNumerically("~", 0.9694, 1e-4))
+			Expect(FocalRatio(800, 160)).To(Equal(5.0))
+		})
+
+		It("should compute sampling and the focal length that achieves it", func() {
+			scale := PixelScale(3.76, 800)
+			Expect(Sampling(2.5, scale)).To(BeNumerically("~", 2.579, 1e-3))
+			Expect(PixelScale(3.76, CriticalFocalLength(3.76, 2.5, 2))).To(BeNumerically("~", 1.25, 1e-12))
+		})
+	})
+})
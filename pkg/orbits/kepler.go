@@ -0,0 +1,39 @@
+package orbits
+
+import (
+	"math"
+)
+
+// Kepler solver settings
+const (
+	KeplerTolerance     = 1e-14 // convergence limit on the eccentric anomaly in radians
+	KeplerMaxIterations = 50
+)
+
+// SolveKepler solves Kepler's equation M = E - e·sin(E) for the eccentric anomaly E of an
+// elliptical orbit (0 <= e < 1) using Newton-Raphson iteration. Angles are in radians.
+// WithPrecision evaluates the solution with math/big.
+func SolveKepler(meanAnomaly, eccentricity float64, options ...PrecisionOption) float64 {
+	if p := precisionOf(options); p != Float64Precision {
+		return solveKeplerPrecise(meanAnomaly, eccentricity, p)
+	}
+	m := math.Remainder(meanAnomaly, 2*math.Pi)
+	e := m
+	if eccentricity > 0.8 {
+		e = math.Pi * math.Copysign(1, m)
+	}
+	for i := 0; i < KeplerMaxIterations; i++ {
+		delta := (e - eccentricity*math.Sin(e) - m) / (1 - eccentricity*math.Cos(e))
+		e -= delta
+		if math.Abs(delta) < KeplerTolerance {
+			break
+		}
+	}
+	return e + (meanAnomaly - m)
+}
+
+// TrueAnomaly returns the true anomaly for an eccentric anomaly of an elliptical orbit, in radians
+func TrueAnomaly(eccentricAnomaly, eccentricity float64) float64 {
+	return 2 * math.Atan2(math.Sqrt(1+eccentricity)*math.Sin(eccentricAnomaly/2),
+		math.Sqrt(1-eccentricity)*math.Cos(eccentricAnomaly/2))
+}
@@ -0,0 +1,35 @@
+package orbits
+
+import (
+	"math"
+
+	"github.com/ocrosby/astronomy/pkg/constants"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Kepler", func() {
+	DescribeTable("SolveKepler satisfies Kepler's equation",
+		func(meanAnomaly, eccentricity float64) {
+			e := SolveKepler(meanAnomaly, eccentricity)
+			Expect(e - eccentricity*math.Sin(e)).To(BeNumerically("~", meanAnomaly, 1e-12))
+		},
+		Entry("circular orbit", 1.0, 0.0),
+		Entry("low eccentricity", 0.3, 0.0167),
+		Entry("moderate eccentricity", 2.5, 0.5),
+		Entry("high eccentricity near periapsis", 0.01, 0.99),
+		Entry("negative mean anomaly", -2.0, 0.7),
+		Entry("several revolutions", 20.0, 0.2),
+	)
+
+	It("should match Meeus example 30.a", func() {
+		e := SolveKepler(5*constants.Rad, 0.1)
+		Expect(e * constants.Deg).To(BeNumerically("~", 5.554589, 1e-6))
+	})
+
+	It("should compute the true anomaly", func() {
+		Expect(TrueAnomaly(0, 0.5)).To(BeNumerically("~", 0, 1e-15))
+		Expect(TrueAnomaly(math.Pi/2, 0)).To(BeNumerically("~", math.Pi/2, 1e-15))
+		Expect(TrueAnomaly(math.Pi/2, 0.5)).To(BeNumerically("~", 2*math.Atan(math.Sqrt(3)), 1e-12))
+	})
+})
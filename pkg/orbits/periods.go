@@ -0,0 +1,62 @@
+package orbits
+
+import (
+	"math"
+)
+
+// Period helpers accept any consistent units: with a semi-major axis in km and a
+// gravitational parameter in km³/s², mean motions are in rad/s and periods in seconds.
+
+// DegreesPerRevolution is the angle swept in one orbital revolution
+const DegreesPerRevolution = 360.0
+
+// SynodicPeriod returns the synodic period of two bodies with sidereal periods p1 and p2,
+// the interval between successive alignments as seen from the common central body
+func SynodicPeriod(p1, p2 float64) float64 {
+	return 1 / math.Abs(1/p1-1/p2)
+}
+
+// SiderealPeriod returns the sidereal period of a body from its synodic period as seen from an
+// observer with the given sidereal period. inner reports whether the body orbits inside the
+// observer's orbit (e.g. Venus seen from the Earth).
+func SiderealPeriod(synodic, observer float64, inner bool) float64 {
+	if inner {
+		return 1 / (1/observer + 1/synodic)
+	}
+	return 1 / (1/observer - 1/synodic)
+}
+
+// AnomalisticPeriod returns the time between periapsis passages for a sidereal period and an
+// apsidal precession rate in degrees per unit of the period (positive for a prograde advance)
+func AnomalisticPeriod(sidereal, apsidalRate float64) float64 {
+	return 1 / (1/sidereal - apsidalRate/DegreesPerRevolution)
+}
+
+// DraconicPeriod returns the time between ascending node passages for a sidereal period and a
+// nodal precession rate in degrees per unit of the period (negative for a regression)
+func DraconicPeriod(sidereal, nodalRate float64) float64 {
+	return 1 / (1/sidereal - nodalRate/DegreesPerRevolution)
+}
+
+// MeanMotion returns the mean angular motion in radians per unit time for a semi-major axis
+// and gravitational parameter mu
+func MeanMotion(semiMajorAxis, mu float64) float64 {
+	return math.Sqrt(mu / (semiMajorAxis * semiMajorAxis * semiMajorAxis))
+}
+
+// SemiMajorAxisFromMeanMotion returns the semi-major axis for a mean motion in radians per
+// unit time and gravitational parameter mu
+func SemiMajorAxisFromMeanMotion(meanMotion, mu float64) float64 {
+	return math.Cbrt(mu / (meanMotion * meanMotion))
+}
+
+// Period returns the orbital period for a semi-major axis and gravitational parameter mu
+func Period(semiMajorAxis, mu float64) float64 {
+	return 2 * math.Pi / MeanMotion(semiMajorAxis, mu)
+}
+
+// SemiMajorAxisFromPeriod returns the semi-major axis for an orbital period and gravitational
+// parameter mu
+func SemiMajorAxisFromPeriod(period, mu float64) float64 {
+	return SemiMajorAxisFromMeanMotion(2*math.Pi/period, mu)
+}
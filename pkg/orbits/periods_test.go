@@ -0,0 +1,56 @@
+package orbits
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Periods", func() {
+	const (
+		earthYear = 365.256363 // sidereal year in days
+		muEarth   = 398600.4418
+		muSun     = 1.32712440018e11
+	)
+
+	DescribeTable("SynodicPeriod",
+		func(p1, p2, expected float64) {
+			Expect(SynodicPeriod(p1, p2)).To(BeNumerically("~", expected, 0.5))
+			Expect(SynodicPeriod(p2, p1)).To(BeNumerically("~", expected, 0.5))
+		},
+		Entry("Mars", 686.980, earthYear, 779.9),
+		Entry("Venus", 224.701, earthYear, 583.9),
+		Entry("Jupiter", 4332.589, earthYear, 398.9),
+	)
+
+	It("should recover sidereal periods from synodic periods", func() {
+		Expect(SiderealPeriod(SynodicPeriod(686.980, earthYear), earthYear, false)).To(BeNumerically("~", 686.980, 1e-6))
+		Expect(SiderealPeriod(SynodicPeriod(224.701, earthYear), earthYear, true)).To(BeNumerically("~", 224.701, 1e-6))
+	})
+
+	It("should compute the Moon's anomalistic and draconic months", func() {
+		const sidereal = 27.321662
+		Expect(AnomalisticPeriod(sidereal, 360/3232.6)).To(BeNumerically("~", 27.554550, 1e-4))
+		Expect(DraconicPeriod(sidereal, -360/6798.38)).To(BeNumerically("~", 27.212221, 1e-4))
+	})
+
+	It("should compute the Earth's anomalistic year", func() {
+		perihelionAdvance := 11.6 / 3600 / 365.25 // degrees per day
+		Expect(AnomalisticPeriod(earthYear, perihelionAdvance)).To(BeNumerically("~", 365.259636, 1e-3))
+	})
+
+	It("should relate mean motion, period and semi-major axis", func() {
+		geostationary := 42164.17
+		Expect(Period(geostationary, muEarth)).To(BeNumerically("~", 86164.09, 0.1))
+		Expect(SemiMajorAxisFromPeriod(86164.0905, muEarth)).To(BeNumerically("~", geostationary, 0.05))
+
+		n := MeanMotion(geostationary, muEarth)
+		Expect(n).To(BeNumerically("~", 2*math.Pi/86164.09, 1e-10))
+		Expect(SemiMajorAxisFromMeanMotion(n, muEarth)).To(BeNumerically("~", geostationary, 1e-6))
+	})
+
+	It("should give one year for 1 AU around the Sun", func() {
+		Expect(Period(149597870.7, muSun) / 86400).To(BeNumerically("~", 365.25, 0.1))
+	})
+})
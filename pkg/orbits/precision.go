@@ -0,0 +1,100 @@
+package orbits
+
+import (
+	"github.com/ocrosby/astronomy/pkg/internal/bigmath"
+	"math"
+	"math/big"
+)
+
+// Precision is the mantissa size in bits used for arbitrary-precision evaluation. The zero
+// value selects ordinary float64 arithmetic, so callers who do not ask for more pay nothing.
+type Precision uint
+
+const (
+	Float64Precision Precision = 0
+	QuadPrecision    Precision = 113
+	HighPrecision    Precision = 256
+)
+
+// precisionOptions holds the settings of SolveKepler and MeanAnomaly
+type precisionOptions struct {
+	precision Precision
+}
+
+// PrecisionOption configures SolveKepler and MeanAnomaly
+type PrecisionOption func(*precisionOptions)
+
+// WithPrecision evaluates with math/big at p bits (default Float64Precision)
+func WithPrecision(p Precision) PrecisionOption {
+	return func(o *precisionOptions) { o.precision = p }
+}
+
+// precisionOf returns the precision the options select, Float64Precision when none does
+func precisionOf(options []PrecisionOption) Precision {
+	var o precisionOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+	return o.precision
+}
+
+// SolveKeplerBig solves Kepler's equation for the eccentric anomaly in radians at the precision
+// of meanAnomaly. Angles are reduced with π to full precision, so mean anomalies accumulated over
+// millions of revolutions keep their fractional part.
+func SolveKeplerBig(meanAnomaly, eccentricity *big.Float) *big.Float {
+	prec := meanAnomaly.Prec()
+	twoPi := bigmath.Pi(prec + 32)
+	twoPi.Mul(twoPi, big.NewFloat(2))
+	m := bigmath.Remainder(new(big.Float).SetPrec(prec+32).Set(meanAnomaly), twoPi).SetPrec(prec)
+	e := new(big.Float).SetPrec(prec).Set(eccentricity)
+
+	anomaly := new(big.Float).SetPrec(prec).Set(m)
+	if e.Cmp(big.NewFloat(0.8)) > 0 {
+		anomaly.SetFloat64(math.Copysign(1, float64Of(m))).Mul(anomaly, bigmath.Pi(prec))
+	}
+	limit := new(big.Float).SetMantExp(big.NewFloat(1), 8-int(prec))
+	one := big.NewFloat(1).SetPrec(prec)
+	for i := 0; i < KeplerMaxIterations; i++ {
+		sin, cos := bigmath.Sincos(anomaly)
+		f := new(big.Float).SetPrec(prec).Mul(e, sin)
+		f.Sub(anomaly, f).Sub(f, m)
+		slope := new(big.Float).SetPrec(prec).Mul(e, cos)
+		slope.Sub(one, slope)
+		delta := f.Quo(f, slope)
+		anomaly.Sub(anomaly, delta)
+		if delta.Abs(delta).Cmp(limit) < 0 {
+			break
+		}
+	}
+	return anomaly.Add(anomaly, new(big.Float).SetPrec(prec).Sub(meanAnomaly, m))
+}
+
+// solveKeplerPrecise evaluates SolveKepler at the requested precision
+func solveKeplerPrecise(meanAnomaly, eccentricity float64, precision Precision) float64 {
+	m := new(big.Float).SetPrec(uint(precision)).SetFloat64(meanAnomaly)
+	e := new(big.Float).SetPrec(uint(precision)).SetFloat64(eccentricity)
+	return float64Of(SolveKeplerBig(m, e))
+}
+
+// MeanAnomaly returns the mean anomaly in radians, reduced to [-π, π], a time dt after an epoch
+// with mean anomaly m0 in radians and mean motion n in radians per unit of dt. WithPrecision
+// the product n·dt and its reduction are formed without float64 cancellation.
+func MeanAnomaly(m0, n, dt float64, options ...PrecisionOption) float64 {
+	p := precisionOf(options)
+	if p == Float64Precision {
+		return math.Remainder(m0+n*dt, 2*math.Pi)
+	}
+	prec := uint(p)
+	m := new(big.Float).SetPrec(prec).SetFloat64(n)
+	m.Mul(m, new(big.Float).SetPrec(prec).SetFloat64(dt))
+	m.Add(m, new(big.Float).SetPrec(prec).SetFloat64(m0))
+	twoPi := bigmath.Pi(prec)
+	twoPi.Mul(twoPi, big.NewFloat(2))
+	return float64Of(bigmath.Remainder(m, twoPi))
+}
+
+// float64Of returns the nearest float64 to x
+func float64Of(x *big.Float) float64 {
+	f, _ := x.Float64()
+	return f
+}
@@ -0,0 +1,44 @@
+package orbits
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/ocrosby/astronomy/pkg/internal/bigmath"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("High precision", func() {
+	DescribeTable("SolveKepler agrees with float64 for ordinary inputs",
+		func(m, e float64) {
+			Expect(SolveKepler(m, e, WithPrecision(HighPrecision))).To(BeNumerically("~", SolveKepler(m, e), 1e-14))
+			Expect(SolveKepler(m, e, WithPrecision(QuadPrecision))).To(BeNumerically("~", SolveKepler(m, e), 1e-14))
+		},
+		Entry("circular", 1.0, 0.0),
+		Entry("moderate", 2.0, 0.3),
+		Entry("eccentric", -0.2, 0.95),
+	)
+
+	It("should satisfy Kepler's equation to full precision", func() {
+		m := new(big.Float).SetPrec(256).SetFloat64(2e6*math.Pi + 0.75)
+		e := new(big.Float).SetPrec(256).SetFloat64(0.9)
+		anomaly := SolveKeplerBig(m, e)
+
+		sin, _ := bigmath.Sincos(anomaly)
+		residual := new(big.Float).SetPrec(256).Mul(e, sin)
+		residual.Sub(anomaly, residual).Sub(residual, m)
+		Expect(residual.Abs(residual).Cmp(big.NewFloat(1e-60))).To(BeNumerically("<", 0))
+	})
+
+	It("should compute π", func() {
+		Expect(bigmath.Pi(256).Text('f', 40)).To(Equal("3.1415926535897932384626433832795028841972"))
+	})
+
+	It("should reduce long mean anomalies without cancellation", func() {
+		n, dt := 0.017202124161, 3.6525e6
+		Expect(MeanAnomaly(0.1, n, dt, WithPrecision(HighPrecision))).To(BeNumerically("~", MeanAnomaly(0.1, n, dt), 1e-9))
+		Expect(math.Abs(MeanAnomaly(0.1, n, dt, WithPrecision(HighPrecision)))).To(BeNumerically("<=", math.Pi))
+		Expect(math.Abs(MeanAnomaly(3*math.Pi, 0, 0, WithPrecision(HighPrecision)))).To(BeNumerically("~", math.Pi, 1e-15))
+	})
+})
@@ -0,0 +1,33 @@
+package orbits
+
+import (
+	"math"
+)
+
+// VisViva returns the orbital speed at distance r for an orbit with semi-major axis a around a
+// body with gravitational parameter mu. Pass math.Inf(1) for a parabolic orbit and a negative
+// semi-major axis for a hyperbolic one.
+func VisViva(r, a, mu float64) float64 {
+	return math.Sqrt(mu * (2/r - 1/a))
+}
+
+// CircularVelocity returns the speed of a circular orbit of radius r
+func CircularVelocity(r, mu float64) float64 {
+	return math.Sqrt(mu / r)
+}
+
+// EscapeVelocity returns the speed needed to escape from distance r
+func EscapeVelocity(r, mu float64) float64 {
+	return math.Sqrt(2 * mu / r)
+}
+
+// SpecificEnergy returns the specific orbital energy -mu/(2a)
+func SpecificEnergy(a, mu float64) float64 {
+	return -mu / (2 * a)
+}
+
+// SemiMajorAxisFromSpeed returns the semi-major axis of the orbit passing distance r at speed v,
+// negative for hyperbolic orbits and infinite for parabolic ones
+func SemiMajorAxisFromSpeed(r, v, mu float64) float64 {
+	return 1 / (2/r - v*v/mu)
+}
@@ -0,0 +1,42 @@
+package orbits
+
+import (
+	"math"
+
+	"github.com/ocrosby/astronomy/pkg/constants"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Velocity", func() {
+	It("should compute circular and escape velocities at the Earth's surface", func() {
+		Expect(CircularVelocity(constants.EarthRadius, constants.GMEarth)).To(BeNumerically("~", 7.91, 0.01))
+		Expect(EscapeVelocity(constants.EarthRadius, constants.GMEarth)).To(BeNumerically("~", 11.19, 0.01))
+	})
+
+	It("should compute the Earth's mean orbital speed", func() {
+		Expect(CircularVelocity(constants.AU, constants.GMSun)).To(BeNumerically("~", 29.78, 0.01))
+	})
+
+	It("should reduce to the circular velocity when r equals a", func() {
+		Expect(VisViva(7000, 7000, constants.GMEarth)).To(BeNumerically("~", CircularVelocity(7000, constants.GMEarth), 1e-12))
+	})
+
+	It("should equal the escape velocity for a parabolic orbit", func() {
+		Expect(VisViva(7000, math.Inf(1), constants.GMEarth)).To(BeNumerically("~", EscapeVelocity(7000, constants.GMEarth), 1e-12))
+	})
+
+	It("should compute Hohmann transfer speeds from LEO to GEO", func() {
+		leo, geo := 6678.0, 42164.0
+		transfer := (leo + geo) / 2
+		Expect(VisViva(leo, transfer, constants.GMEarth) - CircularVelocity(leo, constants.GMEarth)).To(BeNumerically("~", 2.43, 0.01))
+		Expect(CircularVelocity(geo, constants.GMEarth) - VisViva(geo, transfer, constants.GMEarth)).To(BeNumerically("~", 1.46, 0.01))
+	})
+
+	It("should invert vis-viva for the semi-major axis", func() {
+		v := VisViva(8000, 12000, constants.GMEarth)
+		Expect(SemiMajorAxisFromSpeed(8000, v, constants.GMEarth)).To(BeNumerically("~", 12000, 1e-6))
+		Expect(SemiMajorAxisFromSpeed(8000, 1.5*EscapeVelocity(8000, constants.GMEarth), constants.GMEarth)).To(BeNumerically("<", 0))
+		Expect(SpecificEnergy(12000, constants.GMEarth)).To(BeNumerically("~", v*v/2-constants.GMEarth/8000, 1e-9))
+	})
+})
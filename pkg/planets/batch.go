@@ -0,0 +1,57 @@
+package planets
+
+import (
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/internal/parallel"
+	"github.com/ocrosby/astronomy/pkg/internal/timescale"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"time"
+)
+
+// batchOptions holds the settings of a batch computation
+type batchOptions struct {
+	workers int
+	deltaT  func(t time.Time) float64
+	model   coordinates.Model
+}
+
+// BatchOption configures Positions
+type BatchOption func(*batchOptions)
+
+// WithWorkers spreads the computation over n goroutines; a negative n uses one per CPU
+func WithWorkers(n int) BatchOption {
+	return func(o *batchOptions) { o.workers = n }
+}
+
+// WithDeltaT converts the times to TT with deltaT, which returns TT − UT in seconds, in place
+// of TT − UTC from the leap-second table
+func WithDeltaT(deltaT func(t time.Time) float64) BatchOption {
+	return func(o *batchOptions) { o.deltaT = deltaT }
+}
+
+// WithModel selects the precession-nutation model of the frames (default coordinates.Classical)
+func WithModel(m coordinates.Model) BatchOption {
+	return func(o *batchOptions) { o.model = m }
+}
+
+// Positions returns the apparent geocentric position of a planet other than the Earth at each
+// of times, UTC instants converted to TT by the leap-second table unless WithDeltaT is given,
+// as ApparentPosition gives it, in the same order. Precession and nutation are shared between
+// samples closer than half a day.
+func Positions(p Planet, times []time.Time, opts ...BatchOption) []coordinates.Equatorial {
+	o := batchOptions{deltaT: timescale.TTMinusUTC}
+	for _, apply := range opts {
+		apply(&o)
+	}
+
+	ts := make([]float64, len(times))
+	for i, t := range times {
+		ts[i] = julian.Centuries(julian.FromTime(t) + o.deltaT(t)/julian.SecondsPerDay)
+	}
+	frames := coordinates.Frames(ts, coordinates.WithModel(o.model))
+	positions := make([]coordinates.Equatorial, len(times))
+	parallel.For(len(times), o.workers, func(i int) {
+		positions[i] = frames[i].Nutate(frames[i].PrecessFromJ2000(Observe(p, ts[i]).Equatorial()))
+	})
+	return positions
+}
@@ -0,0 +1,36 @@
+package planets
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/internal/timescale"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Positions", func() {
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	times := make([]time.Time, 100)
+	for i := range times {
+		times[i] = start.Add(time.Duration(i) * time.Hour)
+	}
+
+	It("should match ApparentPosition sample by sample", func() {
+		positions := Positions(Mars, times, WithWorkers(3))
+		for i, t := range times {
+			exact := ApparentPosition(Mars, julian.Centuries(timescale.TT(t)))
+			Expect(coordinates.Separation(positions[i], exact) * 3600).To(BeNumerically("<", 0.01))
+		}
+		Expect(Positions(Mars, times)).To(Equal(positions))
+	})
+
+	It("should precess and nutate with the selected model", func() {
+		t := julian.Centuries(timescale.TT(times[0]))
+		frame := coordinates.FrameAt(t, coordinates.WithModel(coordinates.IAU2006))
+		exact := frame.Nutate(frame.PrecessFromJ2000(Observe(Mars, t).Equatorial()))
+		Expect(Positions(Mars, times[:1], WithModel(coordinates.IAU2006))[0]).To(Equal(exact))
+		Expect(Positions(Mars, times[:1], WithModel(coordinates.IAU2006))).NotTo(Equal(Positions(Mars, times[:1])))
+	})
+})
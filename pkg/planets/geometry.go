@@ -0,0 +1,122 @@
+package planets
+
+import (
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/vectors"
+	"math"
+)
+
+// lightTimePerAU is the light travel time across one astronomical unit in Julian centuries
+const lightTimePerAU = constants.AU / constants.SpeedOfLight / 86400 / 36525
+
+// saturnPole is the north pole of Saturn's ring plane (J2000 equatorial)
+var saturnPole = coordinates.Equatorial{RA: 40.589, Dec: 83.537}
+
+// Geometry is the Sun-planet-Earth configuration at one instant, with the planet taken at the
+// time its light left it. Vectors are in AU in J2000 ecliptic axes.
+type Geometry struct {
+	Planet       Planet
+	Heliocentric vectors.Vector3D // the planet seen from the Sun
+	Geocentric   vectors.Vector3D // the planet seen from the Earth
+}
+
+// Observe returns the geometry of a planet other than the Earth for Julian centuries t since
+// J2000.0 (TT)
+func Observe(p Planet, t float64) Geometry {
+	return ObserveFrom(p, Heliocentric(Earth, t), t)
+}
+
+// ObserveFrom returns the geometry of a planet seen from a heliocentric Earth position already
+// computed for t, so several planets can share it
+func ObserveFrom(p Planet, earth vectors.Vector3D, t float64) Geometry {
+	helio := Heliocentric(p, t)
+	geo := helio.Subtract(earth)
+	for i := 0; i < 2; i++ {
+		helio = Heliocentric(p, t-geo.Magnitude()*lightTimePerAU)
+		geo = helio.Subtract(earth)
+	}
+	return Geometry{Planet: p, Heliocentric: helio, Geocentric: geo}
+}
+
+// Distance returns the distance from the Earth in AU
+func (g Geometry) Distance() float64 {
+	return g.Geocentric.Magnitude()
+}
+
+// SunDistance returns the distance from the Sun in AU
+func (g Geometry) SunDistance() float64 {
+	return g.Heliocentric.Magnitude()
+}
+
+// PhaseAngle returns the Sun-planet-Earth angle in degrees
+func (g Geometry) PhaseAngle() float64 {
+	return vectors.Angle3D(g.Heliocentric, g.Geocentric) * constants.Deg
+}
+
+// Magnitude returns the apparent visual magnitude, using the expressions of the Astronomical
+// Almanac 1984 (Meeus 41); Saturn's includes the brightening by its rings
+func (g Geometry) Magnitude() float64 {
+	i := g.PhaseAngle()
+	distances := 5 * math.Log10(g.SunDistance()*g.Distance())
+	switch g.Planet {
+	case Mercury:
+		return -0.42 + distances + (0.0380+(-0.000273+0.000002*i)*i)*i
+	case Venus:
+		return -4.40 + distances + (0.0009+(0.000239-0.00000065*i)*i)*i
+	case Mars:
+		return -1.52 + distances + 0.016*i
+	case Jupiter:
+		return -9.40 + distances + 0.005*i
+	case Saturn:
+		b, deltaU := g.ringTilt()
+		sinB := math.Sin(math.Abs(b) * constants.Rad)
+		return -8.88 + distances + 0.044*math.Abs(deltaU) - 2.60*sinB + 1.25*sinB*sinB
+	case Uranus:
+		return -7.19 + distances
+	case Neptune:
+		return -6.87 + distances
+	case Pluto:
+		return -1.00 + distances
+	}
+	return math.NaN()
+}
+
+// ringTilt returns the Saturnicentric latitude of the Earth referred to the ring plane and the
+// difference between the Saturnicentric longitudes of the Sun and the Earth in that plane, both
+// in degrees
+func (g Geometry) ringTilt() (b, deltaU float64) {
+	pole := vectors.Rotate3Dx(saturnPole.Vector(), -coordinates.MeanObliquity(0)*constants.Rad)
+	toEarth := g.Geocentric.ScalarMultiply(-1).Normalize()
+	toSun := g.Heliocentric.ScalarMultiply(-1).Normalize()
+	b = math.Asin(pole.DotProduct(toEarth)) * constants.Deg
+
+	inPlane := func(v vectors.Vector3D) vectors.Vector3D {
+		return v.Subtract(pole.ScalarMultiply(v.DotProduct(pole)))
+	}
+	deltaU = vectors.Angle3D(inPlane(toEarth), inPlane(toSun)) * constants.Deg
+	return b, deltaU
+}
+
+// Equatorial returns the geocentric direction referred to the J2000 equator and equinox
+func (g Geometry) Equatorial() coordinates.Equatorial {
+	eq := coordinates.EquatorialFromVector(vectors.Rotate3Dx(g.Geocentric, coordinates.MeanObliquity(0)*constants.Rad))
+	eq.Epoch = coordinates.J2000
+	return eq
+}
+
+// ApparentPosition returns the planet's geocentric right ascension and declination referred to
+// the true equator and equinox of date, corrected for light-time, precession and nutation but
+// not for aberration (at most 20")
+func ApparentPosition(p Planet, t float64) coordinates.Equatorial {
+	mean := coordinates.Precess(Observe(p, t).Equatorial(), 0, t)
+	longitude, obliquity := coordinates.Nutation(t)
+	ecliptic := mean.ToEcliptic(coordinates.MeanObliquity(t))
+	ecliptic.Longitude += longitude
+	return ecliptic.ToEquatorial(coordinates.MeanObliquity(t) + obliquity)
+}
+
+// Magnitude returns the apparent visual magnitude of a planet other than the Earth
+func Magnitude(p Planet, t float64) float64 {
+	return Observe(p, t).Magnitude()
+}
@@ -0,0 +1,57 @@
+package planets
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/testutil/reference"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Geometry", func() {
+	// 1992 December 20, 0h TD (Meeus examples 33.a and 41.a)
+	venusT := -0.070321697
+
+	It("should match Meeus example 33.a for the apparent place of Venus", func() {
+		g := Observe(Venus, venusT)
+		Expect(g.Distance()).To(BeNumerically("~", 0.910947, 0.001))
+		Expect(g.SunDistance()).To(BeNumerically("~", 0.724604, 0.001))
+
+		p := ApparentPosition(Venus, venusT)
+		Expect(p.RA).To(BeNumerically("~", 316.17272, 0.05))
+		Expect(p.Dec).To(BeNumerically("~", -18.88801, 0.05))
+	})
+
+	It("should compute the phase angle and magnitude of Venus (Meeus 41.a)", func() {
+		g := Observe(Venus, venusT)
+		Expect(g.PhaseAngle()).To(BeNumerically("~", 72.96, 0.1))
+		Expect(g.Magnitude()).To(BeNumerically("~", -4.2, 0.05))
+	})
+
+	It("should match Meeus example 45.a for Saturn's rings (1992 December 16)", func() {
+		b, deltaU := Observe(Saturn, julian.Centuries(2448972.5)).ringTilt()
+		Expect(b).To(BeNumerically("~", 16.442, 0.1))
+		Expect(deltaU).To(BeNumerically("~", 4.198, 0.2))
+	})
+
+	DescribeTable("Magnitude at opposition",
+		func(p Planet, date time.Time, expected float64) {
+			Expect(Magnitude(p, julian.Centuries(julian.FromTime(date)))).To(BeNumerically("~", expected, 0.1))
+		},
+		Entry("Mars, 2003 perihelic opposition", Mars, time.Date(2003, 8, 28, 0, 0, 0, 0, time.UTC), -2.9),
+		Entry("Jupiter, 2022", Jupiter, time.Date(2022, 9, 26, 0, 0, 0, 0, time.UTC), -2.9),
+		Entry("Neptune, 2020", Neptune, time.Date(2020, 9, 11, 0, 0, 0, 0, time.UTC), 7.8),
+	)
+})
+
+var _ = Describe("Reference positions", func() {
+	for _, v := range reference.Positions("Venus") {
+		It("should match "+v.Source+" to the accuracy of the mean elements", func() {
+			p := ApparentPosition(Venus, v.Centuries())
+			Expect(p.RA).To(BeNumerically("~", v.RA, 0.05))
+			Expect(p.Dec).To(BeNumerically("~", v.Dec, 0.05))
+			Expect(Observe(Venus, v.Centuries()).Distance()).To(BeNumerically("~", v.Distance, 0.001))
+		})
+	}
+})
@@ -0,0 +1,95 @@
+package planets
+
+import (
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/internal/timescale"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/vectors"
+	"math"
+	"time"
+)
+
+// Scale maps heliocentric distances onto a drawing, compressing the outer solar system
+type Scale int
+
+const (
+	Linear      Scale = iota // radius in AU
+	SquareRoot               // square root of the radius in AU
+	Logarithmic              // log10(1 + radius in AU)
+)
+
+// String returns the name of the scale
+func (s Scale) String() string {
+	return [...]string{"Linear", "SquareRoot", "Logarithmic"}[s]
+}
+
+// apply maps a distance in AU onto the scale
+func (s Scale) apply(r float64) float64 {
+	switch s {
+	case SquareRoot:
+		return math.Sqrt(r)
+	case Logarithmic:
+		return math.Log10(1 + r)
+	}
+	return r
+}
+
+// Body is one planet of an orrery, with positions in AU in J2000 ecliptic axes
+type Body struct {
+	Planet    Planet
+	Position  vectors.Vector3D // heliocentric position in AU
+	Distance  float64          // distance from the Sun in AU
+	Longitude float64          // heliocentric ecliptic longitude in degrees
+	Latitude  float64          // heliocentric ecliptic latitude in degrees
+}
+
+// Project returns the body's position on the ecliptic plane with its distance from the Sun
+// mapped onto a scale, keeping its longitude; X points to the J2000 equinox
+func (b Body) Project(scale Scale) vectors.Vector2D {
+	r := scale.apply(b.Distance)
+	sin, cos := math.Sincos(b.Longitude * constants.Rad)
+	return vectors.Vector2D{X: r * cos, Y: r * sin}
+}
+
+// orreryOptions holds the settings of an orrery
+type orreryOptions struct {
+	dwarfPlanets bool
+}
+
+// OrreryOption configures Orrery
+type OrreryOption func(*orreryOptions)
+
+// WithDwarfPlanets adds Pluto to the eight planets
+func WithDwarfPlanets() OrreryOption {
+	return func(o *orreryOptions) { o.dwarfPlanets = true }
+}
+
+// Orrery returns the heliocentric positions of the planets from Mercury to Neptune at t, in
+// order from the Sun. Positions come from the mean elements, good to a few arcminutes for
+// 1800-2050, evaluated at t converted to TT.
+func Orrery(t time.Time, opts ...OrreryOption) []Body {
+	var o orreryOptions
+	for _, apply := range opts {
+		apply(&o)
+	}
+	last := Neptune
+	if o.dwarfPlanets {
+		last = Pluto
+	}
+
+	centuries := julian.Centuries(timescale.TT(t))
+	bodies := make([]Body, 0, last+1)
+	for p := Mercury; p <= last; p++ {
+		position := Heliocentric(p, centuries)
+		distance := position.Magnitude()
+		bodies = append(bodies, Body{
+			Planet:    p,
+			Position:  position,
+			Distance:  distance,
+			Longitude: angles.NormalizeDegrees(math.Atan2(position.Y, position.X) * constants.Deg),
+			Latitude:  math.Asin(position.Z/distance) * constants.Deg,
+		})
+	}
+	return bodies
+}
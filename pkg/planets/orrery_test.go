@@ -0,0 +1,57 @@
+package planets
+
+import (
+	"math"
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/internal/timescale"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Orrery", func() {
+	date := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	It("should list the eight planets in order from the Sun", func() {
+		bodies := Orrery(date)
+		Expect(bodies).To(HaveLen(8))
+		for i, b := range bodies {
+			Expect(b.Planet).To(Equal(Planet(i)))
+			Expect(b.Distance).To(BeNumerically("~", b.Position.Magnitude(), 1e-12))
+			Expect(b.Longitude).To(BeNumerically("~", HeliocentricLongitude(b.Planet, julian.Centuries(timescale.TT(date))), 1e-9))
+			Expect(math.Abs(b.Latitude)).To(BeNumerically("<", 7.1))
+		}
+	})
+
+	It("should add Pluto with WithDwarfPlanets", func() {
+		bodies := Orrery(date, WithDwarfPlanets())
+		Expect(bodies).To(HaveLen(9))
+		Expect(bodies[8].Planet).To(Equal(Pluto))
+		Expect(bodies[8].Planet.String()).To(Equal("Pluto"))
+	})
+
+	It("should put Pluto near its 1989 perihelion distance", func() {
+		pluto := Orrery(time.Date(1989, 9, 5, 0, 0, 0, 0, time.UTC), WithDwarfPlanets())[8]
+		Expect(pluto.Distance).To(BeNumerically("~", 29.66, 0.05))
+		Expect(Pluto.SiderealPeriod()).To(BeNumerically("~", 90560, 100))
+	})
+
+	DescribeTable("projects onto the ecliptic plane",
+		func(scale Scale, radius func(float64) float64) {
+			earth := Orrery(date)[Earth]
+			p := earth.Project(scale)
+			Expect(p.Magnitude()).To(BeNumerically("~", radius(earth.Distance), 1e-12))
+			Expect(math.Atan2(p.Y, p.X)).To(BeNumerically("~", math.Remainder(earth.Longitude, 360)*math.Pi/180, 1e-12))
+		},
+		Entry("linearly", Linear, func(r float64) float64 { return r }),
+		Entry("by square root", SquareRoot, math.Sqrt),
+		Entry("logarithmically", Logarithmic, func(r float64) float64 { return math.Log10(1 + r) }),
+	)
+
+	It("should compress Neptune's orbit on a logarithmic scale", func() {
+		neptune := Orrery(date)[Neptune].Project(Logarithmic)
+		Expect(neptune.Magnitude()).To(BeNumerically("<", 1.5))
+		Expect(Logarithmic.String()).To(Equal("Logarithmic"))
+	})
+})
@@ -0,0 +1,139 @@
+package planets
+
+import (
+	"github.com/ocrosby/astronomy/pkg/accuracy"
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/orbits"
+	"github.com/ocrosby/astronomy/pkg/vectors"
+	"math"
+)
+
+// Planet identifies a major planet
+type Planet int
+
+const (
+	Mercury Planet = iota
+	Venus
+	Earth // the Earth-Moon barycenter
+	Mars
+	Jupiter
+	Saturn
+	Uranus
+	Neptune
+	Pluto // dwarf planet
+)
+
+// String returns the name of the planet
+func (p Planet) String() string {
+	return [...]string{"Mercury", "Venus", "Earth", "Mars", "Jupiter", "Saturn", "Uranus", "Neptune", "Pluto"}[p]
+}
+
+// Elements are osculating Keplerian elements referred to the J2000 ecliptic and equinox
+type Elements struct {
+	SemiMajorAxis float64 // AU
+	Eccentricity  float64
+	Inclination   float64 // degrees
+	MeanLongitude float64 // degrees
+	Perihelion    float64 // longitude of perihelion, degrees
+	Node          float64 // longitude of the ascending node, degrees
+}
+
+// meanElements holds J2000 values and rates per Julian century of the approximate elements
+// of Standish (JPL), valid 1800-2050 to a few arcminutes for the inner planets
+type meanElements struct {
+	at2000, rate Elements
+}
+
+var elements = [...]meanElements{
+	Mercury: {
+		Elements{0.38709927, 0.20563593, 7.00497902, 252.25032350, 77.45779628, 48.33076593},
+		Elements{0.00000037, 0.00001906, -0.00594749, 149472.67411175, 0.16047689, -0.12534081},
+	},
+	Venus: {
+		Elements{0.72333566, 0.00677672, 3.39467605, 181.97909950, 131.60246718, 76.67984255},
+		Elements{0.00000390, -0.00004107, -0.00078890, 58517.81538729, 0.00268329, -0.27769418},
+	},
+	Earth: {
+		Elements{1.00000261, 0.01671123, -0.00001531, 100.46457166, 102.93768193, 0.0},
+		Elements{0.00000562, -0.00004392, -0.01294668, 35999.37244981, 0.32327364, 0.0},
+	},
+	Mars: {
+		Elements{1.52371034, 0.09339410, 1.84969142, -4.55343205, -23.94362959, 49.55953891},
+		Elements{0.00001847, 0.00007882, -0.00813131, 19140.30268499, 0.44441088, -0.29257343},
+	},
+	Jupiter: {
+		Elements{5.20288700, 0.04838624, 1.30439695, 34.39644051, 14.72847983, 100.47390909},
+		Elements{-0.00011607, -0.00013253, -0.00183714, 3034.74612775, 0.21252668, 0.20469106},
+	},
+	Saturn: {
+		Elements{9.53667594, 0.05386179, 2.48599187, 49.95424423, 92.59887831, 113.66242448},
+		Elements{-0.00125060, -0.00050991, 0.00193609, 1222.49362201, -0.41897216, -0.28867794},
+	},
+	Uranus: {
+		Elements{19.18916464, 0.04725744, 0.77263783, 313.23810451, 170.95427630, 74.01692503},
+		Elements{-0.00196176, -0.00004397, -0.00242939, 428.48202785, 0.40805281, 0.04240589},
+	},
+	Neptune: {
+		Elements{30.06992276, 0.00859048, 1.77004347, -55.12002969, 44.96476227, 131.78422574},
+		Elements{0.00026291, 0.00005105, 0.00035372, 218.45945325, -0.32241464, -0.00508664},
+	},
+	Pluto: {
+		Elements{39.48211675, 0.24882730, 17.14001206, 238.92903833, 224.06891629, 110.30393684},
+		Elements{-0.00031596, 0.00005170, 0.00004818, 145.20780515, -0.04062942, -0.01183482},
+	},
+}
+
+// ElementsAt returns the planet's elements for Julian centuries t since J2000.0 (TT)
+func (p Planet) ElementsAt(t float64) Elements {
+	e := elements[p]
+	return Elements{
+		SemiMajorAxis: e.at2000.SemiMajorAxis + e.rate.SemiMajorAxis*t,
+		Eccentricity:  e.at2000.Eccentricity + e.rate.Eccentricity*t,
+		Inclination:   e.at2000.Inclination + e.rate.Inclination*t,
+		MeanLongitude: angles.NormalizeDegrees(e.at2000.MeanLongitude + e.rate.MeanLongitude*t),
+		Perihelion:    angles.NormalizeDegrees(e.at2000.Perihelion + e.rate.Perihelion*t),
+		Node:          angles.NormalizeDegrees(e.at2000.Node + e.rate.Node*t),
+	}
+}
+
+// SiderealPeriod returns the planet's mean sidereal period in days
+func (p Planet) SiderealPeriod() float64 {
+	return 360 * 36525 / elements[p].rate.MeanLongitude
+}
+
+// heliocentricErrors are Standish's maximum errors in heliocentric longitude over 1800-2050 in
+// arcseconds (Table 1 of "Keplerian Elements for Approximate Positions of the Major Planets")
+var heliocentricErrors = [...]float64{
+	Mercury: 15, Venus: 20, Earth: 20, Mars: 40, Jupiter: 400, Saturn: 600, Uranus: 50, Neptune: 10, Pluto: 5,
+}
+
+// Accuracy returns the expected error of the planet's heliocentric direction from the mean
+// elements; geocentric directions of the nearer planets are worse by up to the ratio of the
+// planet's distances from the Sun and the Earth
+func (p Planet) Accuracy() accuracy.Accuracy {
+	return accuracy.Accuracy{Name: p.String() + " mean elements", Angle: heliocentricErrors[p],
+		FirstYear: 1800, LastYear: 2050, Source: "Standish (JPL), approximate Keplerian elements"}
+}
+
+// Heliocentric returns the planet's heliocentric position in AU in J2000 ecliptic axes for
+// Julian centuries t since J2000.0
+func Heliocentric(p Planet, t float64) vectors.Vector3D {
+	e := p.ElementsAt(t)
+	argPerihelion := (e.Perihelion - e.Node) * constants.Rad
+	meanAnomaly := (e.MeanLongitude - e.Perihelion) * constants.Rad
+
+	anomaly := orbits.SolveKepler(meanAnomaly, e.Eccentricity)
+	x := e.SemiMajorAxis * (math.Cos(anomaly) - e.Eccentricity)
+	y := e.SemiMajorAxis * math.Sqrt(1-e.Eccentricity*e.Eccentricity) * math.Sin(anomaly)
+
+	position := vectors.Rotate3Dz(vectors.Vector3D{X: x, Y: y}, argPerihelion)
+	position = vectors.Rotate3Dx(position, e.Inclination*constants.Rad)
+	return vectors.Rotate3Dz(position, e.Node*constants.Rad)
+}
+
+// HeliocentricLongitude returns the planet's heliocentric ecliptic longitude in degrees
+func HeliocentricLongitude(p Planet, t float64) float64 {
+	v := Heliocentric(p, t)
+	return angles.NormalizeDegrees(math.Atan2(v.Y, v.X) * constants.Deg)
+}
@@ -0,0 +1,13 @@
+package planets_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPlanets(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Planets Suite")
+}
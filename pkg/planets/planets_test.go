@@ -0,0 +1,46 @@
+package planets
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Planets", func() {
+	It("should place the planets at their mean distances", func() {
+		Expect(Heliocentric(Earth, 0).Magnitude()).To(BeNumerically("~", 0.983, 0.001))
+		Expect(Heliocentric(Jupiter, 0.25).Magnitude()).To(BeNumerically(">", 4.95))
+		Expect(Heliocentric(Jupiter, 0.25).Magnitude()).To(BeNumerically("<", 5.46))
+	})
+
+	It("should report the accuracy of the mean elements", func() {
+		Expect(Saturn.Accuracy().Angle).To(Equal(600.0))
+		Expect(Venus.Accuracy().Within(30, 2024)).To(BeTrue())
+		Expect(Venus.Accuracy().Covers(2100)).To(BeFalse())
+		Expect(Pluto.Accuracy().Name).To(Equal("Pluto mean elements"))
+	})
+
+	It("should match Meeus example 33.a for Venus (1992 December 20)", func() {
+		// heliocentric longitude 26.11428 deg (mean equinox of date), referred back to J2000
+		t := -0.070321697
+		Expect(HeliocentricLongitude(Venus, t)).To(BeNumerically("~", 26.11428+0.01397*7.0321697, 0.05))
+	})
+
+	It("should put the Earth opposite the Sun's longitude", func() {
+		// Sun's J2000 geometric longitude at 2000 January 1.5 is 280.37
+		Expect(HeliocentricLongitude(Earth, 0)).To(BeNumerically("~", 100.38, 0.02))
+	})
+
+	DescribeTable("SiderealPeriod",
+		func(p Planet, expected float64) {
+			Expect(p.SiderealPeriod()).To(BeNumerically("~", expected, expected*1e-3))
+		},
+		Entry("Mercury", Mercury, 87.969),
+		Entry("Earth", Earth, 365.256),
+		Entry("Mars", Mars, 686.98),
+		Entry("Neptune", Neptune, 60190.0),
+	)
+
+	It("should name planets", func() {
+		Expect(Saturn.String()).To(Equal("Saturn"))
+	})
+})
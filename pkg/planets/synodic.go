@@ -0,0 +1,45 @@
+package planets
+
+import (
+	"github.com/ocrosby/astronomy/pkg/events"
+	"github.com/ocrosby/astronomy/pkg/internal/timescale"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/orbits"
+	"time"
+)
+
+// synodicSamples is the number of samples per synodic period used to bracket events
+const synodicSamples = 16
+
+// SynodicEvents returns the heliocentric conjunctions (equal heliocentric longitudes) and
+// oppositions (longitudes 180° apart) of two planets between start and end in chronological
+// order. Times are accurate to a few hours, limited by the mean orbital elements.
+func SynodicEvents(a, b Planet, start, end time.Time) []events.Event {
+	if a == b {
+		return nil
+	}
+	difference := func(jd float64) float64 {
+		t := julian.Centuries(timescale.TTFromJD(jd))
+		return HeliocentricLongitude(a, t) - HeliocentricLongitude(b, t)
+	}
+	step := orbits.SynodicPeriod(a.SiderealPeriod(), b.SiderealPeriod()) / synodicSamples
+	from, to := julian.FromTime(start), julian.FromTime(end)
+
+	var found []events.Event
+	for _, target := range []struct {
+		kind  events.Kind
+		angle float64
+	}{{events.Conjunction, 0}, {events.Opposition, 180}} {
+		for _, c := range events.FindAngleCrossings(difference, target.angle, from, to, step) {
+			found = append(found, events.Event{
+				Kind:  target.kind,
+				Time:  julian.ToTime(c.JD),
+				Body:  a.String(),
+				Other: b.String(),
+				Value: HeliocentricLongitude(a, julian.Centuries(timescale.TTFromJD(c.JD))),
+			})
+		}
+	}
+	events.Sort(found)
+	return found
+}
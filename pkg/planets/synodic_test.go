@@ -0,0 +1,57 @@
+package planets
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/events"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SynodicEvents", func() {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	It("should find Earth-Mars heliocentric conjunctions near the oppositions seen from Earth", func() {
+		found := SynodicEvents(Earth, Mars, date(2020, 1, 1), date(2025, 6, 1))
+		var conjunctions []time.Time
+		for _, e := range found {
+			if e.Kind == events.Conjunction {
+				conjunctions = append(conjunctions, e.Time)
+			}
+		}
+		Expect(conjunctions).To(HaveLen(3))
+		Expect(conjunctions[0]).To(BeTemporally("~", date(2020, 10, 13), 24*time.Hour))
+		Expect(conjunctions[1]).To(BeTemporally("~", date(2022, 12, 8), 24*time.Hour))
+		Expect(conjunctions[2]).To(BeTemporally("~", date(2025, 1, 16), 24*time.Hour))
+	})
+
+	It("should alternate conjunctions and oppositions in order", func() {
+		found := SynodicEvents(Venus, Earth, date(2020, 1, 1), date(2024, 1, 1))
+		Expect(len(found)).To(BeNumerically(">=", 4))
+		for i := 1; i < len(found); i++ {
+			Expect(found[i].Time.After(found[i-1].Time)).To(BeTrue())
+			Expect(found[i].Kind).NotTo(Equal(found[i-1].Kind))
+		}
+		Expect(found[0].Body).To(Equal("Venus"))
+		Expect(found[0].Other).To(Equal("Earth"))
+	})
+
+	It("should find the Venus inferior conjunction of 2022 January 9", func() {
+		found := SynodicEvents(Venus, Earth, date(2021, 12, 1), date(2022, 2, 1))
+		Expect(found).To(HaveLen(1))
+		Expect(found[0].Kind).To(Equal(events.Conjunction))
+		Expect(found[0].Time).To(BeTemporally("~", date(2022, 1, 9), 24*time.Hour))
+	})
+
+	It("should find the Jupiter opposition of 2023 November 3", func() {
+		found := SynodicEvents(Earth, Jupiter, date(2023, 10, 1), date(2023, 12, 1))
+		Expect(found).To(HaveLen(1))
+		Expect(found[0].Time).To(BeTemporally("~", date(2023, 11, 3), 24*time.Hour))
+	})
+
+	It("should return nothing for the same planet", func() {
+		Expect(SynodicEvents(Mars, Mars, date(2020, 1, 1), date(2030, 1, 1))).To(BeEmpty())
+	})
+})
@@ -0,0 +1,55 @@
+package planner
+
+import (
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/internal/timescale"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/lunar"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"math"
+	"time"
+)
+
+// Constraints describe what counts as a dark sky
+type Constraints struct {
+	SunAltitude     float64       // the Sun must be below this altitude in degrees
+	MoonAltitude    float64       // the Moon's geocentric centre must be below this altitude in degrees...
+	MaxIllumination float64       // ...unless its illuminated fraction is at most this
+	MinimumDuration time.Duration // shorter windows are dropped
+}
+
+// DefaultConstraints requires astronomical darkness with the Moon's centre below the horizon,
+// whatever its phase
+func DefaultConstraints() Constraints {
+	return Constraints{SunAltitude: AstronomicalTwilight}
+}
+
+// DarkWindows returns the intervals between start and end of darkness free of moonlight under
+// constraints, in chronological order and in the location of start. Twilight and the Moon are
+// each searched once over the whole range, so months cost little more than a night.
+func DarkWindows(obs observer.Observer, start, end time.Time, constraints Constraints) []Interval {
+	from, to := julian.FromTime(start), julian.FromTime(end)
+	moon := altitude(func(jd float64) coordinates.Equatorial {
+		return lunar.ApparentPosition(julian.Centuries(timescale.TTFromJD(jd)))
+	}, obs)
+	moonless := func(jd float64) float64 {
+		return math.Max(constraints.MoonAltitude-moon(jd), constraints.MaxIllumination-MoonIllumination(timescale.TTFromJD(jd)))
+	}
+
+	var windows []Interval
+	for _, s := range intersect(darkness(obs, constraints.SunAltitude, from, to), positive(moonless, from, to)) {
+		i := Interval{Start: julian.ToTime(s.start).In(start.Location()), End: julian.ToTime(s.end).In(start.Location())}
+		if i.Duration() >= constraints.MinimumDuration {
+			windows = append(windows, i)
+		}
+	}
+	return windows
+}
+
+// MoonIllumination returns the illuminated fraction of the Moon's disk at a Julian date in TT,
+// such as astrotime.TT gives, from its elongation in longitude (the latitude changes it by under
+// 0.01)
+func MoonIllumination(jdTT float64) float64 {
+	return (1 - math.Cos(lunar.PhaseLongitude(julian.Centuries(jdTT))*constants.Rad)) / 2
+}
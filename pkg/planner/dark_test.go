@@ -0,0 +1,49 @@
+package planner
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DarkWindows", func() {
+	obs := observer.NewObserver(31.96, -111.6)
+	utc := time.UTC
+
+	It("should give whole nights around new moon", func() {
+		// New moon 2024-07-05
+		windows := DarkWindows(obs, time.Date(2024, 7, 4, 12, 0, 0, 0, utc), time.Date(2024, 7, 6, 12, 0, 0, 0, utc), DefaultConstraints())
+		Expect(windows).To(HaveLen(2))
+		for _, w := range windows {
+			Expect(w.Duration()).To(BeNumerically("~", 6*time.Hour+40*time.Minute, 30*time.Minute))
+		}
+	})
+
+	It("should find no dark sky at full moon unless bright moonlight is allowed", func() {
+		// Full moon 2024-07-21
+		start, end := time.Date(2024, 7, 21, 12, 0, 0, 0, utc), time.Date(2024, 7, 22, 12, 0, 0, 0, utc)
+		Expect(DarkWindows(obs, start, end, DefaultConstraints())).To(BeEmpty())
+		lenient := DefaultConstraints()
+		lenient.MaxIllumination = 1
+		Expect(DarkWindows(obs, start, end, lenient)).To(HaveLen(1))
+	})
+
+	It("should trim the night by the Moon and drop short windows", func() {
+		// First quarter 2024-07-13: the Moon sets around local midnight
+		start, end := time.Date(2024, 7, 13, 12, 0, 0, 0, utc), time.Date(2024, 7, 14, 12, 0, 0, 0, utc)
+		windows := DarkWindows(obs, start, end, DefaultConstraints())
+		Expect(windows).To(HaveLen(1))
+		Expect(windows[0].Duration()).To(BeNumerically("<", 6*time.Hour))
+		strict := DefaultConstraints()
+		strict.MinimumDuration = 6 * time.Hour
+		Expect(DarkWindows(obs, start, end, strict)).To(BeEmpty())
+	})
+
+	It("should compute the illuminated fraction", func() {
+		Expect(MoonIllumination(astrotime.TT(time.Date(2024, 7, 21, 10, 17, 0, 0, utc)))).To(BeNumerically(">", 0.999))
+		Expect(MoonIllumination(astrotime.TT(time.Date(2024, 7, 13, 22, 49, 0, 0, utc)))).To(BeNumerically("~", 0.5, 0.01))
+	})
+})
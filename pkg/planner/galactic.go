@@ -0,0 +1,23 @@
+package planner
+
+import (
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/ephem"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"time"
+)
+
+// GalacticCenter is the J2000 position of Sagittarius A*
+var GalacticCenter = coordinates.Equatorial{RA: 266.41683, Dec: -29.00781, Epoch: coordinates.J2000}
+
+// GalacticCenterVisibility returns the windows between start and end in which the centre of the
+// Milky Way is above the minimum altitude while the Sun is below the darkness limit, in
+// chronological order. Altitudes are geometric.
+func GalacticCenterVisibility(obs observer.Observer, start, end time.Time, opts ...VisibilityOption) []Window {
+	mid := julian.Centuries(astrotime.TT(start.Add(end.Sub(start) / 2)))
+	center := ephem.Fixed(coordinates.Precess(GalacticCenter, 0, mid))
+	windows, _ := Visibility(center, obs, start, end, opts...) // a fixed position cannot fail
+	return windows
+}
@@ -0,0 +1,49 @@
+package planner
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/observer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GalacticCenterVisibility", func() {
+	// Kitt Peak, Arizona
+	obs := observer.NewObserver(31.96, -111.6)
+	utc := time.UTC
+
+	It("should find the summer core in the middle of the night", func() {
+		windows := GalacticCenterVisibility(obs, time.Date(2024, 7, 1, 12, 0, 0, 0, utc), time.Date(2024, 7, 8, 12, 0, 0, 0, utc))
+		Expect(windows).To(HaveLen(7))
+		for _, w := range windows {
+			Expect(w.Duration()).To(BeNumerically(">", 4*time.Hour))
+			Expect(w.PeakAltitude).To(BeNumerically("~", 90-31.96-29.0, 0.2))
+			Expect(w.PeakTime).To(BeTemporally(">=", w.Start))
+			Expect(w.PeakTime).To(BeTemporally("<=", w.End))
+		}
+		Expect(windows[0].Night).To(Equal(time.Date(2024, 7, 1, 0, 0, 0, 0, utc)))
+	})
+
+	It("should find nothing when the centre is up only by day", func() {
+		Expect(GalacticCenterVisibility(obs, time.Date(2024, 12, 1, 0, 0, 0, 0, utc), time.Date(2024, 12, 8, 0, 0, 0, 0, utc))).To(BeEmpty())
+	})
+
+	It("should shorten the windows for a higher altitude or a darker sky", func() {
+		start, end := time.Date(2024, 4, 10, 18, 0, 0, 0, utc), time.Date(2024, 4, 11, 18, 0, 0, 0, utc)
+		wide := GalacticCenterVisibility(obs, start, end, WithMinimumAltitude(0), WithSunAltitude(NauticalTwilight))
+		narrow := GalacticCenterVisibility(obs, start, end, WithMinimumAltitude(20))
+		Expect(wide).To(HaveLen(1))
+		Expect(narrow).To(HaveLen(1))
+		Expect(narrow[0].Duration()).To(BeNumerically("<", wide[0].Duration()))
+		Expect(narrow[0].Start).To(BeTemporally(">", wide[0].Start))
+		Expect(narrow[0].End).To(BeTemporally("<", wide[0].End))
+	})
+
+	It("should intersect span lists", func() {
+		a := []span{{0, 2}, {4, 6}}
+		b := []span{{1, 5}}
+		Expect(intersect(a, b)).To(Equal([]span{{1, 2}, {4, 5}}))
+		Expect(positive(func(jd float64) float64 { return 1 }, 0, 1)).To(Equal([]span{{0, 1}}))
+	})
+})
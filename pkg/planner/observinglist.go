@@ -0,0 +1,37 @@
+package planner
+
+import (
+	"github.com/ocrosby/astronomy/pkg/catalog"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/ephem"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"sort"
+	"time"
+)
+
+// ObservingList returns the targets that have a window of visibility between start and end as
+// an observing list, ordered by the start of their first window, to export to planetarium
+// programs. The J2000 positions of the entries are precessed to the middle of the range.
+func ObservingList(name string, targets []catalog.ListEntry, obs observer.Observer, start, end time.Time, opts ...VisibilityOption) catalog.ObservingList {
+	mid := julian.Centuries((julian.FromTime(start) + julian.FromTime(end)) / 2)
+	type planned struct {
+		entry catalog.ListEntry
+		first time.Time
+	}
+	var found []planned
+	for _, target := range targets {
+		position := coordinates.Precess(coordinates.Equatorial{RA: target.RA, Dec: target.Dec, Epoch: coordinates.J2000}, 0, mid)
+		windows, _ := Visibility(ephem.Fixed(position), obs, start, end, opts...) // a fixed position cannot fail
+		if len(windows) > 0 {
+			found = append(found, planned{entry: target, first: windows[0].Start})
+		}
+	}
+	sort.SliceStable(found, func(i, j int) bool { return found[i].first.Before(found[j].first) })
+
+	list := catalog.ObservingList{Name: name, Entries: make([]catalog.ListEntry, len(found))}
+	for i, p := range found {
+		list.Entries[i] = p.entry
+	}
+	return list
+}
@@ -0,0 +1,28 @@
+package planner
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/catalog"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ObservingList", func() {
+	obs := observer.NewObserver(31.96, -111.6)
+	start, end := time.Date(2024, 9, 1, 20, 0, 0, 0, time.UTC), time.Date(2024, 9, 2, 12, 0, 0, 0, time.UTC)
+
+	It("should list the targets that are up in darkness in the order they become observable", func() {
+		m31, _ := catalog.MessierObject(31)
+		m22, _ := catalog.MessierObject(22)
+		polarisAustralis := catalog.Star{ID: "HR 7228", Name: "Polaris Australis", RA: 317.19, Dec: -88.96, Magnitude: 5.47}
+		list := ObservingList("September", []catalog.ListEntry{m31.ListEntry(), polarisAustralis.ListEntry(), m22.ListEntry()},
+			obs, start, end, WithMinimumAltitude(30))
+		Expect(list.Name).To(Equal("September"))
+		Expect(list.Entries).To(HaveLen(2))
+		// M22 is high in the south at dusk; Andromeda climbs above 30° later in the evening
+		Expect(list.Entries[0].Designations[0]).To(Equal("M22"))
+		Expect(list.Entries[1].Designations[0]).To(Equal("M31"))
+	})
+})
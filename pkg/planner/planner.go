@@ -0,0 +1,102 @@
+// Package planner answers observing-session questions over ranges of dates: when targets are
+// up during darkness and when the sky is free of moonlight.
+package planner
+
+import (
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/events"
+	"github.com/ocrosby/astronomy/pkg/internal/timescale"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	"github.com/ocrosby/astronomy/pkg/solar"
+	"time"
+)
+
+// Altitudes of the Sun's centre in degrees at which the twilights end
+const (
+	CivilTwilight        = -6.0
+	NauticalTwilight     = -12.0
+	AstronomicalTwilight = -18.0
+)
+
+// searchStep is the sampling interval in days, short against the quickest change of state
+// searched for (a target skimming its altitude limit for under half an hour is missed)
+const searchStep = 1.0 / 96
+
+// Interval is a span of time during which a condition holds
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Duration returns the length of the interval
+func (i Interval) Duration() time.Duration {
+	return i.End.Sub(i.Start)
+}
+
+// span is an interval in Julian dates
+type span struct {
+	start, end float64
+}
+
+// positive returns the spans of [start, end] in which f is positive
+func positive(f events.Func, start, end float64) []span {
+	var spans []span
+	inside, from := f(start) > 0, start
+	for _, c := range events.FindCrossings(f, start, end, searchStep) {
+		if c.Rising && !inside {
+			inside, from = true, c.JD
+		} else if !c.Rising && inside {
+			inside = false
+			if c.JD > from {
+				spans = append(spans, span{from, c.JD})
+			}
+		}
+	}
+	if inside && end > from {
+		spans = append(spans, span{from, end})
+	}
+	return spans
+}
+
+// intersect returns the spans common to two sorted span lists
+func intersect(a, b []span) []span {
+	var spans []span
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		start, end := max(a[i].start, b[j].start), min(a[i].end, b[j].end)
+		if end > start {
+			spans = append(spans, span{start, end})
+		}
+		if a[i].end < b[j].end {
+			i++
+		} else {
+			j++
+		}
+	}
+	return spans
+}
+
+// altitude returns the altitude in degrees of a body seen by obs, ignoring refraction
+func altitude(position func(jd float64) coordinates.Equatorial, obs observer.Observer) events.Func {
+	return func(jd float64) float64 {
+		return position(jd).ToHorizontal(obs.Latitude, sidereal.LocalMeanSiderealTime(jd, obs.Longitude)).Altitude
+	}
+}
+
+// sunPosition returns the Sun's apparent position at a Julian date in UT
+func sunPosition(jd float64) coordinates.Equatorial {
+	return solar.ApparentPosition(julian.Centuries(timescale.TTFromJD(jd)))
+}
+
+// darkness returns the spans of [start, end] with the Sun below sunAltitude
+func darkness(obs observer.Observer, sunAltitude, start, end float64) []span {
+	sun := altitude(sunPosition, obs)
+	return positive(func(jd float64) float64 { return sunAltitude - sun(jd) }, start, end)
+}
+
+// nightOf returns the calendar date in loc of the evening that begins the night containing t
+func nightOf(t time.Time, loc *time.Location) time.Time {
+	local := t.In(loc).Add(-12 * time.Hour)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+}
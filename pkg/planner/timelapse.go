@@ -0,0 +1,75 @@
+package planner
+
+import (
+	"fmt"
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/lunar"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	"github.com/ocrosby/astronomy/pkg/solar"
+	"math"
+	"time"
+)
+
+// Schedule is an intervalometer program: Count exposures Interval apart, the first at Start
+type Schedule struct {
+	Start    time.Time
+	Interval time.Duration
+	Count    int
+}
+
+// Times returns the start of each exposure
+func (s Schedule) Times() []time.Time {
+	times := make([]time.Time, max(s.Count, 0))
+	for i := range times {
+		times[i] = s.Start.Add(time.Duration(i) * s.Interval)
+	}
+	return times
+}
+
+// Frame is the Sun and Moon as the observer sees them at the start of one exposure
+type Frame struct {
+	Time             time.Time
+	Sun              coordinates.Horizontal // refracted
+	Moon             coordinates.Horizontal // refracted and corrected for diurnal parallax
+	MoonIllumination float64                // illuminated fraction of the disk
+	MoonPhase        float64                // lunar.PhaseLongitude: 0 new, 90 first quarter, 180 full, 270 last quarter
+}
+
+// Timelapse returns the Sun and Moon at every exposure of a schedule, for annotating the
+// frames of a timelapse. The theories are evaluated in TT, and the Moon comes from the batch
+// lunar.Positions, so long sequences share the nutation between neighbouring frames.
+func Timelapse(obs observer.Observer, schedule Schedule) ([]Frame, error) {
+	if schedule.Count < 0 || (schedule.Count > 1 && schedule.Interval <= 0) {
+		return nil, fmt.Errorf("invalid schedule of %d exposures every %v", schedule.Count, schedule.Interval)
+	}
+
+	times := schedule.Times()
+	moons := lunar.Positions(times)
+	frames := make([]Frame, len(times))
+	for i, t := range times {
+		tt := astrotime.TT(t)
+		c := julian.Centuries(tt)
+		lst := sidereal.LocalApparentSiderealTime(julian.FromTime(t), obs.Longitude)
+		frames[i] = Frame{
+			Time:             t,
+			Sun:              apparentHorizontal(obs, solar.ApparentPosition(c), lst, 0),
+			Moon:             apparentHorizontal(obs, moons[i], lst, lunar.Parallax(c)),
+			MoonIllumination: MoonIllumination(tt),
+			MoonPhase:        lunar.PhaseLongitude(c),
+		}
+	}
+	return frames, nil
+}
+
+// apparentHorizontal returns the refracted horizontal position of an apparent place at a
+// local sidereal time in degrees, lowered by a horizontal parallax in degrees
+func apparentHorizontal(obs observer.Observer, position coordinates.Equatorial, lst, parallax float64) coordinates.Horizontal {
+	h := position.ToHorizontal(obs.Latitude, lst)
+	h.Altitude -= parallax * math.Cos(h.Altitude*constants.Rad)
+	h.Altitude += obs.RefractionAt(h.Altitude)
+	return h
+}
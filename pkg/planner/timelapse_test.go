@@ -0,0 +1,62 @@
+package planner
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/lunar"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Timelapse", func() {
+	obs := observer.NewObserver(31.96, -111.6)
+	utc := time.UTC
+
+	It("should list the exposure times", func() {
+		start := time.Date(2024, 7, 21, 3, 0, 0, 0, utc)
+		times := Schedule{Start: start, Interval: 30 * time.Second, Count: 3}.Times()
+		Expect(times).To(Equal([]time.Time{start, start.Add(30 * time.Second), start.Add(time.Minute)}))
+		Expect(Schedule{Start: start, Count: -1}.Times()).To(BeEmpty())
+	})
+
+	It("should follow the full moon rising through an evening sequence", func() {
+		// Full moon 2024-07-21 10:17 UTC; the Sun sets around 02:30 UTC in Arizona
+		schedule := Schedule{Start: time.Date(2024, 7, 21, 2, 0, 0, 0, utc), Interval: 10 * time.Minute, Count: 13}
+		frames, err := Timelapse(obs, schedule)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(frames).To(HaveLen(13))
+
+		first, last := frames[0], frames[12]
+		Expect(first.Time).To(Equal(schedule.Start))
+		Expect(last.Time).To(Equal(schedule.Start.Add(2 * time.Hour)))
+		Expect(first.Sun.Altitude).To(BeNumerically(">", 0))
+		Expect(last.Sun.Altitude).To(BeNumerically("<", -10))
+		Expect(last.Sun.Azimuth).To(BeNumerically("~", 300, 15))
+		Expect(last.Moon.Altitude).To(BeNumerically(">", first.Moon.Altitude))
+		Expect(last.Moon.Azimuth).To(BeNumerically("~", 130, 20))
+		for _, f := range frames {
+			Expect(f.MoonIllumination).To(BeNumerically(">", 0.98))
+			Expect(f.MoonPhase).To(BeNumerically("~", 175, 10))
+		}
+	})
+
+	It("should match the sky at a single exposure", func() {
+		t := time.Date(2024, 7, 13, 22, 49, 0, 0, utc)
+		frames, err := Timelapse(obs, Schedule{Start: t, Count: 1})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(frames).To(HaveLen(1))
+		Expect(frames[0].MoonIllumination).To(BeNumerically("~", 0.5, 0.01))
+		Expect(frames[0].MoonPhase).To(BeNumerically("~", 90, 1))
+		Expect(frames[0].MoonPhase).To(Equal(lunar.PhaseLongitude(julian.Centuries(astrotime.TT(t)))))
+	})
+
+	It("should reject impossible schedules", func() {
+		_, err := Timelapse(obs, Schedule{Start: time.Now(), Count: 2})
+		Expect(err).To(HaveOccurred())
+		_, err = Timelapse(obs, Schedule{Start: time.Now(), Interval: time.Second, Count: -1})
+		Expect(err).To(HaveOccurred())
+	})
+})
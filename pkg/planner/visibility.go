@@ -0,0 +1,69 @@
+package planner
+
+import (
+	"github.com/ocrosby/astronomy/pkg/ephem"
+	"github.com/ocrosby/astronomy/pkg/events"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"time"
+)
+
+// visibilityOptions holds the settings of a visibility search
+type visibilityOptions struct {
+	minimumAltitude float64
+	sunAltitude     float64
+}
+
+// VisibilityOption configures Visibility and GalacticCenterVisibility
+type VisibilityOption func(*visibilityOptions)
+
+// WithMinimumAltitude sets the altitude in degrees the target must exceed (default 10°)
+func WithMinimumAltitude(degrees float64) VisibilityOption {
+	return func(o *visibilityOptions) { o.minimumAltitude = degrees }
+}
+
+// WithSunAltitude sets the altitude of the Sun below which the sky counts as dark (default
+// AstronomicalTwilight)
+func WithSunAltitude(degrees float64) VisibilityOption {
+	return func(o *visibilityOptions) { o.sunAltitude = degrees }
+}
+
+// Window is a span of darkness during which a target stays above the minimum altitude
+type Window struct {
+	Interval
+	Night        time.Time // calendar date of the evening, in the location of the search start
+	PeakAltitude float64   // highest altitude in degrees within the window
+	PeakTime     time.Time
+}
+
+// Visibility returns the windows between start and end in which a target from any ephemeris
+// backend is above the minimum altitude while the Sun is below the darkness limit, in
+// chronological order. Altitudes are geometric; the target and the Sun are both evaluated at
+// the UTC instants converted to TT.
+func Visibility(target ephem.PositionProvider, obs observer.Observer, start, end time.Time, opts ...VisibilityOption) ([]Window, error) {
+	o := visibilityOptions{minimumAltitude: 10, sunAltitude: AstronomicalTwilight}
+	for _, apply := range opts {
+		apply(&o)
+	}
+
+	sampler := ephem.Sampler{Provider: target}
+	height := altitude(sampler.Position, obs)
+	from, to := julian.FromTime(start), julian.FromTime(end)
+	up := positive(func(jd float64) float64 { return height(jd) - o.minimumAltitude }, from, to)
+
+	var windows []Window
+	for _, s := range intersect(darkness(obs, o.sunAltitude, from, to), up) {
+		peak := events.GoldenSection(func(jd float64) float64 { return -height(jd) }, s.start, s.end)
+		w := Window{
+			Interval:     Interval{Start: julian.ToTime(s.start).In(start.Location()), End: julian.ToTime(s.end).In(start.Location())},
+			PeakAltitude: height(peak),
+			PeakTime:     julian.ToTime(peak).In(start.Location()),
+		}
+		w.Night = nightOf(w.Start, start.Location())
+		windows = append(windows, w)
+	}
+	if sampler.Err != nil {
+		return nil, sampler.Err
+	}
+	return windows, nil
+}
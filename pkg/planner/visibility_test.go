@@ -0,0 +1,34 @@
+package planner
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/ephem"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/planets"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Visibility", func() {
+	obs := observer.NewObserver(31.96, -111.6)
+	start, end := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC), time.Date(2024, 9, 3, 12, 0, 0, 0, time.UTC)
+
+	It("should plan any provider's target", func() {
+		// Saturn at opposition in September 2024 is up most of the night
+		windows, err := Visibility(ephem.Planet(planets.Saturn), obs, start, end, WithMinimumAltitude(20))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(windows).To(HaveLen(2))
+		Expect(windows[0].Duration()).To(BeNumerically(">", 5*time.Hour))
+	})
+
+	It("should report a failing backend", func() {
+		failing := ephem.ProviderFunc(func(time.Time) (coordinates.Equatorial, float64, error) {
+			return coordinates.Equatorial{}, 0, errors.New("backend offline")
+		})
+		_, err := Visibility(failing, obs, start, end)
+		Expect(err).To(MatchError("backend offline"))
+	})
+})
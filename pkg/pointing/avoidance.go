@@ -0,0 +1,76 @@
+package pointing
+
+import (
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/internal/timescale"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/lunar"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/riseset"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	"github.com/ocrosby/astronomy/pkg/solar"
+	"time"
+)
+
+// Cone is a keep-out region: a circle of Radius degrees around a possibly moving axis
+type Cone struct {
+	Name   string
+	Axis   riseset.PositionFunc
+	Radius float64
+}
+
+// Violation records a target lying inside a keep-out cone
+type Violation struct {
+	Cone       Cone
+	Separation float64 // degrees between the target and the cone axis
+}
+
+// SunCone returns a keep-out cone of the given radius around the apparent Sun
+func SunCone(radius float64) Cone {
+	return Cone{Name: "Sun", Radius: radius, Axis: func(jd float64) coordinates.Equatorial {
+		return solar.ApparentPosition(julian.Centuries(timescale.TTFromJD(jd)))
+	}}
+}
+
+// MoonCone returns a keep-out cone of the given radius around the geocentric apparent Moon
+func MoonCone(radius float64) Cone {
+	return Cone{Name: "Moon", Radius: radius, Axis: func(jd float64) coordinates.Equatorial {
+		return lunar.ApparentPosition(julian.Centuries(timescale.TTFromJD(jd)))
+	}}
+}
+
+// ZenithCone returns the blind spot of an alt-az mount, a cone of the given radius around the
+// observer's zenith where the azimuth rate becomes unmanageable
+func ZenithCone(obs observer.Observer, radius float64) Cone {
+	return Cone{Name: "Zenith", Radius: radius, Axis: func(jd float64) coordinates.Equatorial {
+		return coordinates.Equatorial{RA: sidereal.LocalMeanSiderealTime(jd, obs.Longitude), Dec: obs.Latitude}
+	}}
+}
+
+// Separation returns the angle in degrees between the target and the cone axis at t
+func (c Cone) Separation(target coordinates.Equatorial, t time.Time) float64 {
+	return coordinates.Separation(target, c.Axis(julian.FromTime(t)))
+}
+
+// Contains reports whether the target lies inside the cone at t
+func (c Cone) Contains(target coordinates.Equatorial, t time.Time) bool {
+	return c.Separation(target, t) < c.Radius
+}
+
+// CheckKeepOut evaluates every cone for the target at t and returns those it violates
+func CheckKeepOut(target coordinates.Equatorial, t time.Time, cones ...Cone) []Violation {
+	var violations []Violation
+	for _, c := range cones {
+		if sep := c.Separation(target, t); sep < c.Radius {
+			violations = append(violations, Violation{Cone: c, Separation: sep})
+		}
+	}
+	return violations
+}
+
+// SunAvoidance reports whether the target is at least minSep degrees from the Sun at t, along
+// with the actual separation
+func SunAvoidance(target coordinates.Equatorial, t time.Time, minSep float64) (bool, float64) {
+	sep := SunCone(minSep).Separation(target, t)
+	return sep >= minSep, sep
+}
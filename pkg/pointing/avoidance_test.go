@@ -0,0 +1,49 @@
+package pointing
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/lunar"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	"github.com/ocrosby/astronomy/pkg/solar"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Keep-out cones", func() {
+	t := time.Date(2024, 6, 21, 12, 0, 0, 0, time.UTC)
+	sun := solar.ApparentPosition(julian.Centuries(astrotime.TT(t)))
+
+	It("should reject a target near the Sun", func() {
+		ok, sep := SunAvoidance(coordinates.Equatorial{RA: sun.RA + 10, Dec: sun.Dec}, t, 45)
+		Expect(ok).To(BeFalse())
+		Expect(sep).To(BeNumerically("~", 10*0.9, 0.5))
+	})
+
+	It("should accept a target opposite the Sun", func() {
+		ok, sep := SunAvoidance(coordinates.Equatorial{RA: sun.RA + 180, Dec: -sun.Dec}, t, 45)
+		Expect(ok).To(BeTrue())
+		Expect(sep).To(BeNumerically("~", 180, 1e-6))
+	})
+
+	It("should report every violated cone", func() {
+		obs := observer.Observer{Latitude: 40, Longitude: -105}
+		zenith := coordinates.Equatorial{RA: sidereal.LocalMeanSiderealTime(julian.FromTime(t), obs.Longitude), Dec: 40}
+		violations := CheckKeepOut(zenith, t, SunCone(30), MoonCone(10), ZenithCone(obs, 2))
+		Expect(violations).To(HaveLen(1))
+		Expect(violations[0].Cone.Name).To(Equal("Zenith"))
+		Expect(violations[0].Separation).To(BeNumerically("<", 1e-6))
+	})
+
+	It("should place the Moon cone on the Moon", func() {
+		moon := MoonCone(5)
+		axis := moon.Axis(julian.FromTime(t))
+		Expect(axis).To(Equal(lunar.ApparentPosition(julian.Centuries(astrotime.TT(t)))))
+		Expect(moon.Contains(axis, t)).To(BeTrue())
+		Expect(moon.Contains(coordinates.Equatorial{RA: axis.RA + 20, Dec: axis.Dec}, t)).To(BeFalse())
+	})
+})
@@ -0,0 +1,150 @@
+package pointing
+
+import (
+	"fmt"
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	"time"
+)
+
+// EquatorialSystem is the equator and equinox a telescope driver works in, numbered as ASCOM's
+// EquatorialCoordinateType. INDI's EQUATORIAL_EOD_COORD is JNow and EQUATORIAL_COORD J2000.
+// B1950 is the FK5 mean system of that epoch rather than the FK4 catalogue frame, which differs
+// from it by up to about 1".
+type EquatorialSystem int
+
+const (
+	EquatorialOther EquatorialSystem = iota // unknown to the driver, and so not convertible
+	EquatorialJNow                          // true equator and equinox of the moment (ASCOM's equTopocentric)
+	EquatorialJ2000                         // mean equator and equinox of J2000.0
+	EquatorialJ2050                         // mean equator and equinox of J2050.0
+	EquatorialB1950                         // mean equator and equinox of B1950.0, in the FK5 frame
+)
+
+// equatorialSystemNames are the names of the systems by value
+var equatorialSystemNames = [...]string{"Other", "JNow", "J2000", "J2050", "B1950"}
+
+// String returns the name of the system, or its number for a value no driver defines
+func (s EquatorialSystem) String() string {
+	if s < 0 || int(s) >= len(equatorialSystemNames) {
+		return fmt.Sprintf("EquatorialSystem(%d)", int(s))
+	}
+	return equatorialSystemNames[s]
+}
+
+// b1950 is the Julian epoch of B1950.0, JD 2433282.4235. B1950 positions are reached by
+// precessing within FK5; the elliptic terms of aberration and the FK4 equinox correction, which
+// take FK5 to the FK4 catalogue frame, are not applied.
+const b1950 coordinates.Epoch = 1949.99979
+
+// MountCoordinates are a position as drivers exchange it: right ascension in hours rather than
+// degrees, with the system it is referred to
+type MountCoordinates struct {
+	RA     float64 // right ascension in hours, in [0, 24)
+	Dec    float64 // declination in degrees
+	System EquatorialSystem
+}
+
+// ToMount converts a position to a driver's system at t. The position is referred to its Epoch,
+// or to J2000 when that is unspecified; JNow adds nutation to the precession, as drivers
+// expect, but neither aberration nor refraction.
+func ToMount(e coordinates.Equatorial, system EquatorialSystem, t time.Time) (MountCoordinates, error) {
+	from := mountEpoch(e.Epoch).Centuries()
+	var converted coordinates.Equatorial
+	switch system {
+	case EquatorialJNow:
+		frame := coordinates.FrameAt(julian.Centuries(astrotime.TT(t)))
+		converted = frame.Nutate(coordinates.Precess(e, from, frame.T))
+	case EquatorialJ2000, EquatorialJ2050, EquatorialB1950:
+		converted = coordinates.Precess(e, from, systemEpoch(system).Centuries())
+	default:
+		return MountCoordinates{}, fmt.Errorf("cannot convert to the %v equatorial system", system)
+	}
+	return MountCoordinates{RA: angles.NormalizeDegrees(converted.RA) / angles.DegreesPerHour, Dec: converted.Dec, System: system}, nil
+}
+
+// FromMount converts coordinates reported by a driver at t to a J2000 mean position
+func FromMount(m MountCoordinates, t time.Time) (coordinates.Equatorial, error) {
+	e := coordinates.Equatorial{RA: angles.NormalizeDegrees(m.RA * angles.DegreesPerHour), Dec: m.Dec}
+	switch m.System {
+	case EquatorialJNow:
+		frame := coordinates.FrameAt(julian.Centuries(astrotime.TT(t)))
+		ecliptic := e.ToEcliptic(frame.TrueObliquity())
+		ecliptic.Longitude -= frame.NutationLongitude
+		return coordinates.Precess(ecliptic.ToEquatorial(frame.MeanObliquity), frame.T, 0), nil
+	case EquatorialJ2000, EquatorialJ2050, EquatorialB1950:
+		return coordinates.Precess(e, systemEpoch(m.System).Centuries(), 0), nil
+	default:
+		return coordinates.Equatorial{}, fmt.Errorf("cannot convert from the %v equatorial system", m.System)
+	}
+}
+
+// SiderealTime returns the local apparent sidereal time at t in hours, the value of a driver's
+// SiderealTime property
+func SiderealTime(obs observer.Observer, t time.Time) float64 {
+	return sidereal.LocalApparentSiderealTime(julian.FromTime(t), obs.Longitude) / angles.DegreesPerHour
+}
+
+// HourAngle returns the hour angle in hours, in [-12, 12), at which a mount pointing at m sees
+// its target at t, measured westwards from the meridian
+func HourAngle(m MountCoordinates, obs observer.Observer, t time.Time) (float64, error) {
+	jnow, err := jnowDegrees(m, t)
+	if err != nil {
+		return 0, err
+	}
+	lst := sidereal.LocalApparentSiderealTime(julian.FromTime(t), obs.Longitude)
+	return angles.WrapSigned(lst-jnow.RA) / angles.DegreesPerHour, nil
+}
+
+// MountHorizontal returns the geometric altitude and azimuth of the coordinates at t
+func MountHorizontal(m MountCoordinates, obs observer.Observer, t time.Time) (coordinates.Horizontal, error) {
+	jnow, err := jnowDegrees(m, t)
+	if err != nil {
+		return coordinates.Horizontal{}, err
+	}
+	return jnow.ToHorizontal(obs.Latitude, sidereal.LocalApparentSiderealTime(julian.FromTime(t), obs.Longitude)), nil
+}
+
+// HorizontalToMount returns the JNow coordinates of a geometric altitude and azimuth at t, for
+// syncing a mount on a position given in the horizon system
+func HorizontalToMount(h coordinates.Horizontal, obs observer.Observer, t time.Time) MountCoordinates {
+	e := h.ToEquatorial(obs.Latitude, sidereal.LocalApparentSiderealTime(julian.FromTime(t), obs.Longitude))
+	return MountCoordinates{RA: angles.NormalizeDegrees(e.RA) / angles.DegreesPerHour, Dec: e.Dec, System: EquatorialJNow}
+}
+
+// jnowDegrees returns coordinates in JNow with right ascension in degrees
+func jnowDegrees(m MountCoordinates, t time.Time) (coordinates.Equatorial, error) {
+	if m.System != EquatorialJNow {
+		j2000, err := FromMount(m, t)
+		if err != nil {
+			return coordinates.Equatorial{}, err
+		}
+		if m, err = ToMount(j2000, EquatorialJNow, t); err != nil {
+			return coordinates.Equatorial{}, err
+		}
+	}
+	return coordinates.Equatorial{RA: m.RA * angles.DegreesPerHour, Dec: m.Dec}, nil
+}
+
+// mountEpoch returns the epoch of a position, J2000 when unspecified
+func mountEpoch(e coordinates.Epoch) coordinates.Epoch {
+	if e == 0 {
+		return coordinates.J2000
+	}
+	return e
+}
+
+// systemEpoch returns the epoch of one of the mean systems
+func systemEpoch(system EquatorialSystem) coordinates.Epoch {
+	switch system {
+	case EquatorialJ2050:
+		return 2050
+	case EquatorialB1950:
+		return b1950
+	}
+	return coordinates.J2000
+}
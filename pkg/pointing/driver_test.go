@@ -0,0 +1,89 @@
+package pointing
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Driver coordinates", func() {
+	vega := coordinates.Equatorial{RA: 279.23473, Dec: 38.78369, Epoch: coordinates.J2000}
+	t := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	obs := observer.Observer{Latitude: 40, Longitude: -105}
+
+	It("should precess and nutate to JNow and back", func() {
+		jnow, err := ToMount(vega, EquatorialJNow, t)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(jnow.System).To(Equal(EquatorialJNow))
+		// 24 years of precession move Vega about 47s of time east and 1.3' north
+		Expect((jnow.RA - 279.23473/15) * 3600).To(BeNumerically("~", 47, 2))
+		Expect((jnow.Dec - 38.78369) * 60).To(BeNumerically("~", 1.3, 0.2))
+		frame := coordinates.FrameAt(julian.Centuries(astrotime.TT(t)))
+		Expect(jnow.RA * 15).To(BeNumerically("~", frame.Nutate(coordinates.Precess(vega, 0, frame.T)).RA, 1e-9))
+
+		back, err := FromMount(jnow, t)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(back.Epoch).To(Equal(coordinates.J2000))
+		Expect(coordinates.Separation(back, vega) * 3600).To(BeNumerically("<", 0.001))
+	})
+
+	It("should convert to the mean systems", func() {
+		b1950, err := ToMount(vega, EquatorialB1950, t)
+		Expect(err).NotTo(HaveOccurred())
+		// the FK4 catalogue gives 18h35m14.66s +38°44'09.7"; precession alone leaves out Vega's
+		// proper motion over the fifty years, about 10" in right ascension
+		Expect(b1950.RA).To(BeNumerically("~", 18+35/60.0+14.66/3600, 1.5/3600))
+		Expect(b1950.Dec).To(BeNumerically("~", 38+44/60.0+9.7/3600, 15.0/3600))
+		back, err := FromMount(b1950, t)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(coordinates.Separation(back, vega) * 3600).To(BeNumerically("<", 0.001))
+
+		j2000, err := ToMount(vega, EquatorialJ2000, t)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(j2000.RA).To(BeNumerically("~", 279.23473/15, 1e-9))
+
+		_, err = ToMount(vega, EquatorialOther, t)
+		Expect(err).To(HaveOccurred())
+		_, err = FromMount(MountCoordinates{}, t)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should name the systems", func() {
+		Expect(EquatorialB1950.String()).To(Equal("B1950"))
+		Expect(EquatorialSystem(7).String()).To(Equal("EquatorialSystem(7)"))
+		Expect(EquatorialSystem(-1).String()).To(Equal("EquatorialSystem(-1)"))
+		_, err := ToMount(vega, EquatorialSystem(7), t)
+		Expect(err).To(MatchError("cannot convert to the EquatorialSystem(7) equatorial system"))
+	})
+
+	It("should give the hour angle from the apparent sidereal time", func() {
+		jnow, _ := ToMount(vega, EquatorialJNow, t)
+		lst := SiderealTime(obs, t)
+		ha, err := HourAngle(jnow, obs, t)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ha).To(BeNumerically(">=", -12))
+		Expect(ha).To(BeNumerically("<", 12))
+		Expect(ha).To(BeNumerically("~", angles.WrapSigned((lst-jnow.RA)*15)/15, 1e-9))
+
+		fromJ2000, err := HourAngle(MountCoordinates{RA: 279.23473 / 15, Dec: 38.78369, System: EquatorialJ2000}, obs, t)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fromJ2000).To(BeNumerically("~", ha, 1e-9))
+	})
+
+	It("should round-trip through the horizon system", func() {
+		jnow, _ := ToMount(vega, EquatorialJNow, t)
+		h, err := MountHorizontal(jnow, obs, t)
+		Expect(err).NotTo(HaveOccurred())
+		synced := HorizontalToMount(h, obs, t)
+		Expect(synced.System).To(Equal(EquatorialJNow))
+		Expect(synced.RA).To(BeNumerically("~", jnow.RA, 1e-9))
+		Expect(synced.Dec).To(BeNumerically("~", jnow.Dec, 1e-9))
+		Expect(EquatorialB1950.String()).To(Equal("B1950"))
+	})
+})
@@ -0,0 +1,13 @@
+package pointing_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPointing(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Pointing Suite")
+}
@@ -0,0 +1,81 @@
+package pointing
+
+import (
+	"errors"
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"math"
+)
+
+// Direction is the sense of rotation of an azimuth slew
+type Direction int
+
+const (
+	Clockwise        Direction = iota // increasing azimuth (north through east)
+	CounterClockwise                  // decreasing azimuth
+)
+
+// String returns the name of the direction
+func (d Direction) String() string {
+	return [...]string{"Clockwise", "CounterClockwise"}[d]
+}
+
+// ErrUnreachable is returned when no slew reaches the target within the limits
+var ErrUnreachable = errors.New("pointing: target azimuth unreachable within limits")
+
+// Limits constrains azimuth motion. Min and Max bound the unwrapped (cumulative) azimuth of a
+// mount with a cable wrap, e.g. -270 to 270; equal values mean unlimited rotation. KeepOut lists
+// azimuth sectors that may not be entered or crossed, such as a dome shutter motor or a pier.
+type Limits struct {
+	Min     float64
+	Max     float64
+	KeepOut []angles.Interval
+}
+
+// unlimited reports whether the limits allow unlimited rotation
+func (l Limits) unlimited() bool {
+	return l.Min == l.Max
+}
+
+// Slew describes a planned azimuth move
+type Slew struct {
+	Direction Direction
+	Distance  float64 // degrees travelled, never negative
+	End       float64 // unwrapped azimuth at the end of the slew
+}
+
+// ShortestSlew plans the shortest move from the unwrapped azimuth currentAz to targetAz that
+// stays within the cable-wrap range and does not cross any keep-out sector
+func ShortestSlew(currentAz, targetAz float64, limits Limits) (Slew, error) {
+	low, high := currentAz-360, currentAz+360
+	if !limits.unlimited() {
+		low, high = limits.Min, limits.Max
+	}
+
+	target := angles.NormalizeDegrees(targetAz)
+	best := Slew{Distance: math.Inf(1)}
+	for end := target + 360*math.Ceil((low-target)/360); end <= high; end += 360 {
+		distance := math.Abs(end - currentAz)
+		if distance >= best.Distance || !limits.clear(currentAz, end) {
+			continue
+		}
+		best = Slew{Direction: Clockwise, Distance: distance, End: end}
+		if end < currentAz {
+			best.Direction = CounterClockwise
+		}
+	}
+	if math.IsInf(best.Distance, 1) {
+		return Slew{}, ErrUnreachable
+	}
+	return best, nil
+}
+
+// clear reports whether the path between two unwrapped azimuths avoids every keep-out sector
+func (l Limits) clear(from, to float64) bool {
+	path := angles.NewIntervalWidth(math.Min(from, to), math.Abs(to-from))
+	for _, k := range l.KeepOut {
+		if path.Overlaps(k) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,59 @@
+package pointing
+
+import (
+	"github.com/ocrosby/astronomy/pkg/angles"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ShortestSlew", func() {
+	It("should take the short way across north without limits", func() {
+		slew, err := ShortestSlew(350, 10, Limits{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(slew.Direction).To(Equal(Clockwise))
+		Expect(slew.Distance).To(BeNumerically("~", 20, 1e-9))
+		Expect(slew.End).To(BeNumerically("~", 370, 1e-9))
+	})
+
+	It("should go counterclockwise when shorter", func() {
+		slew, err := ShortestSlew(100, 40, Limits{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(slew.Direction).To(Equal(CounterClockwise))
+		Expect(slew.Distance).To(BeNumerically("~", 60, 1e-9))
+		Expect(slew.Direction.String()).To(Equal("CounterClockwise"))
+	})
+
+	It("should unwind when the cable wrap forbids the short way", func() {
+		limits := Limits{Min: -270, Max: 270}
+		slew, err := ShortestSlew(260, 290, limits)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(slew.Direction).To(Equal(CounterClockwise))
+		Expect(slew.End).To(BeNumerically("~", -70, 1e-9))
+		Expect(slew.Distance).To(BeNumerically("~", 330, 1e-9))
+	})
+
+	It("should choose between wrapped positions inside an overlapping range", func() {
+		slew, err := ShortestSlew(-100, 200, Limits{Min: -270, Max: 270})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(slew.End).To(BeNumerically("~", -160, 1e-9))
+	})
+
+	It("should route around a keep-out sector", func() {
+		limits := Limits{KeepOut: []angles.Interval{angles.NewInterval(355, 5)}}
+		slew, err := ShortestSlew(350, 10, limits)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(slew.Direction).To(Equal(CounterClockwise))
+		Expect(slew.Distance).To(BeNumerically("~", 340, 1e-9))
+	})
+
+	It("should fail when the target is inside a keep-out sector", func() {
+		limits := Limits{KeepOut: []angles.Interval{angles.NewInterval(355, 5)}}
+		_, err := ShortestSlew(90, 0, limits)
+		Expect(err).To(MatchError(ErrUnreachable))
+	})
+
+	It("should fail when no wrapped target lies within the range", func() {
+		_, err := ShortestSlew(10, 90, Limits{Min: 0, Max: 45})
+		Expect(err).To(MatchError(ErrUnreachable))
+	})
+})
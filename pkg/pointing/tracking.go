@@ -0,0 +1,44 @@
+package pointing
+
+import (
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/riseset"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	"time"
+)
+
+// rateStep is the half-width in days of the central difference used to differentiate positions
+const rateStep = 30.0 / julian.SecondsPerDay
+
+// Rates are the instantaneous rates of change of a target's coordinates in arcseconds per
+// second of time. RA is the rate of right ascension itself, not multiplied by cos(Dec), so it is
+// the offset a mount must add to sidereal tracking.
+type Rates struct {
+	RA       float64
+	Dec      float64
+	Azimuth  float64
+	Altitude float64
+}
+
+// TrackingRates differentiates the target's apparent position at t for an observer, giving the
+// non-sidereal equatorial rates and the horizontal rates needed by alt-az mounts
+func TrackingRates(target riseset.PositionFunc, obs observer.Observer, t time.Time) Rates {
+	jd := julian.FromTime(t)
+	horizontal := func(jd float64) (coordinates.Equatorial, coordinates.Horizontal) {
+		eq := target(jd)
+		return eq, eq.ToHorizontal(obs.Latitude, sidereal.LocalMeanSiderealTime(jd, obs.Longitude))
+	}
+	eqBefore, hzBefore := horizontal(jd - rateStep)
+	eqAfter, hzAfter := horizontal(jd + rateStep)
+
+	scale := 3600 / (2 * rateStep * julian.SecondsPerDay)
+	return Rates{
+		RA:       angles.ShortestDifference(eqAfter.RA, eqBefore.RA) * scale,
+		Dec:      (eqAfter.Dec - eqBefore.Dec) * scale,
+		Azimuth:  angles.ShortestDifference(hzAfter.Azimuth, hzBefore.Azimuth) * scale,
+		Altitude: (hzAfter.Altitude - hzBefore.Altitude) * scale,
+	}
+}
@@ -0,0 +1,47 @@
+package pointing
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TrackingRates", func() {
+	t := time.Date(2024, 3, 1, 3, 0, 0, 0, time.UTC)
+
+	It("should report no equatorial motion for a fixed star", func() {
+		star := func(float64) coordinates.Equatorial { return coordinates.Equatorial{RA: 80, Dec: 20} }
+		rates := TrackingRates(star, observer.Observer{Latitude: 40}, t)
+		Expect(rates.RA).To(BeNumerically("~", 0, 1e-9))
+		Expect(rates.Dec).To(BeNumerically("~", 0, 1e-9))
+	})
+
+	It("should recover the motion of a moving target", func() {
+		comet := func(jd float64) coordinates.Equatorial {
+			return coordinates.Equatorial{RA: 359.99 + (jd-2460370.5)*1.0, Dec: 10 - (jd-2460370.5)*0.5}
+		}
+		rates := TrackingRates(comet, observer.Observer{Latitude: 40}, t)
+		Expect(rates.RA).To(BeNumerically("~", 3600.0/86400, 1e-6))
+		Expect(rates.Dec).To(BeNumerically("~", -1800.0/86400, 1e-6))
+	})
+
+	It("should give the sidereal rate in altitude for an equatorial star rising at the equator", func() {
+		lst := sidereal.LocalMeanSiderealTime(2460370.625, 0)
+		star := func(float64) coordinates.Equatorial { return coordinates.Equatorial{RA: lst + 90, Dec: 0} }
+		rates := TrackingRates(star, observer.Observer{}, time.Date(2024, 3, 1, 3, 0, 0, 0, time.UTC))
+		Expect(rates.Altitude).To(BeNumerically("~", 15.041, 1e-3))
+		Expect(rates.Azimuth).To(BeNumerically("~", 0, 1e-3))
+	})
+
+	It("should show no altitude change at transit", func() {
+		lst := sidereal.LocalMeanSiderealTime(2460370.625, 0)
+		star := func(float64) coordinates.Equatorial { return coordinates.Equatorial{RA: lst, Dec: 0} }
+		rates := TrackingRates(star, observer.Observer{Latitude: 40}, t)
+		Expect(rates.Altitude).To(BeNumerically("~", 0, 1e-3))
+		Expect(rates.Azimuth).To(BeNumerically(">", 0))
+	})
+})
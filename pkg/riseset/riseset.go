@@ -0,0 +1,172 @@
+package riseset
+
+import (
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/events"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	"math"
+	"time"
+)
+
+// Standard altitudes of the center of a body at rising and setting, in degrees
+const (
+	StarAltitude = -0.5667 // refraction at the horizon
+	SunAltitude  = -0.8333 // refraction plus the Sun's semi-diameter
+)
+
+// HorizonAltitude returns the standard altitude of a body's center at rising and setting for
+// the observer's refraction model and atmosphere: the true altitude seen on the horizon, lowered
+// by the body's semi-diameter in degrees so that the upper limb touches the horizon
+func HorizonAltitude(obs observer.Observer, semidiameter float64) float64 {
+	return obs.TrueAltitude(0) - semidiameter
+}
+
+// searchStep is the sampling interval in days used to bracket risings and settings
+const searchStep = 1.0 / 24
+
+// fineStep is the sampling interval in days used where a moving observer or a ragged skyline
+// can bring two events within the hour of searchStep
+const fineStep = 1.0 / 288
+
+// moonRadiusRatio is the Moon's radius in equatorial radii of the Earth, the ratio of its
+// semi-diameter to its horizontal parallax
+const moonRadiusRatio = 0.2725
+
+// MoonAltitude returns the standard altitude of the Moon for its horizontal parallax in degrees,
+// combining parallax, semi-diameter and refraction in the standard atmosphere
+func MoonAltitude(parallax float64) float64 {
+	return (1-moonRadiusRatio)*parallax + StarAltitude
+}
+
+// MoonHorizonAltitude is MoonAltitude for the observer's refraction model and atmosphere
+func MoonHorizonAltitude(obs observer.Observer, parallax float64) float64 {
+	return HorizonAltitude(obs, moonRadiusRatio*parallax) + parallax
+}
+
+// PositionFunc returns the apparent geocentric position of a body at a Julian date
+type PositionFunc func(jd float64) coordinates.Equatorial
+
+// Result holds the rising and setting of a body during one search window. Rise and Set are
+// zero when the event does not occur within the window.
+type Result struct {
+	Rise        time.Time
+	RiseAzimuth float64 // degrees from north through east
+	Set         time.Time
+	SetAzimuth  float64 // degrees from north through east
+	AlwaysUp    bool    // the body stays above the standard altitude throughout the window
+	AlwaysDown  bool    // the body stays below the standard altitude throughout the window
+}
+
+// Events returns the rising and setting as events of a body in chronological order, with the
+// azimuth as their value, for listing with other events or describing with events.Summary
+func (r Result) Events(body string) []events.Event {
+	var found []events.Event
+	if !r.Rise.IsZero() {
+		found = append(found, events.Event{Kind: events.Rise, Time: r.Rise, Body: body, Value: r.RiseAzimuth})
+	}
+	if !r.Set.IsZero() {
+		found = append(found, events.Event{Kind: events.Set, Time: r.Set, Body: body, Value: r.SetAzimuth})
+	}
+	events.Sort(found)
+	return found
+}
+
+// Find returns the first rising and setting of a body above standardAltitude within 24 hours of
+// start, as seen by obs. The standard altitude is lowered by the dip of a sea horizon seen from
+// the observer's elevation; an observer on land whose horizon is not the sea's should be placed
+// at zero elevation, or given its skyline with FindWithHorizon.
+func Find(position PositionFunc, standardAltitude float64, obs observer.Observer, start time.Time) Result {
+	horizontal := func(jd float64) coordinates.Horizontal {
+		return position(jd).ToHorizontal(obs.Latitude, sidereal.LocalMeanSiderealTime(jd, obs.Longitude))
+	}
+	standardAltitude -= HorizonDip(obs.Elevation)
+	altitude := func(jd float64) float64 {
+		return horizontal(jd).Altitude - standardAltitude
+	}
+	azimuth := func(jd float64) float64 {
+		return horizontal(jd).Azimuth
+	}
+	return search(altitude, azimuth, start, searchStep)
+}
+
+// HorizonDip returns the dip of the sea horizon in degrees below the astronomical horizon for
+// an elevation in metres, including terrestrial refraction (1.76' √h)
+func HorizonDip(elevation float64) float64 {
+	return 1.76 * math.Sqrt(math.Max(elevation, 0)) / 60
+}
+
+// Horizon is a local skyline, giving the altitude in degrees of the visible horizon at an
+// azimuth in degrees from north through east, such as the terrain.Profile of a valley
+type Horizon interface {
+	Altitude(azimuth float64) float64
+}
+
+// FindWithHorizon is Find for an observer whose horizon is raised or lowered by terrain: the
+// body rises when its standard altitude clears the skyline at its azimuth, which takes the place
+// of the sea horizon's dip. The refraction in
+// standardAltitude is that of the astronomical horizon, a slight overestimate above it.
+func FindWithHorizon(position PositionFunc, standardAltitude float64, obs observer.Observer, horizon Horizon, start time.Time) Result {
+	horizontal := func(jd float64) coordinates.Horizontal {
+		return position(jd).ToHorizontal(obs.Latitude, sidereal.LocalMeanSiderealTime(jd, obs.Longitude))
+	}
+	altitude := func(jd float64) float64 {
+		h := horizontal(jd)
+		return h.Altitude - standardAltitude - horizon.Altitude(h.Azimuth)
+	}
+	azimuth := func(jd float64) float64 {
+		return horizontal(jd).Azimuth
+	}
+	return search(altitude, azimuth, start, fineStep)
+}
+
+// search returns the first rising and setting within 24 hours of start, altitude being the
+// height of the body above its standard altitude, sampled every step days
+func search(altitude, azimuth events.Func, start time.Time, step float64) Result {
+	from := julian.FromTime(start)
+	var result Result
+	for _, c := range events.FindCrossings(altitude, from, from+1, step) {
+		if c.Rising && result.Rise.IsZero() {
+			result.Rise, result.RiseAzimuth = julian.ToTime(c.JD).In(start.Location()), azimuth(c.JD)
+		} else if !c.Rising && result.Set.IsZero() {
+			result.Set, result.SetAzimuth = julian.ToTime(c.JD).In(start.Location()), azimuth(c.JD)
+		}
+	}
+	if result.Rise.IsZero() && result.Set.IsZero() {
+		up := altitude(from) > 0
+		result.AlwaysUp, result.AlwaysDown = up, !up
+	}
+	return result
+}
+
+// OnDay returns the rising and setting of a body on the calendar day of date in date's location
+func OnDay(position PositionFunc, standardAltitude float64, obs observer.Observer, date time.Time) Result {
+	return Find(position, standardAltitude, obs, startOfDay(date))
+}
+
+// Amplitude returns the angle in degrees between due east and the rising point of a body with
+// the given declination at a latitude, ignoring refraction; positive values are north of east
+func Amplitude(declination, latitude float64) float64 {
+	return math.Asin(math.Sin(declination*constants.Rad)/math.Cos(latitude*constants.Rad)) * constants.Deg
+}
+
+// RiseAzimuth returns the azimuth in degrees at which a body of fixed declination reaches
+// standardAltitude while rising. The set azimuth is 360 minus this value. It returns NaN for a
+// body that never crosses that altitude.
+func RiseAzimuth(declination, latitude, standardAltitude float64) float64 {
+	sinDec := math.Sin(declination * constants.Rad)
+	sinLat, cosLat := math.Sincos(latitude * constants.Rad)
+	sinAlt, cosAlt := math.Sincos(standardAltitude * constants.Rad)
+	cosAz := (sinDec - sinLat*sinAlt) / (cosLat * cosAlt)
+	if cosAz < -1 || cosAz > 1 {
+		return math.NaN()
+	}
+	return math.Acos(cosAz) * constants.Deg
+}
+
+// startOfDay returns midnight at the start of t's calendar day in t's location
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
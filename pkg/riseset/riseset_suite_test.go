@@ -0,0 +1,13 @@
+package riseset_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestRiseSet(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "RiseSet Suite")
+}
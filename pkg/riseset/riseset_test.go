@@ -0,0 +1,113 @@
+package riseset
+
+import (
+	"math"
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/events"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// skyline is a Horizon of fixed altitude over the eastern half of the sky
+type skyline float64
+
+func (s skyline) Altitude(azimuth float64) float64 {
+	if azimuth < 180 {
+		return float64(s)
+	}
+	return 0
+}
+
+var _ = Describe("RiseSet", func() {
+	// Sirius, ignoring precession
+	sirius := func(float64) coordinates.Equatorial { return coordinates.Equatorial{RA: 101.2872, Dec: -16.7161} }
+	london := observer.Observer{Latitude: 51.5, Longitude: 0}
+
+	It("should find a star at the standard altitude when it rises and sets", func() {
+		r := OnDay(sirius, StarAltitude, london, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC))
+		Expect(r.Rise.IsZero()).To(BeFalse())
+		Expect(r.Set.IsZero()).To(BeFalse())
+		for _, t := range []time.Time{r.Rise, r.Set} {
+			jd := julian.FromTime(t)
+			h := sirius(jd).ToHorizontal(london.Latitude, sidereal.LocalMeanSiderealTime(jd, london.Longitude))
+			Expect(h.Altitude).To(BeNumerically("~", StarAltitude, 1e-4))
+		}
+		Expect(r.RiseAzimuth).To(BeNumerically("~", RiseAzimuth(-16.7161, 51.5, StarAltitude), 1e-3))
+		Expect(r.SetAzimuth).To(BeNumerically("~", 360-RiseAzimuth(-16.7161, 51.5, StarAltitude), 1e-3))
+	})
+
+	It("should emit the rising and setting as events", func() {
+		r := OnDay(sirius, StarAltitude, london, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC))
+		found := r.Events("Sirius")
+		Expect(found).To(HaveLen(2))
+		Expect(found[0].Time.Before(found[1].Time)).To(BeTrue())
+		for _, e := range found {
+			Expect(e.Body).To(Equal("Sirius"))
+			if e.Kind == events.Rise {
+				Expect(e.Time).To(Equal(r.Rise))
+				Expect(e.Value).To(Equal(r.RiseAzimuth))
+			} else {
+				Expect(e.Kind).To(Equal(events.Set))
+				Expect(e.Time).To(Equal(r.Set))
+			}
+		}
+		Expect(Result{AlwaysUp: true}.Events("Sirius")).To(BeEmpty())
+	})
+
+	It("should repeat a sidereal day later", func() {
+		r1 := Find(sirius, StarAltitude, london, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC))
+		r2 := Find(sirius, StarAltitude, london, time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC))
+		Expect(r1.Rise.Add(24 * time.Hour).Sub(r2.Rise)).To(BeNumerically("~", 236*time.Second, 2*time.Second))
+	})
+
+	It("should report circumpolar and never-rising stars", func() {
+		polaris := func(float64) coordinates.Equatorial { return coordinates.Equatorial{RA: 37.95, Dec: 89.26} }
+		day := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+		Expect(OnDay(polaris, StarAltitude, london, day).AlwaysUp).To(BeTrue())
+		Expect(OnDay(polaris, StarAltitude, observer.Observer{Latitude: -30}, day).AlwaysDown).To(BeTrue())
+	})
+
+	It("should derive the horizon altitude from the observer's refraction model", func() {
+		Expect(HorizonAltitude(observer.NewObserver(51.5, 0, observer.WithRefraction(coordinates.Bennett{})), 0)).To(
+			BeNumerically("~", StarAltitude, 0.01))
+		Expect(HorizonAltitude(observer.NewObserver(51.5, 0, observer.WithRefraction(coordinates.Bennett{})), 0.2667)).To(
+			BeNumerically("~", SunAltitude, 0.01))
+		Expect(HorizonAltitude(observer.NewObserver(51.5, 0, observer.WithRefraction(coordinates.NoRefraction{})), 0)).To(Equal(0.0))
+		Expect(HorizonAltitude(observer.NewObserver(51.5, 0), 0)).To(BeNumerically("<", -0.45))
+	})
+
+	It("should compute the Moon's standard altitude", func() {
+		Expect(MoonAltitude(0.95)).To(BeNumerically("~", 0.1244, 1e-4))
+		airless := observer.NewObserver(51.5, 0, observer.WithRefraction(coordinates.NoRefraction{}))
+		Expect(MoonHorizonAltitude(airless, 0.95)).To(BeNumerically("~", 0.7275*0.95, 1e-9))
+		bennett := observer.NewObserver(51.5, 0, observer.WithRefraction(coordinates.Bennett{}))
+		Expect(MoonHorizonAltitude(bennett, 0.95)).To(BeNumerically("~", MoonAltitude(0.95), 0.01))
+	})
+
+	It("should compute the amplitude and rise azimuth of a fixed declination", func() {
+		Expect(Amplitude(23.44, 40.7128)).To(BeNumerically("~", 31.7, 0.1))
+		Expect(RiseAzimuth(23.44, 40.7128, 0)).To(BeNumerically("~", 90-Amplitude(23.44, 40.7128), 1e-9))
+		Expect(RiseAzimuth(0, 0, 0)).To(BeNumerically("~", 90, 1e-9))
+		Expect(math.IsNaN(RiseAzimuth(80, 60, 0))).To(BeTrue())
+	})
+
+	It("should rise and set behind a skyline", func() {
+		start := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+		plain := Find(sirius, StarAltitude, london, start)
+		level := FindWithHorizon(sirius, StarAltitude, london, skyline(0), start)
+		Expect(level.Rise).To(BeTemporally("~", plain.Rise, time.Second))
+		Expect(level.Set).To(BeTemporally("~", plain.Set, time.Second))
+
+		hills := FindWithHorizon(sirius, StarAltitude, london, skyline(3), start)
+		Expect(hills.Rise.Sub(plain.Rise)).To(BeNumerically(">", 10*time.Minute))
+		Expect(hills.Set).To(BeTemporally("~", plain.Set, time.Second))
+		jd := julian.FromTime(hills.Rise)
+		h := sirius(jd).ToHorizontal(london.Latitude, sidereal.LocalMeanSiderealTime(jd, london.Longitude))
+		Expect(h.Altitude).To(BeNumerically("~", StarAltitude+3, 1e-3))
+	})
+})
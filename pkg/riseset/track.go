@@ -0,0 +1,102 @@
+package riseset
+
+import (
+	"fmt"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	"github.com/ocrosby/astronomy/pkg/vectors"
+	"math"
+	"sort"
+	"time"
+)
+
+// Waypoint is the position of a moving observer at one time
+type Waypoint struct {
+	Time     time.Time
+	Observer observer.Observer
+}
+
+// Track is the route of a moving observer such as a ship or aircraft, its waypoints in
+// chronological order. Between two waypoints the observer moves at constant speed along the
+// great circle joining them, its elevation changing linearly; before the first waypoint and
+// after the last it stays where they put it.
+type Track []Waypoint
+
+// Validate reports a track without waypoints, with waypoints out of order or with consecutive
+// waypoints at opposite ends of a diameter, between which the great circle is undefined
+func (t Track) Validate() error {
+	if len(t) == 0 {
+		return fmt.Errorf("track has no waypoints")
+	}
+	for i := 1; i < len(t); i++ {
+		if t[i].Time.Before(t[i-1].Time) {
+			return fmt.Errorf("waypoint %d at %v is before waypoint %d at %v", i, t[i].Time, i-1, t[i-1].Time)
+		}
+		if surfaceVector(t[i].Observer).DotProduct(surfaceVector(t[i-1].Observer)) < -1+1e-12 {
+			return fmt.Errorf("waypoints %d and %d are antipodal", i-1, i)
+		}
+	}
+	return nil
+}
+
+// At returns the observer's position at a time. The track must be valid.
+func (t Track) At(when time.Time) observer.Observer {
+	i := sort.Search(len(t), func(i int) bool { return t[i].Time.After(when) })
+	if i == 0 {
+		return t[0].Observer
+	}
+	if i == len(t) {
+		return t[len(t)-1].Observer
+	}
+	a, b := t[i-1], t[i]
+	f := float64(when.Sub(a.Time)) / float64(b.Time.Sub(a.Time))
+	return interpolateObserver(a.Observer, b.Observer, f)
+}
+
+// FindMoving returns the first rising and setting of a body within 24 hours of start as seen
+// from an observer following track. As in Find, the standard altitude is lowered by the dip of
+// the horizon at the observer's elevation, which brings sunrise several minutes earlier for an
+// aircraft at cruising height; a stationary track gives the times of Find.
+func FindMoving(position PositionFunc, standardAltitude float64, track Track, start time.Time) (Result, error) {
+	if err := track.Validate(); err != nil {
+		return Result{}, err
+	}
+	horizontal := func(jd float64) (coordinates.Horizontal, observer.Observer) {
+		obs := track.At(julian.ToTime(jd))
+		return position(jd).ToHorizontal(obs.Latitude, sidereal.LocalMeanSiderealTime(jd, obs.Longitude)), obs
+	}
+	altitude := func(jd float64) float64 {
+		h, obs := horizontal(jd)
+		return h.Altitude - (standardAltitude - HorizonDip(obs.Elevation))
+	}
+	azimuth := func(jd float64) float64 {
+		h, _ := horizontal(jd)
+		return h.Azimuth
+	}
+	return search(altitude, azimuth, start, fineStep), nil
+}
+
+// interpolateObserver returns the observer a fraction f of the way from a to b, along the
+// shorter great circle and linearly in elevation, with a's atmosphere
+func interpolateObserver(a, b observer.Observer, f float64) observer.Observer {
+	va, vb := surfaceVector(a), surfaceVector(b)
+	o := a
+	o.Elevation = a.Elevation + f*(b.Elevation-a.Elevation)
+	omega := vectors.Angle3D(va, vb)
+	if omega < 1e-12 {
+		return o
+	}
+	sinOmega := math.Sin(omega)
+	v := va.ScalarMultiply(math.Sin((1-f)*omega) / sinOmega).Add(vb.ScalarMultiply(math.Sin(f*omega) / sinOmega))
+	o.Latitude = math.Atan2(v.Z, math.Hypot(v.X, v.Y)) * constants.Deg
+	o.Longitude = math.Atan2(v.Y, v.X) * constants.Deg
+	return o
+}
+
+// surfaceVector returns the unit vector towards an observer in Earth-fixed axes
+func surfaceVector(o observer.Observer) vectors.Vector3D {
+	return coordinates.UnitVector(o.Longitude, o.Latitude)
+}
@@ -0,0 +1,89 @@
+package riseset
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Track", func() {
+	sirius := func(float64) coordinates.Equatorial { return coordinates.Equatorial{RA: 101.2872, Dec: -16.7161} }
+	start := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	london := observer.Observer{Latitude: 51.5, Longitude: 0}
+
+	altitudeSeen := func(track Track, t time.Time) float64 {
+		jd := julian.FromTime(t)
+		obs := track.At(t)
+		return sirius(jd).ToHorizontal(obs.Latitude, sidereal.LocalMeanSiderealTime(jd, obs.Longitude)).Altitude
+	}
+
+	It("should interpolate along the great circle and clamp outside the waypoints", func() {
+		track := Track{
+			{Time: start, Observer: observer.Observer{Latitude: 0, Longitude: 0}},
+			{Time: start.Add(2 * time.Hour), Observer: observer.Observer{Latitude: 0, Longitude: 90, Elevation: 1000}},
+		}
+		mid := track.At(start.Add(time.Hour))
+		Expect(mid.Latitude).To(BeNumerically("~", 0, 1e-9))
+		Expect(mid.Longitude).To(BeNumerically("~", 45, 1e-9))
+		Expect(mid.Elevation).To(BeNumerically("~", 500, 1e-9))
+		Expect(track.At(start.Add(-time.Hour))).To(Equal(track[0].Observer))
+		Expect(track.At(start.Add(3 * time.Hour))).To(Equal(track[1].Observer))
+
+		north := Track{
+			{Time: start, Observer: observer.Observer{Latitude: 45, Longitude: -60}},
+			{Time: start.Add(time.Hour), Observer: observer.Observer{Latitude: 45, Longitude: 60}},
+		}
+		Expect(north.At(start.Add(30 * time.Minute)).Latitude).To(BeNumerically(">", 45))
+	})
+
+	It("should agree with Find for a stationary observer", func() {
+		aloft := london
+		aloft.Elevation = 3000
+		for _, obs := range []observer.Observer{london, aloft} {
+			fixed := Find(sirius, StarAltitude, obs, start)
+			moving, err := FindMoving(sirius, StarAltitude, Track{{Time: start, Observer: obs}}, start)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(moving.Rise).To(BeTemporally("~", fixed.Rise, time.Second))
+			Expect(moving.Set).To(BeTemporally("~", fixed.Set, time.Second))
+			Expect(moving.RiseAzimuth).To(BeNumerically("~", fixed.RiseAzimuth, 1e-3))
+		}
+	})
+
+	It("should follow an observer travelling east", func() {
+		track := Track{
+			{Time: start, Observer: london},
+			{Time: start.Add(24 * time.Hour), Observer: observer.Observer{Latitude: 52.5, Longitude: 30}},
+		}
+		r, err := FindMoving(sirius, StarAltitude, track, start)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r.Rise.Before(Find(sirius, StarAltitude, london, start).Rise)).To(BeTrue())
+		Expect(altitudeSeen(track, r.Rise)).To(BeNumerically("~", StarAltitude, 1e-3))
+		Expect(altitudeSeen(track, r.Set)).To(BeNumerically("~", StarAltitude, 1e-3))
+	})
+
+	It("should lower the horizon for an observer at altitude", func() {
+		aloft := london
+		aloft.Elevation = 10000
+		track := Track{{Time: start, Observer: aloft}}
+		r, err := FindMoving(sirius, StarAltitude, track, start)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(HorizonDip(10000)).To(BeNumerically("~", 2.93, 0.01))
+		Expect(altitudeSeen(track, r.Rise)).To(BeNumerically("~", StarAltitude-HorizonDip(10000), 1e-3))
+		Expect(r.Rise.Before(Find(sirius, StarAltitude, london, start).Rise)).To(BeTrue())
+	})
+
+	It("should reject invalid tracks", func() {
+		_, err := FindMoving(sirius, StarAltitude, nil, start)
+		Expect(err).To(MatchError("track has no waypoints"))
+		Expect(Track{{Time: start}, {Time: start.Add(-time.Hour)}}.Validate()).To(HaveOccurred())
+		Expect(Track{
+			{Time: start, Observer: observer.Observer{Latitude: 10, Longitude: 20}},
+			{Time: start, Observer: observer.Observer{Latitude: -10, Longitude: -160}},
+		}.Validate()).To(MatchError(ContainSubstring("antipodal")))
+	})
+})
@@ -0,0 +1,68 @@
+package satellite
+
+import (
+	"github.com/ocrosby/astronomy/pkg/events"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"time"
+)
+
+// approachStep is the sampling interval in days used to bracket close approaches, short
+// against the half-orbit spacing of distance minima between low orbits
+const approachStep = 1.0 / 1440
+
+// Approach is a local minimum of the distance between two satellites
+type Approach struct {
+	Time          time.Time
+	Distance      float64 // km
+	RelativeSpeed float64 // km/s
+}
+
+// CloseApproaches propagates two element sets over [start, end] and returns the times at
+// which their separation reaches a local minimum below threshold km, in chronological order.
+// It is meant for screening: the accuracy is that of the element sets, typically a kilometre
+// or more after a day.
+func CloseApproaches(a, b TLE, start, end time.Time, threshold float64) ([]Approach, error) {
+	pa, err := NewPropagator(a)
+	if err != nil {
+		return nil, err
+	}
+	pb, err := NewPropagator(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var failure error
+	relative := func(jd float64) (State, State) {
+		t := julian.ToTime(jd)
+		sa, err := pa.Propagate(t)
+		if err != nil && failure == nil {
+			failure = err
+		}
+		sb, err := pb.Propagate(t)
+		if err != nil && failure == nil {
+			failure = err
+		}
+		return sa, sb
+	}
+	distance := func(jd float64) float64 {
+		sa, sb := relative(jd)
+		return sa.Position.Subtract(sb.Position).Magnitude()
+	}
+
+	var approaches []Approach
+	for _, e := range events.FindExtrema(distance, julian.FromTime(start), julian.FromTime(end), approachStep) {
+		if e.Maximum || e.Value >= threshold {
+			continue
+		}
+		sa, sb := relative(e.JD)
+		approaches = append(approaches, Approach{
+			Time:          julian.ToTime(e.JD),
+			Distance:      e.Value,
+			RelativeSpeed: sa.Velocity.Subtract(sb.Velocity).Magnitude(),
+		})
+	}
+	if failure != nil {
+		return nil, failure
+	}
+	return approaches, nil
+}
@@ -0,0 +1,73 @@
+package satellite
+
+import (
+	"math"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CloseApproaches", func() {
+	iss, _ := ParseTLE(issLine1, issLine2)
+	// a companion in a polar orbit crossing the station's path
+	companion := iss
+	companion.CatalogNumber = 99999
+	companion.Inclination = 97.5
+	companion.MeanAnomaly = 320.0
+
+	start := iss.Epoch
+	end := start.Add(6 * time.Hour)
+
+	It("should find the minima of the separation in order", func() {
+		approaches, err := CloseApproaches(iss, companion, start, end, math.Inf(1))
+		Expect(err).To(BeNil())
+		Expect(len(approaches)).To(BeNumerically(">=", 6))
+		for i, a := range approaches {
+			Expect(a.RelativeSpeed).To(BeNumerically(">", 0))
+			if i > 0 {
+				Expect(a.Time.After(approaches[i-1].Time)).To(BeTrue())
+			}
+		}
+	})
+
+	It("should agree with a brute-force scan", func() {
+		approaches, err := CloseApproaches(iss, companion, start, end, math.Inf(1))
+		Expect(err).To(BeNil())
+		closest := approaches[0]
+		for _, a := range approaches {
+			if a.Distance < closest.Distance {
+				closest = a
+			}
+		}
+
+		pa, _ := NewPropagator(iss)
+		pb, _ := NewPropagator(companion)
+		best := math.Inf(1)
+		for t := start; t.Before(end); t = t.Add(time.Second) {
+			sa, _ := pa.Propagate(t)
+			sb, _ := pb.Propagate(t)
+			best = math.Min(best, sa.Position.Subtract(sb.Position).Magnitude())
+		}
+		Expect(closest.Distance).To(BeNumerically("<=", best+1e-6))
+		Expect(closest.Distance).To(BeNumerically("~", best, 10))
+	})
+
+	It("should keep only approaches closer than the threshold", func() {
+		all, _ := CloseApproaches(iss, companion, start, end, math.Inf(1))
+		threshold := all[0].Distance + 1
+		close, err := CloseApproaches(iss, companion, start, end, threshold)
+		Expect(err).To(BeNil())
+		Expect(len(close)).To(BeNumerically("<", len(all)))
+		for _, a := range close {
+			Expect(a.Distance).To(BeNumerically("<", threshold))
+		}
+	})
+
+	It("should report propagator errors", func() {
+		deep := companion
+		deep.MeanMotion = 1.0027
+		_, err := CloseApproaches(iss, deep, start, end, 10)
+		Expect(err).To(MatchError(ErrDeepSpace))
+	})
+})
@@ -0,0 +1,60 @@
+package satellite
+
+import (
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/geodesy"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	"github.com/ocrosby/astronomy/pkg/vectors"
+	"math"
+	"time"
+)
+
+// DopplerShift is the line-of-sight motion of a satellite and its effect on a downlink
+type DopplerShift struct {
+	Frequency float64 // received frequency in Hz
+	Shift     float64 // received minus transmitted frequency in Hz
+	Range     float64 // distance from the observer in km
+	RangeRate float64 // rate of change of the range in km/s, positive when receding
+}
+
+// Doppler returns the frequency received by obs at t from a satellite transmitting at
+// frequency Hz
+func Doppler(tle TLE, obs observer.Observer, t time.Time, frequency float64) (DopplerShift, error) {
+	p, err := NewPropagator(tle)
+	if err != nil {
+		return DopplerShift{}, err
+	}
+	return p.Doppler(obs, t, frequency)
+}
+
+// Doppler returns the frequency received by obs at t from the satellite transmitting at
+// frequency Hz, to first order in the range rate
+func (p *Propagator) Doppler(obs observer.Observer, t time.Time, frequency float64) (DopplerShift, error) {
+	state, err := p.Propagate(t)
+	if err != nil {
+		return DopplerShift{}, err
+	}
+	site := Site(obs, t)
+	los := state.Position.Subtract(site.Position)
+	distance := los.Magnitude()
+	rangeRate := state.Velocity.Subtract(site.Velocity).DotProduct(los) / distance
+	received := frequency * (1 - rangeRate/constants.SpeedOfLight)
+	return DopplerShift{Frequency: received, Shift: received - frequency, Range: distance, RangeRate: rangeRate}, nil
+}
+
+// Site returns the position in km and velocity in km/s of an observer on the WGS84 ellipsoid
+// in the TEME frame at t, rotating with the Earth (polar motion is neglected)
+func Site(obs observer.Observer, t time.Time) State {
+	axis, z := geodesy.Geocentric(obs.Latitude, obs.Elevation)
+	theta := (sidereal.GMSTFromTime(t) + obs.Longitude) * constants.Rad
+	sinTheta, cosTheta := math.Sincos(theta)
+
+	position := vectors.Vector3D{
+		X: axis * cosTheta / 1000,
+		Y: axis * sinTheta / 1000,
+		Z: z / 1000,
+	}
+	velocity := vectors.Vector3D{X: -constants.EarthRotationRate * position.Y, Y: constants.EarthRotationRate * position.X}
+	return State{Time: t, Position: position, Velocity: velocity}
+}
@@ -0,0 +1,52 @@
+package satellite
+
+import (
+	"math"
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/observer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Doppler", func() {
+	iss, _ := ParseTLE(issLine1, issLine2)
+	obs := observer.NewObserver(40.0, -100.0)
+	const downlink = 437.8e6
+
+	It("should match the derivative of the range", func() {
+		t := iss.Epoch.Add(2 * time.Hour)
+		shift, err := Doppler(iss, obs, t, downlink)
+		Expect(err).To(BeNil())
+		before, _ := Doppler(iss, obs, t.Add(-time.Second), downlink)
+		after, _ := Doppler(iss, obs, t.Add(time.Second), downlink)
+		Expect(shift.RangeRate).To(BeNumerically("~", (after.Range-before.Range)/2, 1e-3))
+		Expect(shift.Shift).To(BeNumerically("~", shift.Frequency-downlink, 1e-6))
+	})
+
+	It("should raise the frequency of an approaching satellite and lower a receding one", func() {
+		p, _ := NewPropagator(iss)
+		for minutes := 0; minutes < 180; minutes += 5 {
+			shift, err := p.Doppler(obs, iss.Epoch.Add(time.Duration(minutes)*time.Minute), downlink)
+			Expect(err).To(BeNil())
+			Expect(math.Signbit(shift.Shift)).To(Equal(shift.RangeRate > 0))
+			// no more than the orbital speed plus the Earth's rotation along the line of sight
+			Expect(math.Abs(shift.RangeRate)).To(BeNumerically("<", 8.1))
+			Expect(math.Abs(shift.Shift)).To(BeNumerically("<", downlink*8.1/299792.458))
+		}
+	})
+
+	It("should place the observer on the rotating Earth", func() {
+		site := Site(obs, iss.Epoch)
+		Expect(site.Position.Magnitude()).To(BeNumerically("~", 6369.3, 0.5))
+		Expect(site.Velocity.Magnitude()).To(BeNumerically("~", 0.465*math.Cos(40*math.Pi/180), 0.002))
+		Expect(site.Position.DotProduct(site.Velocity)).To(BeNumerically("~", 0, 1e-9))
+	})
+
+	It("should report propagation errors", func() {
+		deep := iss
+		deep.MeanMotion = 1.0027
+		_, err := Doppler(deep, obs, iss.Epoch, downlink)
+		Expect(err).To(MatchError(ErrDeepSpace))
+	})
+})
@@ -0,0 +1,150 @@
+package fetch
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/ocrosby/astronomy/pkg/satellite"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Format is the element set encoding requested from CelesTrak
+type Format int
+
+const (
+	JSON Format = iota // OMM as a JSON array
+	CSV                // OMM as CSV with a header row
+	TLE                // classic three-line element sets
+)
+
+// CelesTrakURL is the default CelesTrak general perturbations query endpoint
+const CelesTrakURL = "https://celestrak.org/NORAD/elements/gp.php"
+
+// String returns the CelesTrak FORMAT parameter for the format
+func (f Format) String() string {
+	return [...]string{"json", "csv", "tle"}[f]
+}
+
+// parse decodes a response body in the format
+func (f Format) parse(body []byte) ([]satellite.TLE, error) {
+	switch f {
+	case CSV:
+		return satellite.ParseOMMCSV(bytes.NewReader(body))
+	case TLE:
+		return satellite.ParseTLEs(string(body))
+	default:
+		return satellite.ParseOMMJSON(bytes.NewReader(body))
+	}
+}
+
+// Fetcher downloads element sets from CelesTrak, keeping the last response on disk and
+// revalidating it with its ETag so unchanged data is not transferred again
+type Fetcher struct {
+	Query    url.Values // CelesTrak selection, e.g. GROUP=stations or CATNR=25544
+	Format   Format
+	CacheDir string
+	Endpoint string // overrides CelesTrakURL
+	Client   *http.Client
+}
+
+// NewFetcher creates a fetcher for a CelesTrak group, such as "stations" or "active", caching
+// into cacheDir
+func NewFetcher(group string, format Format, cacheDir string) *Fetcher {
+	return &Fetcher{Query: url.Values{"GROUP": {group}}, Format: format, CacheDir: cacheDir}
+}
+
+// CachePath returns the location of the cached response for this fetcher's query and format
+func (f *Fetcher) CachePath() string {
+	name := strings.NewReplacer("=", "-", "&", "_", "/", "-").Replace(f.Query.Encode())
+	return filepath.Join(f.CacheDir, fmt.Sprintf("%s.%s", name, f.Format))
+}
+
+// etagPath returns the location of the cached response's ETag
+func (f *Fetcher) etagPath() string {
+	return f.CachePath() + ".etag"
+}
+
+// Fetch returns the current element sets, sending the cached ETag so that the server can answer
+// 304 Not Modified and the cached copy is used
+func (f *Fetcher) Fetch(ctx context.Context) ([]satellite.TLE, error) {
+	endpoint := f.Endpoint
+	if endpoint == "" {
+		endpoint = CelesTrakURL
+	}
+	query := url.Values{"FORMAT": {f.Format.String()}}
+	for key, values := range f.Query {
+		query[key] = values
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	cached, cacheErr := os.ReadFile(f.CachePath())
+	if etag, err := os.ReadFile(f.etagPath()); err == nil && cacheErr == nil {
+		req.Header.Set("If-None-Match", strings.TrimSpace(string(etag)))
+	}
+
+	client := f.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("downloading %s: %w", query.Encode(), err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusNotModified:
+		if cacheErr != nil {
+			return nil, fmt.Errorf("downloading %s: not modified but no cached copy", query.Encode())
+		}
+		return f.Format.parse(cached)
+	case http.StatusOK:
+	default:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("downloading %s: %s: %s", query.Encode(), resp.Status, body)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	sets, err := f.Format.parse(body)
+	if err != nil {
+		// CelesTrak answers unknown queries with 200 and a plain-text message
+		return nil, fmt.Errorf("parsing %s response: %w", query.Encode(), err)
+	}
+	if err := f.store(body, resp.Header.Get("ETag")); err != nil {
+		return nil, err
+	}
+	return sets, nil
+}
+
+// store replaces the cached response and its ETag
+func (f *Fetcher) store(body []byte, etag string) error {
+	if err := os.MkdirAll(f.CacheDir, 0o755); err != nil {
+		return err
+	}
+	tmp := f.CachePath() + ".tmp"
+	if err := os.WriteFile(tmp, body, 0o644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, f.CachePath()); err != nil {
+		return err
+	}
+	if etag == "" {
+		err := os.Remove(f.etagPath())
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	return os.WriteFile(f.etagPath(), []byte(etag), 0o644)
+}
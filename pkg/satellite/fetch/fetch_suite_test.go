@@ -0,0 +1,13 @@
+package fetch_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestFetch(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Satellite Fetch Suite")
+}
@@ -0,0 +1,102 @@
+package fetch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const stations = `[{"OBJECT_NAME":"ISS (ZARYA)","OBJECT_ID":"1998-067A","EPOCH":"2008-09-20T12:25:40.104192",` +
+	`"MEAN_MOTION":15.72125391,"ECCENTRICITY":0.0006703,"INCLINATION":51.6416,"RA_OF_ASC_NODE":247.4627,` +
+	`"ARG_OF_PERICENTER":130.536,"MEAN_ANOMALY":325.0288,"EPHEMERIS_TYPE":0,"CLASSIFICATION_TYPE":"U",` +
+	`"NORAD_CAT_ID":25544,"ELEMENT_SET_NO":292,"REV_AT_EPOCH":56353,"BSTAR":-1.1606e-5,` +
+	`"MEAN_MOTION_DOT":-2.182e-5,"MEAN_MOTION_DDOT":0}]`
+
+var _ = Describe("Fetcher", func() {
+	var (
+		server      *httptest.Server
+		requests    int
+		notModified int
+		query       string
+	)
+
+	BeforeEach(func() {
+		requests, notModified = 0, 0
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			query = r.URL.RawQuery
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				notModified++
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = w.Write([]byte(stations))
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	It("should download and parse a group", func() {
+		f := NewFetcher("stations", JSON, GinkgoT().TempDir())
+		f.Endpoint = server.URL
+
+		sets, err := f.Fetch(context.Background())
+		Expect(err).To(BeNil())
+		Expect(sets).To(HaveLen(1))
+		Expect(sets[0].CatalogNumber).To(Equal(25544))
+		Expect(query).To(Equal("FORMAT=json&GROUP=stations"))
+		Expect(f.CachePath()).To(HaveSuffix("GROUP-stations.json"))
+	})
+
+	It("should revalidate the cached copy with its ETag", func() {
+		f := NewFetcher("stations", JSON, GinkgoT().TempDir())
+		f.Endpoint = server.URL
+
+		_, err := f.Fetch(context.Background())
+		Expect(err).To(BeNil())
+		sets, err := f.Fetch(context.Background())
+		Expect(err).To(BeNil())
+		Expect(sets).To(HaveLen(1))
+		Expect(requests).To(Equal(2))
+		Expect(notModified).To(Equal(1))
+	})
+
+	It("should download again when the cached copy is missing", func() {
+		f := NewFetcher("stations", JSON, GinkgoT().TempDir())
+		f.Endpoint = server.URL
+
+		_, _ = f.Fetch(context.Background())
+		Expect(os.Remove(f.CachePath())).To(Succeed())
+		sets, err := f.Fetch(context.Background())
+		Expect(err).To(BeNil())
+		Expect(sets).To(HaveLen(1))
+		Expect(notModified).To(Equal(0))
+	})
+
+	It("should report HTTP and parse errors without caching them", func() {
+		failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("GROUP") == "nosuchgroup" {
+				_, _ = w.Write([]byte("Invalid query: \"GROUP=nosuchgroup\""))
+				return
+			}
+			http.Error(w, "service unavailable", http.StatusServiceUnavailable)
+		}))
+		defer failing.Close()
+
+		f := NewFetcher("stations", CSV, GinkgoT().TempDir())
+		f.Endpoint = failing.URL
+		_, err := f.Fetch(context.Background())
+		Expect(err).To(MatchError(ContainSubstring("503")))
+
+		f = NewFetcher("nosuchgroup", JSON, GinkgoT().TempDir())
+		f.Endpoint = failing.URL
+		_, err = f.Fetch(context.Background())
+		Expect(err).To(MatchError(ContainSubstring("parsing")))
+		_, err = os.Stat(f.CachePath())
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,123 @@
+package satellite
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// ommEpochLayout is the CCSDS OMM epoch format used by CelesTrak and Space-Track, always UTC
+const ommEpochLayout = "2006-01-02T15:04:05.999999"
+
+// ParseOMMJSON reads a JSON array of CCSDS Orbit Mean-Elements Messages with the keyword names
+// used by CelesTrak (OBJECT_NAME, NORAD_CAT_ID, MEAN_MOTION, ...). Numbers may be given as JSON
+// numbers or strings.
+func ParseOMMJSON(r io.Reader) ([]TLE, error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	var records []map[string]any
+	if err := decoder.Decode(&records); err != nil {
+		return nil, fmt.Errorf("decoding OMM JSON: %w", err)
+	}
+
+	sets := make([]TLE, 0, len(records))
+	for i, record := range records {
+		fields := make(map[string]string, len(record))
+		for key, value := range record {
+			if value != nil {
+				fields[key] = fmt.Sprint(value)
+			}
+		}
+		tle, err := tleFromOMM(fields)
+		if err != nil {
+			return nil, fmt.Errorf("OMM record %d: %v", i+1, err)
+		}
+		sets = append(sets, tle)
+	}
+	return sets, nil
+}
+
+// ParseOMMCSV reads Orbit Mean-Elements Messages as CSV with a header row of OMM keywords,
+// the format CelesTrak serves with FORMAT=csv
+func ParseOMMCSV(r io.Reader) ([]TLE, error) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading OMM CSV header: %w", err)
+	}
+
+	var sets []TLE
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return sets, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		fields := make(map[string]string, len(header))
+		for i, key := range header {
+			fields[strings.TrimSpace(key)] = record[i]
+		}
+		tle, err := tleFromOMM(fields)
+		if err != nil {
+			return nil, fmt.Errorf("OMM CSV row %d: %v", row, err)
+		}
+		sets = append(sets, tle)
+	}
+}
+
+// tleFromOMM builds an element set from OMM keywords and values
+func tleFromOMM(fields map[string]string) (TLE, error) {
+	if theory := fields["MEAN_ELEMENT_THEORY"]; theory != "" && theory != "SGP4" {
+		return TLE{}, fmt.Errorf("unsupported mean element theory '%s'", theory)
+	}
+	epoch, err := time.Parse(ommEpochLayout, strings.TrimSuffix(strings.TrimSpace(fields["EPOCH"]), "Z"))
+	if err != nil {
+		return TLE{}, fmt.Errorf("invalid epoch '%s'", fields["EPOCH"])
+	}
+
+	tle := TLE{
+		Name:           strings.TrimSpace(fields["OBJECT_NAME"]),
+		Classification: 'U',
+		Designator:     designator(fields["OBJECT_ID"]),
+		Epoch:          epoch,
+	}
+	if c := strings.TrimSpace(fields["CLASSIFICATION_TYPE"]); c != "" {
+		tle.Classification = c[0]
+	}
+
+	p := fieldParser{}
+	tle.CatalogNumber = p.integer("catalog number", fields["NORAD_CAT_ID"])
+	tle.MeanMotionDot = p.float("mean motion derivative", fields["MEAN_MOTION_DOT"])
+	tle.MeanMotionDDot = p.float("mean motion second derivative", fields["MEAN_MOTION_DDOT"])
+	tle.BStar = p.float("BSTAR", fields["BSTAR"])
+	tle.ElementSet = p.integer("element set", fields["ELEMENT_SET_NO"])
+	tle.Inclination = p.float("inclination", fields["INCLINATION"])
+	tle.RightAscension = p.float("right ascension", fields["RA_OF_ASC_NODE"])
+	tle.Eccentricity = p.float("eccentricity", fields["ECCENTRICITY"])
+	tle.ArgumentOfPerigee = p.float("argument of perigee", fields["ARG_OF_PERICENTER"])
+	tle.MeanAnomaly = p.float("mean anomaly", fields["MEAN_ANOMALY"])
+	tle.MeanMotion = p.float("mean motion", fields["MEAN_MOTION"])
+	tle.Revolution = p.integer("revolution number", fields["REV_AT_EPOCH"])
+	if p.err != nil {
+		return TLE{}, p.err
+	}
+	if tle.MeanMotion <= 0 {
+		return TLE{}, fmt.Errorf("missing mean motion")
+	}
+	return tle, nil
+}
+
+// designator converts an OMM object ID such as "1998-067A" to the TLE form "98067A", leaving
+// other forms unchanged
+func designator(id string) string {
+	id = strings.TrimSpace(id)
+	if len(id) > 5 && id[4] == '-' {
+		return id[2:4] + id[5:]
+	}
+	return id
+}
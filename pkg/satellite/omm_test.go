@@ -0,0 +1,73 @@
+package satellite
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	issOMMJSON = `[{"OBJECT_NAME":"ISS (ZARYA)","OBJECT_ID":"1998-067A","EPOCH":"2008-09-20T12:25:40.104192",` +
+		`"MEAN_MOTION":15.72125391,"ECCENTRICITY":0.0006703,"INCLINATION":51.6416,"RA_OF_ASC_NODE":247.4627,` +
+		`"ARG_OF_PERICENTER":130.536,"MEAN_ANOMALY":325.0288,"EPHEMERIS_TYPE":0,"CLASSIFICATION_TYPE":"U",` +
+		`"NORAD_CAT_ID":25544,"ELEMENT_SET_NO":292,"REV_AT_EPOCH":56353,"BSTAR":-1.1606e-5,` +
+		`"MEAN_MOTION_DOT":-2.182e-5,"MEAN_MOTION_DDOT":0}]`
+	issOMMCSV = "OBJECT_NAME,OBJECT_ID,EPOCH,MEAN_MOTION,ECCENTRICITY,INCLINATION,RA_OF_ASC_NODE," +
+		"ARG_OF_PERICENTER,MEAN_ANOMALY,EPHEMERIS_TYPE,CLASSIFICATION_TYPE,NORAD_CAT_ID,ELEMENT_SET_NO," +
+		"REV_AT_EPOCH,BSTAR,MEAN_MOTION_DOT,MEAN_MOTION_DDOT\n" +
+		"ISS (ZARYA),1998-067A,2008-09-20T12:25:40.104192,15.72125391,.0006703,51.6416,247.4627," +
+		"130.536,325.0288,0,U,25544,292,56353,-.11606E-4,-.2182E-4,0\n"
+)
+
+var _ = Describe("OMM", func() {
+	expected, _ := ParseTLE(issLine1, issLine2)
+	expected.Name = "ISS (ZARYA)"
+
+	expectISS := func(sets []TLE) {
+		Expect(sets).To(HaveLen(1))
+		tle := sets[0]
+		Expect(tle.Epoch.Sub(expected.Epoch)).To(BeNumerically("~", 0, time.Microsecond))
+		tle.Epoch = expected.Epoch
+		Expect(tle).To(Equal(expected))
+	}
+
+	It("should read CelesTrak JSON", func() {
+		sets, err := ParseOMMJSON(strings.NewReader(issOMMJSON))
+		Expect(err).To(BeNil())
+		expectISS(sets)
+	})
+
+	It("should read numbers given as strings", func() {
+		quoted := strings.Replace(issOMMJSON, `"NORAD_CAT_ID":25544`, `"NORAD_CAT_ID":"25544"`, 1)
+		sets, err := ParseOMMJSON(strings.NewReader(quoted))
+		Expect(err).To(BeNil())
+		expectISS(sets)
+	})
+
+	It("should read CelesTrak CSV", func() {
+		sets, err := ParseOMMCSV(strings.NewReader(issOMMCSV))
+		Expect(err).To(BeNil())
+		expectISS(sets)
+	})
+
+	It("should propagate like the equivalent TLE", func() {
+		sets, _ := ParseOMMJSON(strings.NewReader(issOMMJSON))
+		fromOMM, _ := NewPropagator(sets[0])
+		fromTLE, _ := NewPropagator(expected)
+		t := expected.Epoch.Add(6 * time.Hour)
+		a, _ := fromOMM.Propagate(t)
+		b, _ := fromTLE.Propagate(t)
+		Expect(a.Position.Subtract(b.Position).Magnitude()).To(BeNumerically("<", 1e-3))
+	})
+
+	It("should reject malformed records", func() {
+		_, err := ParseOMMJSON(strings.NewReader(strings.Replace(issOMMJSON, "2008-09-20T", "20-09-2008 ", 1)))
+		Expect(err).To(MatchError(ContainSubstring("epoch")))
+		_, err = ParseOMMCSV(strings.NewReader(strings.Replace(issOMMCSV, "51.6416", "north", 1)))
+		Expect(err).To(MatchError(ContainSubstring("inclination")))
+		_, err = ParseOMMJSON(strings.NewReader(`{"OBJECT_NAME":"ISS"}`))
+		Expect(err).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,13 @@
+package satellite_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSatellite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Satellite Suite")
+}
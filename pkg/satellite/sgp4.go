@@ -0,0 +1,289 @@
+package satellite
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ocrosby/astronomy/pkg/vectors"
+	"math"
+	"time"
+)
+
+// The propagator is the near-Earth SGP4 model of Spacetrack Report #3 as revised by Vallado et
+// al. (2006), with the WGS72 constants the element sets are fitted with. Orbits with periods of
+// 225 minutes or more need the deep-space SDP4 terms, which are not implemented.
+
+// WGS72 constants used by SGP4
+const (
+	EarthRadius = 6378.135 // equatorial radius in km
+	earthMu     = 398600.8 // gravitational parameter in km³/s²
+	j2          = 0.001082616
+	j3          = -0.00000253881
+	j4          = -0.00000165597
+	j3oj2       = j3 / j2
+)
+
+// xke is the square root of the gravitational parameter in Earth radii³/min²
+var xke = 60 / math.Sqrt(EarthRadius*EarthRadius*EarthRadius/earthMu)
+
+// DeepSpacePeriod is the orbital period in minutes from which SGP4 needs deep-space terms
+const DeepSpacePeriod = 225.0
+
+// Propagation errors
+var (
+	ErrDeepSpace = errors.New("satellite: deep-space orbits (period of 225 minutes or more) are not supported")
+	ErrDecayed   = errors.New("satellite: orbit has decayed")
+)
+
+// State is a position in km and velocity in km/s in the TEME (true equator, mean equinox)
+// frame of SGP4
+type State struct {
+	Time     time.Time
+	Position vectors.Vector3D
+	Velocity vectors.Vector3D
+}
+
+// Propagator computes the state of a satellite from its element set
+type Propagator struct {
+	tle TLE
+
+	// mean elements in radians and radians per minute, with the un-Kozai'd mean motion
+	inclination, node, perigee, anomaly, eccentricity, motion, bstar float64
+
+	isimp                                   bool
+	aycof, con41, cc1, cc4, cc5, d2, d3, d4 float64
+	delmo, eta, argpdot, omgcof, sinmao     float64
+	t2cof, t3cof, t4cof, t5cof, x1mth2      float64
+	x7thm1, mdot, nodedot, xlcof, xmcof     float64
+	nodecf                                  float64
+}
+
+// NewPropagator initialises SGP4 for an element set
+func NewPropagator(tle TLE) (*Propagator, error) {
+	p := &Propagator{
+		tle:          tle,
+		inclination:  tle.Inclination * math.Pi / 180,
+		node:         tle.RightAscension * math.Pi / 180,
+		perigee:      tle.ArgumentOfPerigee * math.Pi / 180,
+		anomaly:      tle.MeanAnomaly * math.Pi / 180,
+		eccentricity: tle.Eccentricity,
+		bstar:        tle.BStar,
+	}
+	if tle.MeanMotion <= 0 || tle.Eccentricity < 0 || tle.Eccentricity >= 1 {
+		return nil, fmt.Errorf("satellite: invalid elements for %d", tle.CatalogNumber)
+	}
+	kozai := tle.MeanMotion * 2 * math.Pi / 1440
+
+	// recover the original mean motion and semi-major axis from the Kozai mean motion
+	eccsq := p.eccentricity * p.eccentricity
+	omeosq := 1 - eccsq
+	rteosq := math.Sqrt(omeosq)
+	cosio := math.Cos(p.inclination)
+	cosio2 := cosio * cosio
+	ak := math.Pow(xke/kozai, 2.0/3)
+	d1 := 0.75 * j2 * (3*cosio2 - 1) / (rteosq * omeosq)
+	del := d1 / (ak * ak)
+	adel := ak * (1 - del*del - del*(1.0/3+134*del*del/81))
+	del = d1 / (adel * adel)
+	p.motion = kozai / (1 + del)
+	if 2*math.Pi/p.motion >= DeepSpacePeriod {
+		return nil, ErrDeepSpace
+	}
+
+	ao := math.Pow(xke/p.motion, 2.0/3)
+	sinio := math.Sin(p.inclination)
+	po := ao * omeosq
+	con42 := 1 - 5*cosio2
+	p.con41 = -con42 - 2*cosio2
+	posq := po * po
+	rp := ao * (1 - p.eccentricity)
+
+	p.isimp = rp < 220/EarthRadius+1
+	sfour := 78/EarthRadius + 1
+	qzms24 := math.Pow((120-78)/EarthRadius, 4)
+	if perigee := (rp - 1) * EarthRadius; perigee < 156 {
+		s := perigee - 78
+		if perigee < 98 {
+			s = 20
+		}
+		qzms24 = math.Pow((120-s)/EarthRadius, 4)
+		sfour = s/EarthRadius + 1
+	}
+
+	pinvsq := 1 / posq
+	tsi := 1 / (ao - sfour)
+	p.eta = ao * p.eccentricity * tsi
+	etasq := p.eta * p.eta
+	eeta := p.eccentricity * p.eta
+	psisq := math.Abs(1 - etasq)
+	coef := qzms24 * math.Pow(tsi, 4)
+	coef1 := coef / math.Pow(psisq, 3.5)
+	cc2 := coef1 * p.motion * (ao*(1+1.5*etasq+eeta*(4+etasq)) +
+		0.375*j2*tsi/psisq*p.con41*(8+3*etasq*(8+etasq)))
+	p.cc1 = p.bstar * cc2
+	cc3 := 0.0
+	if p.eccentricity > 1e-4 {
+		cc3 = -2 * coef * tsi * j3oj2 * p.motion * sinio / p.eccentricity
+	}
+	p.x1mth2 = 1 - cosio2
+	p.cc4 = 2 * p.motion * coef1 * ao * omeosq * (p.eta*(2+0.5*etasq) + p.eccentricity*(0.5+2*etasq) -
+		j2*tsi/(ao*psisq)*(-3*p.con41*(1-2*eeta+etasq*(1.5-0.5*eeta))+
+			0.75*p.x1mth2*(2*etasq-eeta*(1+etasq))*math.Cos(2*p.perigee)))
+	p.cc5 = 2 * coef1 * ao * omeosq * (1 + 2.75*(etasq+eeta) + eeta*etasq)
+
+	cosio4 := cosio2 * cosio2
+	temp1 := 1.5 * j2 * pinvsq * p.motion
+	temp2 := 0.5 * temp1 * j2 * pinvsq
+	temp3 := -0.46875 * j4 * pinvsq * pinvsq * p.motion
+	p.mdot = p.motion + 0.5*temp1*rteosq*p.con41 + 0.0625*temp2*rteosq*(13-78*cosio2+137*cosio4)
+	p.argpdot = -0.5*temp1*con42 + 0.0625*temp2*(7-114*cosio2+395*cosio4) + temp3*(3-36*cosio2+49*cosio4)
+	xhdot1 := -temp1 * cosio
+	p.nodedot = xhdot1 + (0.5*temp2*(4-19*cosio2)+2*temp3*(3-7*cosio2))*cosio
+	p.omgcof = p.bstar * cc3 * math.Cos(p.perigee)
+	if p.eccentricity > 1e-4 {
+		p.xmcof = -2.0 / 3 * coef * p.bstar / eeta
+	}
+	p.nodecf = 3.5 * omeosq * xhdot1 * p.cc1
+	p.t2cof = 1.5 * p.cc1
+	if math.Abs(cosio+1) > 1.5e-12 {
+		p.xlcof = -0.25 * j3oj2 * sinio * (3 + 5*cosio) / (1 + cosio)
+	} else {
+		p.xlcof = -0.25 * j3oj2 * sinio * (3 + 5*cosio) / 1.5e-12
+	}
+	p.aycof = -0.5 * j3oj2 * sinio
+	p.delmo = math.Pow(1+p.eta*math.Cos(p.anomaly), 3)
+	p.sinmao = math.Sin(p.anomaly)
+	p.x7thm1 = 7*cosio2 - 1
+
+	if !p.isimp {
+		cc1sq := p.cc1 * p.cc1
+		p.d2 = 4 * ao * tsi * cc1sq
+		temp := p.d2 * tsi * p.cc1 / 3
+		p.d3 = (17*ao + sfour) * temp
+		p.d4 = 0.5 * temp * ao * tsi * (221*ao + 31*sfour) * p.cc1
+		p.t3cof = p.d2 + 2*cc1sq
+		p.t4cof = 0.25 * (3*p.d3 + p.cc1*(12*p.d2+10*cc1sq))
+		p.t5cof = 0.2 * (3*p.d4 + 12*p.cc1*p.d3 + 6*p.d2*p.d2 + 15*cc1sq*(2*p.d2+cc1sq))
+	}
+	return p, nil
+}
+
+// TLE returns the element set of the propagator
+func (p *Propagator) TLE() TLE {
+	return p.tle
+}
+
+// Propagate returns the state at t
+func (p *Propagator) Propagate(t time.Time) (State, error) {
+	state, err := p.propagate(t.Sub(p.tle.Epoch).Minutes())
+	state.Time = t
+	return state, err
+}
+
+// propagate returns the state a number of minutes after the epoch
+func (p *Propagator) propagate(minutes float64) (State, error) {
+	t := minutes
+	xmdf := p.anomaly + p.mdot*t
+	argpdf := p.perigee + p.argpdot*t
+	nodedf := p.node + p.nodedot*t
+	argpm, mm := argpdf, xmdf
+	t2 := t * t
+	nodem := nodedf + p.nodecf*t2
+	tempa := 1 - p.cc1*t
+	tempe := p.bstar * p.cc4 * t
+	templ := p.t2cof * t2
+
+	if !p.isimp {
+		delomg := p.omgcof * t
+		delm := p.xmcof * (math.Pow(1+p.eta*math.Cos(xmdf), 3) - p.delmo)
+		temp := delomg + delm
+		mm = xmdf + temp
+		argpm = argpdf - temp
+		t3 := t2 * t
+		t4 := t3 * t
+		tempa = tempa - p.d2*t2 - p.d3*t3 - p.d4*t4
+		tempe = tempe + p.bstar*p.cc5*(math.Sin(mm)-p.sinmao)
+		templ = templ + p.t3cof*t3 + t4*(p.t4cof+t*p.t5cof)
+	}
+
+	am := math.Pow(xke/p.motion, 2.0/3) * tempa * tempa
+	nm := xke / math.Pow(am, 1.5)
+	em := p.eccentricity - tempe
+	if em >= 1 || em < -0.001 || am < 0.95 {
+		return State{}, ErrDecayed
+	}
+	em = math.Max(em, 1e-6)
+	mm += p.motion * templ
+	xlm := mm + argpm + nodem
+	nodem = math.Mod(nodem, 2*math.Pi)
+	argpm = math.Mod(argpm, 2*math.Pi)
+	xlm = math.Mod(xlm, 2*math.Pi)
+	mm = math.Mod(xlm-argpm-nodem, 2*math.Pi)
+	sinip, cosip := math.Sincos(p.inclination)
+
+	// long-period periodics
+	axnl := em * math.Cos(argpm)
+	temp := 1 / (am * (1 - em*em))
+	aynl := em*math.Sin(argpm) + temp*p.aycof
+	xl := mm + argpm + nodem + temp*p.xlcof*axnl
+
+	// Kepler's equation in the Lyddane variables
+	u := math.Mod(xl-nodem, 2*math.Pi)
+	eo1 := u
+	var sineo1, coseo1 float64
+	for i := 0; i < 10; i++ {
+		sineo1, coseo1 = math.Sincos(eo1)
+		step := (u - aynl*coseo1 + axnl*sineo1 - eo1) / (1 - coseo1*axnl - sineo1*aynl)
+		step = math.Max(-0.95, math.Min(0.95, step))
+		eo1 += step
+		if math.Abs(step) < 1e-12 {
+			break
+		}
+	}
+	sineo1, coseo1 = math.Sincos(eo1)
+
+	// short-period preliminary quantities
+	ecose := axnl*coseo1 + aynl*sineo1
+	esine := axnl*sineo1 - aynl*coseo1
+	el2 := axnl*axnl + aynl*aynl
+	pl := am * (1 - el2)
+	if pl < 0 {
+		return State{}, ErrDecayed
+	}
+	rl := am * (1 - ecose)
+	rdotl := math.Sqrt(am) * esine / rl
+	rvdotl := math.Sqrt(pl) / rl
+	betal := math.Sqrt(1 - el2)
+	temp = esine / (1 + betal)
+	sinu := am / rl * (sineo1 - aynl - axnl*temp)
+	cosu := am / rl * (coseo1 - axnl + aynl*temp)
+	su := math.Atan2(sinu, cosu)
+	sin2u := 2 * cosu * sinu
+	cos2u := 1 - 2*sinu*sinu
+	temp = 1 / pl
+	temp1 := 0.5 * j2 * temp
+	temp2 := temp1 * temp
+
+	// short-period periodics
+	mrt := rl*(1-1.5*temp2*betal*p.con41) + 0.5*temp1*p.x1mth2*cos2u
+	su -= 0.25 * temp2 * p.x7thm1 * sin2u
+	xnode := nodem + 1.5*temp2*cosip*sin2u
+	xinc := p.inclination + 1.5*temp2*cosip*sinip*cos2u
+	mvt := rdotl - nm*temp1*p.x1mth2*sin2u/xke
+	rvdot := rvdotl + nm*temp1*(p.x1mth2*cos2u+1.5*p.con41)/xke
+	if mrt < 1 {
+		return State{}, ErrDecayed
+	}
+
+	sinsu, cossu := math.Sincos(su)
+	snod, cnod := math.Sincos(xnode)
+	sini, cosi := math.Sincos(xinc)
+	xmx, xmy := -snod*cosi, cnod*cosi
+	uv := vectors.Vector3D{X: xmx*sinsu + cnod*cossu, Y: xmy*sinsu + snod*cossu, Z: sini * sinsu}
+	vv := vectors.Vector3D{X: xmx*cossu - cnod*sinsu, Y: xmy*cossu - snod*sinsu, Z: sini * cossu}
+
+	kmPerSecond := EarthRadius * xke / 60
+	return State{
+		Position: uv.ScalarMultiply(mrt * EarthRadius),
+		Velocity: uv.ScalarMultiply(mvt).Add(vv.ScalarMultiply(rvdot)).ScalarMultiply(kmPerSecond),
+	}, nil
+}
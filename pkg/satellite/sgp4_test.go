@@ -0,0 +1,63 @@
+package satellite
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/vectors"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SGP4", func() {
+	// Vanguard 1, the near-Earth verification case of Vallado et al. (2006)
+	vanguard, _ := ParseTLE("1 00005U 58002B   00179.78495062  .00000023  00000-0  28098-4 0  4753",
+		"2 00005  34.2682 348.7242 1859667 331.7664  19.3264 10.82419157413667")
+
+	DescribeTable("matches the published verification states",
+		func(minutes float64, position, velocity vectors.Vector3D) {
+			p, err := NewPropagator(vanguard)
+			Expect(err).To(BeNil())
+			state, err := p.Propagate(vanguard.Epoch.Add(time.Duration(minutes * float64(time.Minute))))
+			Expect(err).To(BeNil())
+			Expect(state.Position.Subtract(position).Magnitude()).To(BeNumerically("<", 1e-6))
+			Expect(state.Velocity.Subtract(velocity).Magnitude()).To(BeNumerically("<", 1e-8))
+		},
+		Entry("at epoch", 0.0,
+			vectors.Vector3D{X: 7022.46529266, Y: -1400.08296755, Z: 0.03995155},
+			vectors.Vector3D{X: 1.893841015, Y: 6.405893759, Z: 4.534807250}),
+		Entry("after 360 minutes", 360.0,
+			vectors.Vector3D{X: -7154.03120202, Y: -3783.17682504, Z: -3536.19412294},
+			vectors.Vector3D{X: 4.741887409, Y: -4.151817765, Z: -2.093935425}),
+	)
+
+	It("should keep the ISS in a low orbit", func() {
+		iss, _ := ParseTLE(issLine1, issLine2)
+		p, err := NewPropagator(iss)
+		Expect(err).To(BeNil())
+		for hours := 0; hours < 24; hours += 3 {
+			state, err := p.Propagate(iss.Epoch.Add(time.Duration(hours) * time.Hour))
+			Expect(err).To(BeNil())
+			Expect(state.Position.Magnitude() - EarthRadius).To(BeNumerically("~", 350, 30))
+			Expect(state.Velocity.Magnitude()).To(BeNumerically("~", 7.7, 0.05))
+		}
+	})
+
+	It("should refuse deep-space orbits", func() {
+		geostationary := vanguard
+		geostationary.MeanMotion = 1.0027
+		geostationary.Eccentricity = 0.0002
+		_, err := NewPropagator(geostationary)
+		Expect(err).To(MatchError(ErrDeepSpace))
+	})
+
+	It("should report decay", func() {
+		decaying := vanguard
+		decaying.MeanMotion = 16.2
+		decaying.Eccentricity = 0.001
+		decaying.BStar = 0.05
+		p, err := NewPropagator(decaying)
+		Expect(err).To(BeNil())
+		_, err = p.Propagate(decaying.Epoch.Add(60 * 24 * time.Hour))
+		Expect(err).To(MatchError(ErrDecayed))
+	})
+})
@@ -0,0 +1,196 @@
+package satellite
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TLE is a NORAD two-line element set. Angles are in degrees and mean motions in revolutions
+// per day; the elements are SGP4 mean elements and only meaningful with that propagator.
+type TLE struct {
+	Name              string
+	CatalogNumber     int
+	Classification    byte   // 'U' unclassified, 'C' classified, 'S' secret
+	Designator        string // international designator, e.g. "98067A"
+	Epoch             time.Time
+	MeanMotionDot     float64 // first derivative of the mean motion divided by two, rev/day²
+	MeanMotionDDot    float64 // second derivative of the mean motion divided by six, rev/day³
+	BStar             float64 // drag term in inverse Earth radii
+	ElementSet        int
+	Inclination       float64
+	RightAscension    float64 // of the ascending node
+	Eccentricity      float64
+	ArgumentOfPerigee float64
+	MeanAnomaly       float64
+	MeanMotion        float64
+	Revolution        int // revolution number at epoch
+}
+
+// ParseTLE parses the two lines of an element set, verifying their checksums
+func ParseTLE(line1, line2 string) (TLE, error) {
+	line1, line2 = strings.TrimRight(line1, " \r\n"), strings.TrimRight(line2, " \r\n")
+	if err := checkLine(line1, '1'); err != nil {
+		return TLE{}, err
+	}
+	if err := checkLine(line2, '2'); err != nil {
+		return TLE{}, err
+	}
+
+	var tle TLE
+	p := fieldParser{}
+	tle.CatalogNumber = p.integer("catalog number", line1[2:7])
+	tle.Classification = line1[7]
+	tle.Designator = strings.TrimSpace(line1[9:17])
+	year := p.integer("epoch year", line1[18:20])
+	day := p.float("epoch day", line1[20:32])
+	tle.MeanMotionDot = p.float("mean motion derivative", line1[33:43])
+	tle.MeanMotionDDot = p.exponent("mean motion second derivative", line1[44:52])
+	tle.BStar = p.exponent("BSTAR", line1[53:61])
+	tle.ElementSet = p.integer("element set", line1[64:68])
+
+	if number := p.integer("catalog number", line2[2:7]); p.err == nil && number != tle.CatalogNumber {
+		return TLE{}, fmt.Errorf("catalog numbers %d and %d of the two lines differ", tle.CatalogNumber, number)
+	}
+	tle.Inclination = p.float("inclination", line2[8:16])
+	tle.RightAscension = p.float("right ascension", line2[17:25])
+	tle.Eccentricity = p.float("eccentricity", "0."+strings.TrimSpace(line2[26:33]))
+	tle.ArgumentOfPerigee = p.float("argument of perigee", line2[34:42])
+	tle.MeanAnomaly = p.float("mean anomaly", line2[43:51])
+	tle.MeanMotion = p.float("mean motion", line2[52:63])
+	tle.Revolution = p.integer("revolution number", line2[63:68])
+	if p.err != nil {
+		return TLE{}, p.err
+	}
+
+	tle.Epoch = epochTime(year, day)
+	return tle, nil
+}
+
+// ParseTLEs reads element sets in two-line or three-line (name first) form, skipping blank
+// lines
+func ParseTLEs(text string) ([]TLE, error) {
+	var lines []string
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	for scanner.Scan() {
+		if line := strings.TrimRight(scanner.Text(), " \r"); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	var sets []TLE
+	for i := 0; i < len(lines); {
+		name := ""
+		if !strings.HasPrefix(lines[i], "1 ") {
+			name = strings.TrimSpace(strings.TrimPrefix(lines[i], "0 "))
+			i++
+		}
+		if i+1 >= len(lines) {
+			return nil, fmt.Errorf("incomplete element set at line %d", i+1)
+		}
+		tle, err := ParseTLE(lines[i], lines[i+1])
+		if err != nil {
+			return nil, fmt.Errorf("element set at line %d: %v", i+1, err)
+		}
+		tle.Name = name
+		sets = append(sets, tle)
+		i += 2
+	}
+	return sets, nil
+}
+
+// Checksum returns the modulo-10 checksum of a TLE line: the sum of its digits, counting
+// each minus sign as one, over the first 68 columns
+func Checksum(line string) int {
+	sum := 0
+	for _, c := range line[:min(len(line), 68)] {
+		switch {
+		case c >= '0' && c <= '9':
+			sum += int(c - '0')
+		case c == '-':
+			sum++
+		}
+	}
+	return sum % 10
+}
+
+// checkLine verifies the length, line number and checksum of a TLE line
+func checkLine(line string, number byte) error {
+	if len(line) != 69 {
+		return fmt.Errorf("line %c has %d columns, expected 69", number, len(line))
+	}
+	if line[0] != number {
+		return fmt.Errorf("line %c starts with '%c'", number, line[0])
+	}
+	if want := int(line[68] - '0'); Checksum(line) != want {
+		return fmt.Errorf("line %c checksum is %d, expected %d", number, Checksum(line), want)
+	}
+	return nil
+}
+
+// epochTime converts a two-digit year (57-99 for 1957-1999) and fractional day of year
+func epochTime(year int, day float64) time.Time {
+	if year < 57 {
+		year += 2000
+	} else {
+		year += 1900
+	}
+	start := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	return start.Add(time.Duration(math.Round((day - 1) * 86400e9)))
+}
+
+// fieldParser parses fixed-width fields, keeping the first error
+type fieldParser struct {
+	err error
+}
+
+// integer parses an integer field, blank meaning zero
+func (p *fieldParser) integer(name, s string) int {
+	s = strings.TrimSpace(s)
+	if p.err != nil || s == "" {
+		return 0
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		p.err = fmt.Errorf("invalid %s '%s'", name, s)
+	}
+	return v
+}
+
+// float parses a decimal field, blank meaning zero
+func (p *fieldParser) float(name, s string) float64 {
+	s = strings.TrimSpace(s)
+	if p.err != nil || s == "" {
+		return 0
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		p.err = fmt.Errorf("invalid %s '%s'", name, s)
+	}
+	return v
+}
+
+// exponent parses a field with an assumed leading decimal point and a power of ten, such as
+// " 28098-4" for 0.28098e-4
+func (p *fieldParser) exponent(name, s string) float64 {
+	s = strings.TrimSpace(s)
+	if p.err != nil || s == "" {
+		return 0
+	}
+	if len(s) < 3 {
+		p.err = fmt.Errorf("invalid %s '%s'", name, s)
+		return 0
+	}
+	mantissa, power := s[:len(s)-2], s[len(s)-2:]
+	sign := ""
+	if mantissa[0] == '-' || mantissa[0] == '+' {
+		sign, mantissa = mantissa[:1], mantissa[1:]
+	}
+	return p.float(name, sign+"0."+mantissa+"e"+power)
+}
@@ -0,0 +1,69 @@
+package satellite
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	issLine1 = "1 25544U 98067A   08264.51782528 -.00002182  00000-0 -11606-4 0  2927"
+	issLine2 = "2 25544  51.6416 247.4627 0006703 130.5360 325.0288 15.72125391563537"
+)
+
+var _ = Describe("TLE", func() {
+	It("should parse every field of an element set", func() {
+		tle, err := ParseTLE(issLine1, issLine2)
+		Expect(err).To(BeNil())
+		Expect(tle.CatalogNumber).To(Equal(25544))
+		Expect(tle.Classification).To(Equal(byte('U')))
+		Expect(tle.Designator).To(Equal("98067A"))
+		Expect(tle.Epoch.Year()).To(Equal(2008))
+		Expect(tle.Epoch.YearDay()).To(Equal(264))
+		Expect(tle.Epoch.Sub(time.Date(2008, 9, 20, 12, 25, 40, 104192000, time.UTC))).To(BeNumerically("~", 0, time.Millisecond))
+		Expect(tle.MeanMotionDot).To(Equal(-0.00002182))
+		Expect(tle.MeanMotionDDot).To(Equal(0.0))
+		Expect(tle.BStar).To(BeNumerically("~", -0.11606e-4, 1e-15))
+		Expect(tle.ElementSet).To(Equal(292))
+		Expect(tle.Inclination).To(Equal(51.6416))
+		Expect(tle.RightAscension).To(Equal(247.4627))
+		Expect(tle.Eccentricity).To(Equal(0.0006703))
+		Expect(tle.ArgumentOfPerigee).To(Equal(130.536))
+		Expect(tle.MeanAnomaly).To(Equal(325.0288))
+		Expect(tle.MeanMotion).To(Equal(15.72125391))
+		Expect(tle.Revolution).To(Equal(56353))
+	})
+
+	It("should reject a bad checksum", func() {
+		_, err := ParseTLE(issLine1[:68]+"8", issLine2)
+		Expect(err).To(MatchError(ContainSubstring("checksum")))
+	})
+
+	It("should reject truncated lines and swapped lines", func() {
+		_, err := ParseTLE(issLine1[:60], issLine2)
+		Expect(err).NotTo(BeNil())
+		_, err = ParseTLE(issLine2, issLine1)
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("should compute checksums counting minus signs as one", func() {
+		Expect(Checksum(issLine1)).To(Equal(7))
+		Expect(Checksum(issLine2)).To(Equal(7))
+	})
+
+	It("should read two-line and three-line sets", func() {
+		text := "ISS (ZARYA)\n" + issLine1 + "\n" + issLine2 + "\n\n" + issLine1 + "\r\n" + issLine2 + "\n"
+		sets, err := ParseTLEs(text)
+		Expect(err).To(BeNil())
+		Expect(sets).To(HaveLen(2))
+		Expect(sets[0].Name).To(Equal("ISS (ZARYA)"))
+		Expect(sets[1].Name).To(BeEmpty())
+		Expect(sets[1].CatalogNumber).To(Equal(25544))
+	})
+
+	It("should report an incomplete set", func() {
+		_, err := ParseTLEs("ISS\n" + issLine1 + "\n")
+		Expect(err).To(MatchError(ContainSubstring("incomplete")))
+	})
+})
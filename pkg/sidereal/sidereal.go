@@ -0,0 +1,58 @@
+package sidereal
+
+import (
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"math"
+	"time"
+)
+
+// ERA returns the Earth rotation angle in degrees for a Julian date in UT1 (IAU 2000)
+func ERA(jd float64) float64 {
+	days := jd - julian.J2000
+	_, fraction := math.Modf(days)
+	return angles.NormalizeDegrees(360 * (fraction + 0.7790572732640 + 0.00273781191135448*days))
+}
+
+// GMST returns the Greenwich mean sidereal time in degrees for a Julian date in UT1. The
+// Classical model (the default) uses IAU 1982, Meeus 12.4; IAU2006 adds the IAU 2006
+// precession polynomial to the Earth rotation angle. UTC may be used where an error of under a
+// second is acceptable.
+func GMST(jd float64, options ...coordinates.ModelOption) float64 {
+	t := julian.Centuries(jd)
+	if coordinates.ModelOf(options) == coordinates.IAU2006 {
+		seconds := 0.014506 + (4612.156534+(1.3915817+(-0.00000044+(-0.000029956-0.0000000368*t)*t)*t)*t)*t
+		return angles.NormalizeDegrees(ERA(jd) + seconds/3600)
+	}
+	return angles.NormalizeDegrees(280.46061837 + 360.98564736629*(jd-julian.J2000) +
+		0.000387933*t*t - t*t*t/38710000)
+}
+
+// GAST returns the Greenwich apparent sidereal time in degrees, adding the equation of the
+// equinoxes to the mean sidereal time of the model
+func GAST(jd float64, options ...coordinates.ModelOption) float64 {
+	m := coordinates.ModelOf(options)
+	t := julian.Centuries(jd)
+	longitude, obliquity := m.Nutation(t)
+	equinoxes := longitude * math.Cos((m.MeanObliquity(t)+obliquity)*constants.Rad)
+	return angles.NormalizeDegrees(GMST(jd, options...) + equinoxes)
+}
+
+// LocalMeanSiderealTime returns the local mean sidereal time in degrees for a Julian date and
+// an east-positive longitude in degrees
+func LocalMeanSiderealTime(jd, longitude float64, options ...coordinates.ModelOption) float64 {
+	return angles.NormalizeDegrees(GMST(jd, options...) + longitude)
+}
+
+// LocalApparentSiderealTime returns the local apparent sidereal time in degrees for a Julian
+// date and an east-positive longitude in degrees
+func LocalApparentSiderealTime(jd, longitude float64, options ...coordinates.ModelOption) float64 {
+	return angles.NormalizeDegrees(GAST(jd, options...) + longitude)
+}
+
+// GMSTFromTime returns the Greenwich mean sidereal time in degrees at t
+func GMSTFromTime(t time.Time, options ...coordinates.ModelOption) float64 {
+	return GMST(julian.FromTime(t), options...)
+}
@@ -0,0 +1,13 @@
+package sidereal_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSidereal(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Sidereal Suite")
+}
@@ -0,0 +1,60 @@
+package sidereal
+
+import (
+	"math"
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/testutil/reference"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sidereal", func() {
+	It("should match Meeus example 12.a (1987 April 10, 0h UT)", func() {
+		Expect(GMST(2446895.5)).To(BeNumerically("~", 197.693195, 1e-6))
+	})
+
+	It("should match Meeus example 12.b (1987 April 10, 19h21m UT)", func() {
+		Expect(GMST(2446896.30625)).To(BeNumerically("~", 128.7378734, 1e-6))
+	})
+
+	It("should add the observer's longitude", func() {
+		Expect(LocalMeanSiderealTime(2446895.5, -77.065)).To(BeNumerically("~", 120.628195, 1e-6))
+		Expect(LocalMeanSiderealTime(2446895.5, 180)).To(BeNumerically("~", 17.693195, 1e-6))
+	})
+
+	It("should accept times", func() {
+		t := time.Date(1987, 4, 10, 19, 21, 0, 0, time.UTC)
+		Expect(GMSTFromTime(t)).To(BeNumerically("~", 128.7378734, 1e-5))
+	})
+
+	Describe("IAU 2006 model", func() {
+		It("should start the Earth rotation angle at its J2000.0 value", func() {
+			Expect(ERA(2451545.0)).To(BeNumerically("~", 280.46061837504, 1e-9))
+			Expect(ERA(2451546.0) - ERA(2451545.0)).To(BeNumerically("~", 360*0.00273781191135448, 1e-9))
+		})
+
+		It("should agree with the classical GMST within the precession-rate correction", func() {
+			Expect(GMST(2446895.5, coordinates.WithModel(coordinates.IAU2006))).To(BeNumerically("~", 197.693195, 2e-5))
+			Expect(GMST(2446896.30625, coordinates.WithModel(coordinates.IAU2006))).To(BeNumerically("~", GMST(2446896.30625), 2e-5))
+		})
+
+		It("should match the apparent sidereal time of Meeus example 12.a", func() {
+			Expect(GAST(2446895.5)).To(BeNumerically("~", 197.692229, 3e-5))
+			Expect(GAST(2446895.5, coordinates.WithModel(coordinates.IAU2006))).To(BeNumerically("~", 197.692229, 3e-5))
+			Expect(LocalApparentSiderealTime(2446895.5, 10, coordinates.WithModel(coordinates.IAU2006))).To(BeNumerically("~", 207.692229, 3e-5))
+		})
+	})
+})
+
+var _ = Describe("Reference sidereal times", func() {
+	for _, v := range reference.SiderealTimes() {
+		It("should match "+v.Source, func() {
+			Expect(GMST(v.JD)).To(BeNumerically("~", v.GMST, 1e-6))
+			if !math.IsNaN(v.GAST) {
+				Expect(GAST(v.JD)).To(BeNumerically("~", v.GAST, 3e-5))
+			}
+		})
+	}
+})
@@ -0,0 +1,112 @@
+package sky
+
+import (
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/catalog"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/lunar"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/planets"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	"github.com/ocrosby/astronomy/pkg/solar"
+	"github.com/ocrosby/astronomy/pkg/vectors"
+	"math"
+	"time"
+)
+
+// SunMagnitude is the apparent visual magnitude of the Sun
+const SunMagnitude = -26.74
+
+// rotation is a 3×3 matrix stored by columns
+type rotation [3]vectors.Vector3D
+
+// apply multiplies a vector by the matrix
+func (r rotation) apply(v vectors.Vector3D) vectors.Vector3D {
+	return r[0].ScalarMultiply(v.X).Add(r[1].ScalarMultiply(v.Y)).Add(r[2].ScalarMultiply(v.Z))
+}
+
+// epoch holds the quantities shared by every object of a frame
+type epoch struct {
+	obs         observer.Observer
+	t           float64 // Julian centuries (TT) since J2000.0
+	lst         float64 // local apparent sidereal time in degrees
+	toDate      rotation
+	earth       vectors.Vector3D // heliocentric Earth, J2000 ecliptic, AU
+	sunPosition coordinates.Equatorial
+}
+
+// newEpoch precomputes the shared quantities for an observer at t (UTC)
+func newEpoch(t time.Time, obs observer.Observer) *epoch {
+	e := &epoch{obs: obs, t: julian.Centuries(astrotime.TT(t))}
+	e.lst = sidereal.LocalApparentSiderealTime(julian.FromTime(t), obs.Longitude)
+	e.toDate = trueOfDate(e.t)
+	e.earth = planets.Heliocentric(planets.Earth, e.t)
+	e.sunPosition = solar.ApparentPosition(e.t)
+	return e
+}
+
+// trueOfDate returns the rotation from J2000 equatorial axes to the true equator and equinox
+// of Julian centuries t, built by carrying the axes through precession and nutation
+func trueOfDate(t float64) rotation {
+	obliquity := coordinates.MeanObliquity(t)
+	longitude, deltaObliquity := coordinates.Nutation(t)
+	var r rotation
+	for i, axis := range [3]vectors.Vector3D{{X: 1}, {Y: 1}, {Z: 1}} {
+		ecliptic := coordinates.Precess(coordinates.EquatorialFromVector(axis), 0, t).ToEcliptic(obliquity)
+		ecliptic.Longitude += longitude
+		r[i] = ecliptic.ToEquatorial(obliquity + deltaObliquity).Vector()
+	}
+	return r
+}
+
+// horizontal returns the refracted horizontal position of an apparent place, lowered by a
+// horizontal parallax in degrees
+func (e *epoch) horizontal(position coordinates.Equatorial, parallax float64) coordinates.Horizontal {
+	h := position.ToHorizontal(e.obs.Latitude, e.lst)
+	h.Altitude -= parallax * math.Cos(h.Altitude*constants.Rad)
+	h.Altitude += e.obs.RefractionAt(h.Altitude)
+	return h
+}
+
+// sun returns the Sun
+func (e *epoch) sun() Object {
+	return Object{Kind: Sun, Label: "Sun", Equatorial: e.sunPosition, Horizontal: e.horizontal(e.sunPosition, 0),
+		Magnitude: SunMagnitude, Distance: solar.Distance(e.t)}
+}
+
+// moon returns the Moon, with its altitude corrected for diurnal parallax
+func (e *epoch) moon() Object {
+	position := lunar.ApparentPosition(e.t)
+	phase := 180 - coordinates.Separation(position, e.sunPosition)
+	return Object{Kind: Moon, Label: "Moon", Equatorial: position, Horizontal: e.horizontal(position, lunar.Parallax(e.t)),
+		Magnitude: -12.73 + 0.026*math.Abs(phase) + 4e-9*math.Pow(phase, 4),
+		Distance:  lunar.Distance(e.t) / constants.AU}
+}
+
+// planets returns the seven planets other than the Earth
+func (e *epoch) planets() []Object {
+	objects := make([]Object, 0, planets.Neptune)
+	for p := planets.Mercury; p <= planets.Neptune; p++ {
+		if p == planets.Earth {
+			continue
+		}
+		g := planets.ObserveFrom(p, e.earth, e.t)
+		position := coordinates.EquatorialFromVector(e.toDate.apply(g.Equatorial().Vector()))
+		objects = append(objects, Object{Kind: Planet, Label: p.String(), Equatorial: position,
+			Horizontal: e.horizontal(position, 0), Magnitude: g.Magnitude(), Distance: g.Distance()})
+	}
+	return objects
+}
+
+// star returns a catalog star, labelled by name or else by ID
+func (e *epoch) star(s catalog.Star) Object {
+	label := s.Name
+	if label == "" {
+		label = s.ID
+	}
+	position := coordinates.EquatorialFromVector(e.toDate.apply(coordinates.Equatorial{RA: s.RA, Dec: s.Dec}.Vector()))
+	return Object{Kind: Star, Label: label, Equatorial: position, Horizontal: e.horizontal(position, 0),
+		Magnitude: s.Magnitude}
+}
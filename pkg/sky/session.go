@@ -0,0 +1,110 @@
+package sky
+
+import (
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/lunar"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	"time"
+)
+
+// DefaultRefreshInterval is how long a session reuses its slowly varying quantities unless
+// another interval is given
+const DefaultRefreshInterval = time.Hour
+
+// WithRefreshInterval sets how long a Session reuses precession, nutation and solar-system
+// positions before recomputing them; Snapshot ignores it
+func WithRefreshInterval(interval time.Duration) Option {
+	return func(o *options) { o.refresh = interval }
+}
+
+// cachedBody is a solar-system body at both ends of a session's interval
+type cachedBody struct {
+	start, end                 Object
+	startParallax, endParallax float64 // horizontal parallax in degrees
+}
+
+// Session produces frames for interactive display at a fixed location. Precession, nutation,
+// the star positions of date and the solar-system positions are computed once per refresh
+// interval; each frame only interpolates the bodies, advances sidereal time and converts to
+// altitude and azimuth.
+//
+// Solar-system bodies are interpolated linearly between the ends of the interval. With the
+// default one-hour interval this departs from Snapshot by under 1" for the Moon and under 0.1"
+// for the Sun and planets; the error grows with the square of the interval, so ten minutes
+// keeps the Moon within 0.03". Stars and the frame rotation are held fixed over the interval,
+// which costs under 0.01" per hour of precession. A Session is not safe for concurrent use.
+type Session struct {
+	obs      observer.Observer
+	options  options
+	interval time.Duration
+	start    time.Time
+	epoch    *epoch
+	bodies   []cachedBody
+	stars    []Object
+}
+
+// NewSession creates a session for an observer
+func NewSession(obs observer.Observer, opts ...Option) *Session {
+	o := newOptions(opts)
+	interval := o.refresh
+	if interval <= 0 {
+		interval = DefaultRefreshInterval
+	}
+	return &Session{obs: obs, options: o, interval: interval}
+}
+
+// Frame returns the frame at t, refreshing the cached quantities when t falls outside the
+// current interval
+func (s *Session) Frame(t time.Time) Frame {
+	if s.epoch == nil || t.Before(s.start) || t.After(s.start.Add(s.interval)) {
+		s.refresh(t)
+	}
+	s.epoch.lst = sidereal.LocalApparentSiderealTime(julian.FromTime(t), s.obs.Longitude)
+	f := float64(t.Sub(s.start)) / float64(s.interval)
+
+	objects := make([]Object, 0, len(s.bodies)+len(s.stars))
+	for _, b := range s.bodies {
+		object := b.start
+		object.Equatorial = coordinates.EquatorialFromVector(
+			b.start.Equatorial.Vector().ScalarMultiply(1 - f).Add(b.end.Equatorial.Vector().ScalarMultiply(f)))
+		object.Magnitude = lerp(b.start.Magnitude, b.end.Magnitude, f)
+		object.Distance = lerp(b.start.Distance, b.end.Distance, f)
+		object.Horizontal = s.epoch.horizontal(object.Equatorial, lerp(b.startParallax, b.endParallax, f))
+		objects = append(objects, object)
+	}
+	for _, star := range s.stars {
+		star.Horizontal = s.epoch.horizontal(star.Equatorial, 0)
+		objects = append(objects, star)
+	}
+	return s.options.frame(s.obs, t, s.epoch.lst, objects)
+}
+
+// refresh recomputes the slowly varying quantities for the interval starting at t
+func (s *Session) refresh(t time.Time) {
+	s.start = t
+	s.epoch = newEpoch(t, s.obs)
+	end := newEpoch(t.Add(s.interval), s.obs)
+
+	startBodies := append([]Object{s.epoch.sun(), s.epoch.moon()}, s.epoch.planets()...)
+	endBodies := append([]Object{end.sun(), end.moon()}, end.planets()...)
+	s.bodies = s.bodies[:0]
+	for i := range startBodies {
+		b := cachedBody{start: startBodies[i], end: endBodies[i]}
+		if b.start.Kind == Moon {
+			b.startParallax, b.endParallax = lunar.Parallax(s.epoch.t), lunar.Parallax(end.t)
+		}
+		s.bodies = append(s.bodies, b)
+	}
+
+	s.stars = s.stars[:0]
+	for _, star := range s.options.stars {
+		s.stars = append(s.stars, s.epoch.star(star))
+	}
+}
+
+// lerp interpolates linearly from a to b
+func lerp(a, b, f float64) float64 {
+	return a + (b-a)*f
+}
@@ -0,0 +1,59 @@
+package sky
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Session", func() {
+	london := observer.NewObserver(51.4779, -0.0015)
+	start := time.Date(2024, 1, 15, 21, 0, 0, 0, time.UTC)
+
+	// expectCloseTo compares every object of a frame with the same object in a full snapshot
+	expectCloseTo := func(frame, reference Frame, arcseconds float64) {
+		Expect(frame.Objects).To(HaveLen(len(reference.Objects)))
+		for _, o := range frame.Objects {
+			r, ok := reference.Find(o.Label)
+			Expect(ok).To(BeTrue(), o.Label)
+			Expect(coordinates.Separation(o.Equatorial, r.Equatorial)*3600).To(BeNumerically("<", arcseconds), o.Label)
+			Expect(o.Horizontal.Altitude).To(BeNumerically("~", r.Horizontal.Altitude, arcseconds/3600), o.Label)
+			Expect(o.Horizontal.Azimuth).To(BeNumerically("~", r.Horizontal.Azimuth, 10*arcseconds/3600), o.Label)
+		}
+	}
+
+	It("should stay within the documented bound of a full snapshot over a night", func() {
+		session := NewSession(london, WithBelowHorizon(), WithMagnitudeLimit(10))
+		for minutes := 0; minutes <= 10*60; minutes += 7 {
+			t := start.Add(time.Duration(minutes) * time.Minute)
+			expectCloseTo(session.Frame(t), Snapshot(london, t, WithBelowHorizon(), WithMagnitudeLimit(10)), 1)
+		}
+	})
+
+	It("should tighten with a shorter refresh interval", func() {
+		session := NewSession(london, WithBelowHorizon(), WithRefreshInterval(10*time.Minute))
+		for seconds := 0; seconds <= 3600; seconds += 97 {
+			t := start.Add(time.Duration(seconds) * time.Second)
+			expectCloseTo(session.Frame(t), Snapshot(london, t, WithBelowHorizon()), 0.03)
+		}
+	})
+
+	It("should refresh when time runs backwards", func() {
+		session := NewSession(london)
+		session.Frame(start)
+		earlier := start.Add(-3 * time.Hour)
+		frame := session.Frame(earlier)
+		Expect(frame.Time).To(Equal(earlier))
+		expectCloseTo(frame, Snapshot(london, earlier), 1e-6)
+	})
+
+	It("should apply the horizon and magnitude limits each frame", func() {
+		frame := NewSession(london).Frame(start)
+		for _, o := range frame.Objects {
+			Expect(o.Horizontal.Altitude).To(BeNumerically(">=", 0), o.Label)
+		}
+	})
+})
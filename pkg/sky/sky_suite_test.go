@@ -0,0 +1,13 @@
+package sky_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSky(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Sky Suite")
+}
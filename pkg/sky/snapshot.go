@@ -0,0 +1,126 @@
+package sky
+
+import (
+	"github.com/ocrosby/astronomy/pkg/catalog"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"sort"
+	"time"
+)
+
+// DefaultMagnitudeLimit is the faintest magnitude included in a frame unless another is given
+const DefaultMagnitudeLimit = 6.0
+
+// Kind classifies the objects of a frame
+type Kind int
+
+const (
+	Sun Kind = iota
+	Moon
+	Planet
+	Star
+)
+
+// String returns the name of the kind
+func (k Kind) String() string {
+	return [...]string{"Sun", "Moon", "Planet", "Star"}[k]
+}
+
+// Object is one body as drawn in a frame
+type Object struct {
+	Kind       Kind
+	Label      string
+	Equatorial coordinates.Equatorial // geocentric right ascension and declination of date
+	Horizontal coordinates.Horizontal // topocentric and refracted position seen by the observer
+	Magnitude  float64                // apparent visual magnitude
+	Distance   float64                // distance from the Earth in AU, zero for stars
+}
+
+// Frame is everything a planetarium display needs at one instant, brightest object first
+type Frame struct {
+	Time              time.Time
+	Observer          observer.Observer
+	LocalSiderealTime float64 // local apparent sidereal time in degrees
+	Objects           []Object
+}
+
+// Find returns the object with the given label
+func (f Frame) Find(label string) (Object, bool) {
+	for _, o := range f.Objects {
+		if o.Label == label {
+			return o, true
+		}
+	}
+	return Object{}, false
+}
+
+// options holds the settings of a snapshot
+type options struct {
+	magnitudeLimit float64
+	belowHorizon   bool
+	stars          []catalog.Star
+	refresh        time.Duration
+}
+
+// Option configures a snapshot
+type Option func(*options)
+
+// WithMagnitudeLimit excludes objects fainter than limit; the Sun and Moon are always included
+func WithMagnitudeLimit(limit float64) Option {
+	return func(o *options) { o.magnitudeLimit = limit }
+}
+
+// WithBelowHorizon includes objects below the horizon
+func WithBelowHorizon() Option {
+	return func(o *options) { o.belowHorizon = true }
+}
+
+// WithStars replaces the embedded bright stars with another list; positions are taken at the
+// catalog epoch without proper motion
+func WithStars(stars []catalog.Star) Option {
+	return func(o *options) { o.stars = stars }
+}
+
+// newOptions applies options over the defaults
+func newOptions(opts []Option) options {
+	o := options{magnitudeLimit: DefaultMagnitudeLimit}
+	for _, apply := range opts {
+		apply(&o)
+	}
+	if o.stars == nil {
+		o.stars = catalog.BrightStars()
+	}
+	return o
+}
+
+// Snapshot returns the Sun, Moon, planets and stars visible to obs at t. Precession, nutation,
+// sidereal time and the Earth's position are computed once and shared by every object, so a
+// frame costs little more than a rotation per star. Planet positions are good to about an
+// arcminute and stars to about 20" (annual aberration is neglected).
+func Snapshot(obs observer.Observer, t time.Time, opts ...Option) Frame {
+	o := newOptions(opts)
+	e := newEpoch(t, obs)
+	objects := append([]Object{e.sun(), e.moon()}, e.planets()...)
+	for _, s := range o.stars {
+		objects = append(objects, e.star(s))
+	}
+	return o.frame(obs, t, e.lst, objects)
+}
+
+// frame keeps the objects that pass the horizon and magnitude limits, brightest first
+func (o options) frame(obs observer.Observer, t time.Time, lst float64, objects []Object) Frame {
+	frame := Frame{Time: t, Observer: obs, LocalSiderealTime: lst}
+	for _, object := range objects {
+		if object.Kind > Moon && object.Magnitude > o.magnitudeLimit {
+			continue
+		}
+		if !o.belowHorizon && object.Horizontal.Altitude < 0 {
+			continue
+		}
+		frame.Objects = append(frame.Objects, object)
+	}
+	sort.SliceStable(frame.Objects, func(i, j int) bool {
+		return frame.Objects[i].Magnitude < frame.Objects[j].Magnitude
+	})
+	return frame
+}
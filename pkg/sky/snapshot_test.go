@@ -0,0 +1,90 @@
+package sky
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/astrometry"
+	"github.com/ocrosby/astronomy/pkg/catalog"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/lunar"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/planets"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Snapshot", func() {
+	london := observer.NewObserver(51.4779, -0.0015)
+	// a winter evening with Jupiter and Orion up and the Sun set
+	evening := time.Date(2024, 1, 15, 21, 0, 0, 0, time.UTC)
+
+	It("should list only objects above the horizon, brightest first", func() {
+		frame := Snapshot(london, evening)
+		Expect(frame.Objects).NotTo(BeEmpty())
+		for i, o := range frame.Objects {
+			Expect(o.Horizontal.Altitude).To(BeNumerically(">=", 0), o.Label)
+			if i > 0 {
+				Expect(o.Magnitude).To(BeNumerically(">=", frame.Objects[i-1].Magnitude))
+			}
+		}
+		_, ok := frame.Find("Sun")
+		Expect(ok).To(BeFalse())
+		jupiter, ok := frame.Find("Jupiter")
+		Expect(ok).To(BeTrue())
+		Expect(jupiter.Kind).To(Equal(Planet))
+		Expect(jupiter.Magnitude).To(BeNumerically("~", -2.5, 0.2))
+		_, ok = frame.Find("Betelgeuse")
+		Expect(ok).To(BeTrue())
+	})
+
+	It("should include every body with WithBelowHorizon and a faint enough limit", func() {
+		frame := Snapshot(london, evening, WithBelowHorizon(), WithMagnitudeLimit(8))
+		Expect(frame.Objects).To(HaveLen(2 + 7 + len(catalog.BrightStars())))
+		Expect(frame.Objects[0].Label).To(Equal("Sun"))
+		Expect(frame.Objects[1].Label).To(Equal("Moon"))
+	})
+
+	It("should apply the magnitude limit to planets and stars only", func() {
+		frame := Snapshot(london, evening, WithBelowHorizon(), WithMagnitudeLimit(0))
+		for _, o := range frame.Objects {
+			if o.Kind != Sun && o.Kind != Moon {
+				Expect(o.Magnitude).To(BeNumerically("<=", 0), o.Label)
+			}
+		}
+		_, ok := frame.Find("Moon")
+		Expect(ok).To(BeTrue())
+	})
+
+	It("should agree with the full apparent place reduction for stars", func() {
+		frame := Snapshot(london, evening, WithBelowHorizon())
+		for _, s := range catalog.BrightStars()[:10] {
+			object, ok := frame.Find(s.Name)
+			Expect(ok).To(BeTrue())
+			place := astrometry.ApparentPlace(astrometry.FromStar(s), evening, london)
+			Expect(coordinates.Separation(object.Equatorial, place.Apparent)).To(BeNumerically("<", 0.01), s.Name)
+			Expect(object.Horizontal.Altitude).To(BeNumerically("~", place.Observed.Altitude, 0.01), s.Name)
+		}
+	})
+
+	It("should agree with the planet and lunar theories", func() {
+		frame := Snapshot(london, evening, WithBelowHorizon())
+		t := julian.Centuries(julian.FromTime(evening.Add(69 * time.Second)))
+		mars, _ := frame.Find("Mars")
+		Expect(coordinates.Separation(mars.Equatorial, planets.ApparentPosition(planets.Mars, t))).To(BeNumerically("<", 1e-4))
+		moon, _ := frame.Find("Moon")
+		Expect(moon.Distance).To(BeNumerically("~", lunar.Distance(t)/1.495978707e8, 1e-6))
+	})
+
+	It("should label stars without a name by their ID", func() {
+		frame := Snapshot(london, evening, WithBelowHorizon(),
+			WithStars([]catalog.Star{{ID: "HIP 1", RA: 0, Dec: 60, Magnitude: 5}}))
+		Expect(frame.Objects).To(HaveLen(9)) // Neptune is fainter than the default limit
+		_, ok := frame.Find("HIP 1")
+		Expect(ok).To(BeTrue())
+	})
+
+	It("should name the kinds", func() {
+		Expect(Moon.String()).To(Equal("Moon"))
+	})
+})
@@ -0,0 +1,73 @@
+package solar
+
+import (
+	"github.com/ocrosby/astronomy/pkg/accuracy"
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/internal/timescale"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"time"
+)
+
+// Algorithm selects the theory used by Position
+type Algorithm int
+
+const (
+	Meeus Algorithm = iota // low-precision theory of Meeus ch. 25, about 0.01°
+	NOAA                   // NOAA fractional-year series, within about half a degree
+)
+
+// String returns the name of the algorithm
+func (a Algorithm) String() string {
+	return [...]string{"Meeus", "NOAA"}[a]
+}
+
+// Algorithms returns the solar theories from the most to the least accurate
+func Algorithms() []Algorithm {
+	return []Algorithm{Meeus, NOAA}
+}
+
+// Accuracy returns the expected error of the apparent positions the algorithm gives
+func (a Algorithm) Accuracy() accuracy.Accuracy {
+	if a == NOAA {
+		return accuracy.Accuracy{Name: a.String(), Angle: 1800, FirstYear: 1901, LastYear: 2099,
+			Source: "NOAA General Solar Position calculations (fractional-year Fourier series)"}
+	}
+	return accuracy.Accuracy{Name: a.String(), Angle: 36, FirstYear: 1800, LastYear: 2200,
+		Source: "Meeus, Astronomical Algorithms, ch. 25 (low-precision theory)"}
+}
+
+// aberrationConstant is the mean annual aberration in longitude used to relate the equation of
+// time to right ascension (Meeus 28.3)
+const aberrationConstant = 0.0057183
+
+// positionOptions holds the settings of Position
+type positionOptions struct {
+	algorithm Algorithm
+}
+
+// PositionOption configures Position
+type PositionOption func(*positionOptions)
+
+// WithAlgorithm selects the solar theory
+func WithAlgorithm(a Algorithm) PositionOption {
+	return func(o *positionOptions) { o.algorithm = a }
+}
+
+// Position returns the apparent right ascension and declination of the Sun at t, a UTC instant
+// converted to TT, using the Meeus theory unless options say otherwise
+func Position(t time.Time, options ...PositionOption) coordinates.Equatorial {
+	settings := positionOptions{algorithm: Meeus}
+	for _, option := range options {
+		option(&settings)
+	}
+
+	centuries := julian.Centuries(timescale.TT(t))
+	if settings.algorithm == NOAA {
+		gamma := FractionalYear(t.UTC())
+		ra := MeanLongitude(centuries) - aberrationConstant - EquationOfTime(gamma)/LongitudeFactor
+		return coordinates.Equatorial{RA: angles.NormalizeDegrees(ra), Dec: SolarDeclination(gamma) * constants.Deg}
+	}
+	return ApparentPosition(centuries)
+}
@@ -0,0 +1,45 @@
+package solar
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/internal/timescale"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Position", func() {
+	t := time.Date(1992, 10, 13, 0, 0, 0, 0, time.UTC)
+
+	It("should use the Meeus theory by default", func() {
+		Expect(Position(t)).To(Equal(ApparentPosition(julian.Centuries(timescale.TT(t)))))
+		Expect(Position(t, WithAlgorithm(Meeus))).To(Equal(Position(t)))
+	})
+
+	It("should agree with the NOAA series to within half a degree", func() {
+		meeus := Position(t)
+		noaa := Position(t, WithAlgorithm(NOAA))
+		Expect(coordinates.Separation(meeus, noaa)).To(BeNumerically("<", 0.5))
+		Expect(NOAA.String()).To(Equal("NOAA"))
+	})
+})
+
+var _ = Describe("Algorithm accuracy", func() {
+	It("should rank the theories and let callers choose by requirement", func() {
+		Expect(Algorithms()).To(Equal([]Algorithm{Meeus, NOAA}))
+		Expect(Meeus.Accuracy().Angle).To(BeNumerically("<", NOAA.Accuracy().Angle))
+		Expect(NOAA.Accuracy().Covers(2150)).To(BeFalse())
+		Expect(NOAA.Accuracy().String()).To(HavePrefix("NOAA: 30'"))
+	})
+
+	It("should stay within its stated accuracy of the reference position", func() {
+		t := time.Date(1992, 10, 13, 0, 0, 0, 0, time.UTC)
+		reference := ApparentPosition(julian.Centuries(timescale.TT(t)))
+		for _, a := range Algorithms() {
+			p := Position(t, WithAlgorithm(a))
+			Expect(coordinates.Separation(p, reference) * 3600).To(BeNumerically("<=", a.Accuracy().Angle))
+		}
+	})
+})
@@ -0,0 +1,23 @@
+package solar_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/solar"
+)
+
+func ExampleRiseSet() {
+	greenwich := observer.NewObserver(51.4769, -0.0005)
+	result, err := solar.RiseSet(time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC), greenwich)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("rise", result.Rise.Format("15:04"), "azimuth", int(result.RiseAzimuth))
+	fmt.Println("set ", result.Set.Format("15:04"), "azimuth", int(result.SetAzimuth))
+	// Output:
+	// rise 03:42 azimuth 48
+	// set  20:21 azimuth 311
+}
@@ -0,0 +1,117 @@
+package solar
+
+import (
+	"math"
+	"sort"
+)
+
+// limbAnnuli is the number of rings used to integrate the intensity over the disk
+const limbAnnuli = 500
+
+// limbCoefficient holds the coefficients of the quadratic limb-darkening law at one wavelength
+type limbCoefficient struct {
+	wavelength float64 // nm
+	u, v       float64
+}
+
+// limbCoefficients are the u2, v2 coefficients of Allen's Astrophysical Quantities (§81) for
+// I(μ)/I(1) = 1 - u - v + uμ + vμ², from the near ultraviolet to the thermal infrared
+var limbCoefficients = []limbCoefficient{
+	{300, 0.74, 0.20},
+	{320, 0.88, 0.03},
+	{350, 0.98, -0.10},
+	{370, 1.03, -0.16},
+	{380, 0.92, -0.05},
+	{400, 0.91, -0.05},
+	{450, 0.99, -0.17},
+	{500, 0.97, -0.22},
+	{550, 0.93, -0.23},
+	{600, 0.88, -0.23},
+	{800, 0.73, -0.22},
+	{1000, 0.64, -0.20},
+	{1500, 0.57, -0.21},
+	{2000, 0.48, -0.18},
+	{3000, 0.35, -0.12},
+	{5000, 0.22, -0.07},
+	{10000, 0.15, -0.07},
+}
+
+// LimbCoefficients returns the coefficients u and v of the quadratic limb-darkening law at a
+// wavelength in nm, interpolated linearly in the tabulated range 300-10000 nm and held at the
+// end values outside it
+func LimbCoefficients(wavelength float64) (u, v float64) {
+	i := sort.Search(len(limbCoefficients), func(i int) bool {
+		return limbCoefficients[i].wavelength >= wavelength
+	})
+	switch i {
+	case 0:
+		return limbCoefficients[0].u, limbCoefficients[0].v
+	case len(limbCoefficients):
+		last := limbCoefficients[i-1]
+		return last.u, last.v
+	}
+	lo, hi := limbCoefficients[i-1], limbCoefficients[i]
+	f := (wavelength - lo.wavelength) / (hi.wavelength - lo.wavelength)
+	return lo.u + f*(hi.u-lo.u), lo.v + f*(hi.v-lo.v)
+}
+
+// LimbDarkening returns the intensity of the solar disk relative to its centre where the line
+// of sight meets the surface at cos θ = mu, for a wavelength in nm. mu is 1 at the centre of
+// the disk and 0 at the limb; values outside [0, 1] give 0.
+func LimbDarkening(mu, wavelength float64) float64 {
+	if mu < 0 || mu > 1 {
+		return 0
+	}
+	u, v := LimbCoefficients(wavelength)
+	return 1 - u*(1-mu) - v*(1-mu*mu)
+}
+
+// DiskIntensity returns the relative intensity at a distance r from the centre of the disk in
+// units of its radius (0 at the centre, 1 at the limb)
+func DiskIntensity(r, wavelength float64) float64 {
+	if r < 0 || r > 1 {
+		return 0
+	}
+	return LimbDarkening(math.Sqrt(1-r*r), wavelength)
+}
+
+// MeanDiskIntensity returns the intensity averaged over the disk relative to the central
+// intensity, 1 - u/3 - v/2 for the quadratic law: the factor converting a central-intensity
+// exposure to one for the whole disk
+func MeanDiskIntensity(wavelength float64) float64 {
+	u, v := LimbCoefficients(wavelength)
+	return 1 - u/3 - v/2
+}
+
+// EclipseFlux returns the fraction of the Sun's disk-integrated flux that remains visible when
+// the Moon's disk, of radius moonRadius, is centred separation from the Sun's centre, of radius
+// sunRadius. The three share any angular unit. The limb darkening at the wavelength in nm is
+// included, so a central eclipse removes more light than the eclipsed area suggests.
+func EclipseFlux(separation, sunRadius, moonRadius, wavelength float64) float64 {
+	d, m := separation/sunRadius, moonRadius/sunRadius
+	if d >= 1+m {
+		return 1
+	}
+
+	var total, hidden float64
+	dr := 1.0 / limbAnnuli
+	for i := 0; i < limbAnnuli; i++ {
+		r := (float64(i) + 0.5) * dr
+		ring := DiskIntensity(r, wavelength) * r
+		total += ring
+		hidden += ring * coveredFraction(r, d, m)
+	}
+	return 1 - hidden/total
+}
+
+// coveredFraction returns the fraction of the circle of radius r about the origin that lies
+// inside the disk of radius m centred d away
+func coveredFraction(r, d, m float64) float64 {
+	switch {
+	case m >= d+r:
+		return 1
+	case d >= r+m || r >= d+m:
+		return 0
+	}
+	return math.Acos((r*r+d*d-m*m)/(2*r*d)) / math.Pi
+}
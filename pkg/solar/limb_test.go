@@ -0,0 +1,40 @@
+package solar
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Limb darkening", func() {
+	It("should darken the visual limb to about 30% of the centre", func() {
+		Expect(LimbDarkening(1, 550)).To(Equal(1.0))
+		Expect(LimbDarkening(0, 550)).To(BeNumerically("~", 0.30, 0.01))
+		Expect(LimbDarkening(-0.1, 550)).To(BeZero())
+		Expect(DiskIntensity(0, 550)).To(Equal(1.0))
+		Expect(DiskIntensity(1, 550)).To(Equal(LimbDarkening(0, 550)))
+	})
+
+	It("should darken the limb less at longer wavelengths", func() {
+		Expect(LimbDarkening(0.2, 400)).To(BeNumerically("<", LimbDarkening(0.2, 550)))
+		Expect(LimbDarkening(0.2, 550)).To(BeNumerically("<", LimbDarkening(0.2, 2000)))
+		u, v := LimbCoefficients(575)
+		Expect(u).To(BeNumerically("~", 0.905, 1e-9))
+		Expect(v).To(BeNumerically("~", -0.23, 1e-9))
+		u, v = LimbCoefficients(100)
+		Expect([]float64{u, v}).To(Equal([]float64{0.74, 0.20}))
+	})
+
+	It("should average the disk analytically", func() {
+		Expect(MeanDiskIntensity(550)).To(BeNumerically("~", 0.805, 1e-9))
+		Expect(EclipseFlux(0, 1, 0.999999, 550)).To(BeNumerically("~", 0, 1e-3))
+	})
+
+	It("should remove more than the eclipsed area at a central annular eclipse", func() {
+		Expect(EclipseFlux(2, 1, 0.5, 550)).To(Equal(1.0))
+		Expect(EclipseFlux(0, 1, 1.1, 550)).To(BeZero())
+		annular := EclipseFlux(0, 1, 0.5, 550)
+		Expect(annular).To(BeNumerically("<", 0.75))
+		Expect(annular).To(BeNumerically(">", 0.65))
+		Expect(EclipseFlux(0.6, 1, 0.5, 550)).To(BeNumerically(">", annular))
+	})
+})
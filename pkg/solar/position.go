@@ -0,0 +1,73 @@
+package solar
+
+import (
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"math"
+)
+
+// The functions below implement the low-precision solar theory of Meeus, Astronomical
+// Algorithms, ch. 25 (accurate to about 0.01°). The argument t is Julian centuries of
+// dynamical time since J2000.0; angles are in degrees.
+
+// PrecessionPerYear is the annual general precession in longitude used to refer the
+// Sun's longitude to the J2000.0 equinox
+const PrecessionPerYear = 0.01397
+
+// MeanLongitude returns the geometric mean longitude of the Sun
+func MeanLongitude(t float64) float64 {
+	return angles.NormalizeDegrees(280.46646 + 36000.76983*t + 0.0003032*t*t)
+}
+
+// MeanAnomaly returns the mean anomaly of the Sun
+func MeanAnomaly(t float64) float64 {
+	return angles.NormalizeDegrees(357.52911 + 35999.05029*t - 0.0001537*t*t)
+}
+
+// OrbitalEccentricity returns the eccentricity of the Earth's orbit
+func OrbitalEccentricity(t float64) float64 {
+	return 0.016708634 - 0.000042037*t - 0.0000001267*t*t
+}
+
+// EquationOfCenter returns the Sun's equation of the center
+func EquationOfCenter(t float64) float64 {
+	m := MeanAnomaly(t) * constants.Rad
+	return (1.914602-0.004817*t-0.000014*t*t)*math.Sin(m) +
+		(0.019993-0.000101*t)*math.Sin(2*m) +
+		0.000289*math.Sin(3*m)
+}
+
+// TrueLongitude returns the Sun's true geometric longitude referred to the mean equinox of date
+func TrueLongitude(t float64) float64 {
+	return angles.NormalizeDegrees(MeanLongitude(t) + EquationOfCenter(t))
+}
+
+// Distance returns the Earth-Sun distance in astronomical units
+func Distance(t float64) float64 {
+	e := OrbitalEccentricity(t)
+	nu := (MeanAnomaly(t) + EquationOfCenter(t)) * constants.Rad
+	return 1.000001018 * (1 - e*e) / (1 + e*math.Cos(nu))
+}
+
+// ApparentLongitude returns the Sun's apparent longitude, corrected for nutation and aberration
+func ApparentLongitude(t float64) float64 {
+	return angles.NormalizeDegrees(TrueLongitude(t) - 0.00569 - 0.00478*math.Sin(ascendingNode(t)))
+}
+
+// J2000Longitude returns the Sun's geometric longitude referred to the J2000.0 equinox
+func J2000Longitude(t float64) float64 {
+	return angles.NormalizeDegrees(TrueLongitude(t) - PrecessionPerYear*t*100)
+}
+
+// ApparentPosition returns the apparent right ascension and declination of the Sun
+func ApparentPosition(t float64) coordinates.Equatorial {
+	obliquity := coordinates.MeanObliquity(t) + 0.00256*math.Cos(ascendingNode(t))
+	return coordinates.Ecliptic{Longitude: ApparentLongitude(t)}.ToEquatorial(obliquity)
+}
+
+// ascendingNode returns the longitude of the Moon's ascending node in radians, used for
+// the approximate nutation and aberration terms
+func ascendingNode(t float64) float64 {
+	return (125.04 - 1934.136*t) * constants.Rad
+}
@@ -0,0 +1,35 @@
+package solar
+
+import (
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/internal/timescale"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/riseset"
+	"time"
+)
+
+// RiseSet returns the times and azimuths of sunrise and sunset on the calendar day of date in
+// date's location, when the upper limb meets the horizon under the observer's refraction model
+// and atmosphere, lowered by as much as SunriseZenith exceeds SunriseAngle. An observer whose
+// latitude or longitude is out of range is reported.
+func RiseSet(date time.Time, obs observer.Observer) (riseset.Result, error) {
+	if err := obs.Validate(); err != nil {
+		return riseset.Result{}, err
+	}
+	return riseset.OnDay(apparentPositionAt, SunriseAltitude(obs), obs, date), nil
+}
+
+// SunriseAltitude returns the altitude of the Sun's centre at which RiseSet reports sunrise and
+// sunset. SunriseAngle stands for the standard refraction and semi-diameter, so the observer's
+// refraction model takes its place and only the excess of SunriseZenith over it, such as the 6°
+// of civil twilight, lowers the horizon further.
+func SunriseAltitude(obs observer.Observer) float64 {
+	return riseset.HorizonAltitude(obs, constants.SunSemidiameter) - (SunriseZenith() - SunriseAngle)
+}
+
+// apparentPositionAt returns the Sun's apparent position at a Julian date in UT
+func apparentPositionAt(jd float64) coordinates.Equatorial {
+	return ApparentPosition(julian.Centuries(timescale.TTFromJD(jd)))
+}
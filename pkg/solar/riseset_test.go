@@ -0,0 +1,81 @@
+package solar
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/riseset"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RiseSet", func() {
+	newYork := observer.Observer{Latitude: 40.7128, Longitude: -74.0060}
+	edt := time.FixedZone("EDT", -4*3600)
+
+	It("should find the summer solstice sunrise and sunset in New York", func() {
+		r, err := RiseSet(time.Date(2024, 6, 20, 12, 0, 0, 0, edt), newYork)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r.Rise).To(BeTemporally("~", time.Date(2024, 6, 20, 5, 25, 0, 0, edt), 2*time.Minute))
+		Expect(r.Set).To(BeTemporally("~", time.Date(2024, 6, 20, 20, 31, 0, 0, edt), 2*time.Minute))
+		Expect(r.RiseAzimuth).To(BeNumerically("~", 57.8, 0.5))
+		Expect(r.SetAzimuth).To(BeNumerically("~", 302.2, 0.5))
+		Expect(r.Rise.Location()).To(Equal(edt))
+	})
+
+	It("should rise due east at the equator on the equinox", func() {
+		r, err := RiseSet(time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC), observer.Observer{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r.RiseAzimuth).To(BeNumerically("~", 90, 0.5))
+		Expect(r.SetAzimuth).To(BeNumerically("~", 270, 0.5))
+	})
+
+	It("should follow the observer's refraction model", func() {
+		day := time.Date(2024, 6, 20, 12, 0, 0, 0, edt)
+		sunrise := func(obs observer.Observer) time.Time {
+			r, err := RiseSet(day, obs)
+			Expect(err).NotTo(HaveOccurred())
+			return r.Rise
+		}
+		airless := newYork
+		airless.Refraction = coordinates.NoRefraction{}
+		// Without the 35' of horizontal refraction the Sun rises some three minutes later
+		Expect(sunrise(airless).Sub(sunrise(newYork))).To(BeNumerically("~", 3*time.Minute, time.Minute))
+		thin := observer.NewObserver(newYork.Latitude, newYork.Longitude, observer.WithPressure(600))
+		Expect(sunrise(thin)).To(BeTemporally(">", sunrise(newYork)))
+	})
+
+	It("should follow a changed sunrise zenith", func() {
+		day := time.Date(2024, 6, 20, 12, 0, 0, 0, edt)
+		standard, err := RiseSet(day, newYork)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(SunriseAltitude(newYork)).To(Equal(riseset.HorizonAltitude(newYork, constants.SunSemidiameter)))
+
+		SetSunriseZenith(96)
+		DeferCleanup(SetSunriseZenith, SunriseAngle)
+		civil, err := RiseSet(day, newYork)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(SunriseAltitude(newYork)).To(BeNumerically("~", riseset.HorizonAltitude(newYork, constants.SunSemidiameter)-(96-SunriseAngle), 1e-12))
+		// civil twilight lasts about half an hour at midsummer in New York
+		Expect(standard.Rise.Sub(civil.Rise)).To(BeNumerically("~", 33*time.Minute, 4*time.Minute))
+		Expect(civil.Set.Sub(standard.Set)).To(BeNumerically("~", 33*time.Minute, 4*time.Minute))
+	})
+
+	It("should report the midnight Sun and polar night", func() {
+		tromso := observer.Observer{Latitude: 69.65, Longitude: 18.96}
+		summer, err := RiseSet(time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC), tromso)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(summer.AlwaysUp).To(BeTrue())
+		winter, err := RiseSet(time.Date(2024, 12, 21, 0, 0, 0, 0, time.UTC), tromso)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(winter.AlwaysDown).To(BeTrue())
+	})
+
+	It("should refuse an observer off the globe", func() {
+		_, err := RiseSet(time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC), observer.Observer{Latitude: 200})
+		Expect(err).To(MatchError(angles.ErrLatitudeRange))
+	})
+})
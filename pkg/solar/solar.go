@@ -3,6 +3,7 @@ package solar
 import (
 	"github.com/ocrosby/astronomy/pkg/constants"
 	"math"
+	"sync"
 	"time"
 )
 
@@ -26,7 +27,7 @@ const (
 	DeclCoeff7 = 0.00148
 
 	// Solar calculations
-	SunriseAngle    = 90.833 // degrees
+	SunriseAngle    = 90.833 // default zenith angle of the Sun at sunrise and sunset in degrees
 	HourAngleDiv    = 4.0    // divisor for hour angle calculation
 	HourAngleOffset = 180.0  // offset for hour angle
 	TimeBase        = 720.0  // base time in minutes
@@ -92,9 +93,38 @@ func SolarAzimuth(lat, decl, zenith float64) float64 {
 	return math.Acos((math.Sin(lat*constants.Rad)*math.Cos(zenith)-math.Sin(decl))/(math.Cos(lat*constants.Rad)*math.Sin(zenith))) * constants.Deg
 }
 
-// SunriseSunsetHourAngle calculates the hour angle for sunrise or sunset
+// sunriseZenith holds the zenith angle of the Sun taken for sunrise and sunset
+var sunriseZenith = struct {
+	sync.RWMutex
+	degrees float64
+}{degrees: SunriseAngle}
+
+// SetSunriseZenith changes the zenith angle in degrees of the Sun's centre that
+// SunriseSunsetHourAngle and TerminatorCrossings take for sunrise and sunset, SunriseAngle
+// unless changed, as astronomy.SetGlobal does. It is safe for concurrent use.
+func SetSunriseZenith(degrees float64) {
+	sunriseZenith.Lock()
+	defer sunriseZenith.Unlock()
+	sunriseZenith.degrees = degrees
+}
+
+// SunriseZenith returns the zenith angle set by SetSunriseZenith
+func SunriseZenith() float64 {
+	sunriseZenith.RLock()
+	defer sunriseZenith.RUnlock()
+	return sunriseZenith.degrees
+}
+
+// SunriseSunsetHourAngle calculates the hour angle for sunrise or sunset at the zenith angle
+// of SunriseZenith
 func SunriseSunsetHourAngle(lat, decl float64) float64 {
-	return math.Acos((math.Cos(SunriseAngle*constants.Rad)/(math.Cos(lat*constants.Rad)*math.Cos(decl)) - math.Tan(lat*constants.Rad)*math.Tan(decl))) * constants.Deg
+	return ZenithHourAngle(lat, decl, SunriseZenith())
+}
+
+// ZenithHourAngle calculates the hour angle in degrees at which the Sun reaches a zenith
+// angle in degrees, for a declination in radians
+func ZenithHourAngle(lat, decl, zenith float64) float64 {
+	return math.Acos((math.Cos(zenith*constants.Rad)/(math.Cos(lat*constants.Rad)*math.Cos(decl)) - math.Tan(lat*constants.Rad)*math.Tan(decl))) * constants.Deg
 }
 
 // Sunrise calculates the UTC time of sunrise in minutes
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/testutil/reference"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -122,6 +123,12 @@ var _ = Describe("Solar", func() {
 			result := SunriseSunsetHourAngle(lat, decl)
 			Expect(math.Abs(result - expected)).To(BeNumerically("<", 1e-6))
 		})
+
+		It("follows a changed sunrise zenith", func() {
+			SetSunriseZenith(96)
+			DeferCleanup(SetSunriseZenith, SunriseAngle)
+			Expect(SunriseSunsetHourAngle(40, 0.2)).To(Equal(ZenithHourAngle(40, 0.2, 96)))
+		})
 	})
 
 	Describe("Sunrise", func() {
@@ -156,3 +163,41 @@ var _ = Describe("Solar", func() {
 		})
 	})
 })
+
+var _ = Describe("Position", func() {
+	// Meeus, Astronomical Algorithms, example 25.a: 1992 October 13.0 TD
+	const t = -0.072183436
+
+	It("should compute the orbital elements", func() {
+		Expect(MeanLongitude(t)).To(BeNumerically("~", 201.80720, 1e-5))
+		Expect(MeanAnomaly(t)).To(BeNumerically("~", 278.99397, 1e-5))
+		Expect(OrbitalEccentricity(t)).To(BeNumerically("~", 0.016711668, 1e-9))
+		Expect(EquationOfCenter(t)).To(BeNumerically("~", -1.89732, 1e-5))
+	})
+
+	It("should compute the longitude and distance", func() {
+		Expect(TrueLongitude(t)).To(BeNumerically("~", 199.90988, 1e-5))
+		Expect(Distance(t)).To(BeNumerically("~", 0.99766, 1e-5))
+		Expect(ApparentLongitude(t)).To(BeNumerically("~", 199.90895, 1e-5))
+		Expect(J2000Longitude(t)).To(BeNumerically("~", 199.90988+0.01397*7.2183436, 1e-5))
+	})
+
+	It("should compute the apparent position", func() {
+		p := ApparentPosition(t)
+		Expect(p.RA).To(BeNumerically("~", 198.38083, 1e-4))
+		Expect(p.Dec).To(BeNumerically("~", -7.78507, 1e-4))
+	})
+})
+
+var _ = Describe("Reference positions", func() {
+	for _, v := range reference.Positions("Sun") {
+		It("should match "+v.Source, func() {
+			t := v.Centuries()
+			Expect(TrueLongitude(t)).To(BeNumerically("~", v.Longitude, 1e-4))
+			Expect(Distance(t)).To(BeNumerically("~", v.Distance, 1e-5))
+			p := ApparentPosition(t)
+			Expect(p.RA).To(BeNumerically("~", v.RA, 1e-4))
+			Expect(p.Dec).To(BeNumerically("~", v.Dec, 1e-4))
+		})
+	}
+})
@@ -0,0 +1,94 @@
+package solar
+
+import (
+	"fmt"
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/events"
+	"github.com/ocrosby/astronomy/pkg/internal/timescale"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/riseset"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	"github.com/ocrosby/astronomy/pkg/vectors"
+	"math"
+	"time"
+)
+
+// terminatorStep is the sampling interval in days along a track, short against the time
+// between two crossings even for a fast aircraft at high latitude
+const terminatorStep = 1.0 / 1440
+
+// LatLon is a point on the Earth's surface in degrees
+type LatLon struct {
+	Latitude  float64 // north positive
+	Longitude float64 // east positive
+}
+
+// vector returns the unit vector of the point in Earth-fixed axes
+func (p LatLon) vector() vectors.Vector3D {
+	return coordinates.UnitVector(p.Longitude, p.Latitude)
+}
+
+// TerminatorCrossing is the moment a moving observer passes from night into day or back,
+// taking sunrise and sunset as the Sun's centre at the zenith angle of SunriseZenith, by
+// default its upper limb on the refracted horizon
+type TerminatorCrossing struct {
+	Time     time.Time
+	Position LatLon
+	Sunrise  bool // whether the observer enters daylight
+}
+
+// SubsolarPoint returns the point at which the Sun is in the zenith at t, its longitude in
+// (-180, 180]
+func SubsolarPoint(t time.Time) LatLon {
+	jd := julian.FromTime(t)
+	sun := ApparentPosition(julian.Centuries(timescale.TT(t)))
+	return LatLon{Latitude: sun.Dec, Longitude: angles.WrapSigned(sun.RA - sidereal.GAST(jd))}
+}
+
+// TerminatorCrossings returns the times at which an observer following path crosses into
+// daylight or darkness, in chronological order. path[i] is reached at times[i]; between two
+// waypoints the observer moves at constant speed along the great circle joining them, as on a
+// riseset.Track, so a route should be sampled finely enough for that to follow it.
+func TerminatorCrossings(path []LatLon, times []time.Time) ([]TerminatorCrossing, error) {
+	if len(path) != len(times) {
+		return nil, fmt.Errorf("path has %d points but %d times", len(path), len(times))
+	}
+	if len(path) < 2 {
+		return nil, fmt.Errorf("path needs at least two points, got %d", len(path))
+	}
+	track := make(riseset.Track, len(path))
+	for i, p := range path {
+		track[i] = riseset.Waypoint{Time: times[i], Observer: observer.Observer{Latitude: p.Latitude, Longitude: p.Longitude}}
+	}
+	if err := track.Validate(); err != nil {
+		return nil, err
+	}
+
+	position := func(jd float64) LatLon {
+		obs := track.At(julian.ToTime(jd))
+		return LatLon{Latitude: obs.Latitude, Longitude: obs.Longitude}
+	}
+	horizon := 90 - SunriseZenith()
+	altitude := func(jd float64) float64 {
+		return sunAltitude(position(jd), julian.ToTime(jd)) - horizon
+	}
+	start, end := julian.FromTime(times[0]), julian.FromTime(times[len(times)-1])
+	var crossings []TerminatorCrossing
+	for _, c := range events.FindCrossings(altitude, start, end, terminatorStep) {
+		crossings = append(crossings, TerminatorCrossing{
+			Time:     julian.ToTime(c.JD),
+			Position: position(c.JD),
+			Sunrise:  c.Rising,
+		})
+	}
+	return crossings, nil
+}
+
+// sunAltitude returns the geometric altitude of the Sun's centre in degrees seen from p at t
+func sunAltitude(p LatLon, t time.Time) float64 {
+	cos := p.vector().DotProduct(SubsolarPoint(t).vector())
+	return 90 - math.Acos(math.Max(-1, math.Min(1, cos)))*constants.Deg
+}
@@ -0,0 +1,71 @@
+package solar
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/observer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SubsolarPoint", func() {
+	It("should lie on the Tropic of Cancer near Greenwich at noon on the June solstice", func() {
+		p := SubsolarPoint(time.Date(2024, 6, 20, 12, 0, 0, 0, time.UTC))
+		Expect(p.Latitude).To(BeNumerically("~", 23.44, 0.01))
+		Expect(p.Longitude).To(BeNumerically("~", 0.4, 0.2))
+	})
+})
+
+var _ = Describe("TerminatorCrossings", func() {
+	day := time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC)
+
+	It("should match sunrise and sunset for an observer who stays put", func() {
+		greenwich := LatLon{Latitude: 51.4769, Longitude: 0}
+		crossings, err := TerminatorCrossings([]LatLon{greenwich, greenwich}, []time.Time{day, day.Add(24 * time.Hour)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(crossings).To(HaveLen(2))
+
+		r, err := RiseSet(day, observer.Observer{Latitude: greenwich.Latitude})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(crossings[0].Sunrise).To(BeTrue())
+		Expect(crossings[0].Time).To(BeTemporally("~", r.Rise, time.Minute))
+		Expect(crossings[1].Sunrise).To(BeFalse())
+		Expect(crossings[1].Time).To(BeTemporally("~", r.Set, time.Minute))
+	})
+
+	It("should locate the dawn on an overnight eastbound flight", func() {
+		// New York to London leaving at 02:00 UTC in December, landing after sunrise
+		start := time.Date(2024, 12, 10, 2, 0, 0, 0, time.UTC)
+		path := []LatLon{{Latitude: 40.64, Longitude: -73.78}, {Latitude: 51.47, Longitude: -0.45}}
+		crossings, err := TerminatorCrossings(path, []time.Time{start, start.Add(7*time.Hour + 30*time.Minute)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(crossings).To(HaveLen(1))
+		Expect(crossings[0].Sunrise).To(BeTrue())
+		Expect(crossings[0].Time.Hour()).To(Equal(8))
+		Expect(crossings[0].Position.Longitude).To(BeNumerically("~", -10, 5))
+		Expect(sunAltitude(crossings[0].Position, crossings[0].Time)).To(BeNumerically("~", -0.8333, 0.001))
+	})
+
+	It("should follow the great circle between waypoints", func() {
+		west, east := LatLon{Latitude: 45, Longitude: -60}, LatLon{Latitude: 45, Longitude: 60}
+		crossings, err := TerminatorCrossings([]LatLon{west, east}, []time.Time{day, day.Add(24 * time.Hour)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(crossings).NotTo(BeEmpty())
+		pole := west.vector().CrossProduct(east.vector())
+		for _, c := range crossings {
+			// the route bows north of the parallel and stays in the plane of the great circle
+			Expect(c.Position.Latitude).To(BeNumerically(">", 45))
+			Expect(c.Position.vector().DotProduct(pole)).To(BeNumerically("~", 0, 1e-9))
+		}
+	})
+
+	It("should reject malformed tracks", func() {
+		p := LatLon{Latitude: 10, Longitude: 20}
+		_, err := TerminatorCrossings([]LatLon{p}, []time.Time{day, day})
+		Expect(err).To(MatchError(ContainSubstring("1 points but 2 times")))
+		_, err = TerminatorCrossings([]LatLon{p, p}, []time.Time{day, day.Add(-time.Hour)})
+		Expect(err).To(HaveOccurred())
+		_, err = TerminatorCrossings([]LatLon{p, {Latitude: -10, Longitude: -160}}, []time.Time{day, day.Add(time.Hour)})
+		Expect(err).To(MatchError(ContainSubstring("antipodal")))
+	})
+})
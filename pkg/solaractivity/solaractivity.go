@@ -0,0 +1,245 @@
+// Package solaractivity reads the sunspot numbers published by SILSO (Royal Observatory of
+// Belgium) and derives the 13-month smoothed series and the phase of the solar cycle.
+package solaractivity
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MeanCycleLength is the mean length of the solar cycle in years
+const MeanCycleLength = 11.0
+
+// minimumWindow is the number of months either side of a smoothed minimum that must not be
+// lower, about half the shortest cycle
+const minimumWindow = 40
+
+// settle is the number of smoothed months needed either side of an extreme before it is
+// accepted, so that the ends of a series, still falling or rising, are not taken for one
+const settle = 12
+
+// CycleMinima are the decimal years of the smoothed minima that began the numbered cycles,
+// from SILSO; the minimum of cycle n is at index n-1
+var CycleMinima = []float64{
+	1755.2, 1766.5, 1775.5, 1784.7, 1798.3, 1810.6, 1823.3, 1833.9, 1843.5, 1855.9,
+	1867.2, 1878.9, 1890.2, 1902.1, 1913.6, 1923.6, 1933.8, 1944.2, 1954.3, 1964.8,
+	1976.2, 1986.8, 1996.4, 2008.9, 2019.9,
+}
+
+// Month is one monthly mean sunspot number. Missing values are NaN.
+type Month struct {
+	Year          int
+	Month         time.Month
+	Date          float64 // decimal year of the middle of the month
+	SunspotNumber float64
+	Deviation     float64 // standard deviation of the daily values
+	Observations  int
+	Definitive    bool // false while the value is provisional
+}
+
+// Series is a run of consecutive months in chronological order
+type Series []Month
+
+// ParseMonthly reads the SILSO monthly mean total sunspot number file (SN_m_tot_V2.0.csv):
+// semicolon-separated year, month, decimal date, sunspot number, standard deviation, number of
+// observations and definitive flag, with -1 marking missing values
+func ParseMonthly(r io.Reader) (Series, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = ';'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var series Series
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return series, nil
+		}
+		if err != nil {
+			return series, fmt.Errorf("reading SILSO data: %v", err)
+		}
+		line, _ := reader.FieldPos(0)
+		m, err := parseMonth(fields)
+		if err != nil {
+			return series, fmt.Errorf("line %d: %v", line, err)
+		}
+		series = append(series, m)
+	}
+}
+
+// parseMonth converts the fields of one SILSO record
+func parseMonth(fields []string) (Month, error) {
+	if len(fields) < 4 {
+		return Month{}, fmt.Errorf("%d fields, expected at least 4", len(fields))
+	}
+	number := func(i int) (float64, error) {
+		if i >= len(fields) {
+			return -1, nil
+		}
+		v, err := strconv.ParseFloat(strings.TrimSpace(fields[i]), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid field %d '%s'", i+1, fields[i])
+		}
+		return v, nil
+	}
+
+	values := make([]float64, 7)
+	for i := range values {
+		v, err := number(i)
+		if err != nil {
+			return Month{}, err
+		}
+		values[i] = v
+	}
+	if values[1] < 1 || values[1] > 12 {
+		return Month{}, fmt.Errorf("invalid month %v", values[1])
+	}
+	missing := func(v float64) float64 {
+		if v < 0 {
+			return math.NaN()
+		}
+		return v
+	}
+	return Month{
+		Year:          int(values[0]),
+		Month:         time.Month(values[1]),
+		Date:          values[2],
+		SunspotNumber: missing(values[3]),
+		Deviation:     missing(values[4]),
+		Observations:  max(int(values[5]), 0),
+		Definitive:    values[6] == 1,
+	}, nil
+}
+
+// Smoothed returns the 13-month running mean used by SILSO to define the cycle extremes: the
+// mean of the month and the five either side with the sixth either side at half weight. The
+// six months at each end, and any month whose window has a missing value, are NaN.
+func (s Series) Smoothed() Series {
+	smoothed := make(Series, len(s))
+	for i := range s {
+		smoothed[i] = s[i]
+		smoothed[i].SunspotNumber = math.NaN()
+		smoothed[i].Deviation = math.NaN()
+		if i < 6 || i+6 >= len(s) {
+			continue
+		}
+		sum := 0.5 * (s[i-6].SunspotNumber + s[i+6].SunspotNumber)
+		for j := i - 5; j <= i+5; j++ {
+			sum += s[j].SunspotNumber
+		}
+		smoothed[i].SunspotNumber = sum / 12
+	}
+	return smoothed
+}
+
+// Latest returns the last month with a value, or false if there is none
+func (s Series) Latest() (Month, bool) {
+	for i := len(s) - 1; i >= 0; i-- {
+		if !math.IsNaN(s[i].SunspotNumber) {
+			return s[i], true
+		}
+	}
+	return Month{}, false
+}
+
+// Minima returns the months at which the smoothed series reaches a cycle minimum, the lowest
+// value within 40 months either side and with a year of smoothed values on both sides
+func (s Series) Minima() []Month {
+	smoothed := s.Smoothed()
+	var minima []Month
+	for i, m := range smoothed {
+		if math.IsNaN(m.SunspotNumber) || !settled(smoothed, i) {
+			continue
+		}
+		lowest := true
+		for j := max(i-minimumWindow, 0); j <= min(i+minimumWindow, len(smoothed)-1) && lowest; j++ {
+			v := smoothed[j].SunspotNumber
+			lowest = math.IsNaN(v) || v > m.SunspotNumber || (v == m.SunspotNumber && j >= i)
+		}
+		if lowest {
+			minima = append(minima, m)
+		}
+	}
+	return minima
+}
+
+// settled reports whether the smoothed values a year either side of month i are known
+func settled(smoothed Series, i int) bool {
+	return i >= settle && i+settle < len(smoothed) &&
+		!math.IsNaN(smoothed[i-settle].SunspotNumber) && !math.IsNaN(smoothed[i+settle].SunspotNumber)
+}
+
+// Phase locates a date within the solar cycle
+type Phase struct {
+	Cycle    int     // cycle number in the SILSO numbering
+	Start    float64 // decimal year of the smoothed minimum that began the cycle
+	Elapsed  float64 // years since the minimum
+	Fraction float64 // Elapsed over MeanCycleLength
+	Maximum  float64 // decimal year of the smoothed maximum, 0 while the cycle is still rising
+	Smoothed float64 // latest smoothed sunspot number up to the date, NaN if none
+}
+
+// Rising reports whether the cycle has not yet passed its maximum
+func (p Phase) Rising() bool {
+	return p.Maximum == 0
+}
+
+// Phase returns the phase of the solar cycle at a decimal year, from the last smoothed minimum
+// in the series before it. It fails if the series has no minimum before the date.
+func (s Series) Phase(date float64) (Phase, error) {
+	var known Series
+	for _, m := range s {
+		if m.Date <= date {
+			known = append(known, m)
+		}
+	}
+
+	minima := known.Minima()
+	if len(minima) == 0 {
+		return Phase{}, fmt.Errorf("no solar minimum in the series before %.1f", date)
+	}
+	start := minima[len(minima)-1].Date
+	p := Phase{
+		Cycle:    CycleNumber(start),
+		Start:    start,
+		Elapsed:  date - start,
+		Fraction: (date - start) / MeanCycleLength,
+		Smoothed: math.NaN(),
+	}
+
+	smoothed := known.Smoothed()
+	if latest, ok := smoothed.Latest(); ok {
+		p.Smoothed = latest.SunspotNumber
+	}
+	peak := -1
+	for i, m := range smoothed {
+		if m.Date > start && !math.IsNaN(m.SunspotNumber) && (peak < 0 || m.SunspotNumber > smoothed[peak].SunspotNumber) {
+			peak = i
+		}
+	}
+	if peak >= 0 && settled(smoothed, peak) {
+		p.Maximum = smoothed[peak].Date
+	}
+	return p, nil
+}
+
+// CycleNumber returns the number of the cycle that began at a smoothed minimum, matching the
+// tabulated minima within 1.5 years and counting mean cycle lengths beyond the table
+func CycleNumber(minimum float64) int {
+	for i, m := range CycleMinima {
+		if math.Abs(minimum-m) <= 1.5 {
+			return i + 1
+		}
+	}
+	if first := CycleMinima[0]; minimum < first {
+		return int(math.Round((minimum-first)/MeanCycleLength)) + 1
+	}
+	last := CycleMinima[len(CycleMinima)-1]
+	return len(CycleMinima) + int(math.Round((minimum-last)/MeanCycleLength))
+}
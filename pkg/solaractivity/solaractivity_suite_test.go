@@ -0,0 +1,13 @@
+package solaractivity_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSolarActivity(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Solar Activity Suite")
+}
@@ -0,0 +1,107 @@
+package solaractivity
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// syntheticCycles builds a SILSO file from 2004 to the end of 2024 with minima at the starts
+// of cycles 24 and 25
+func syntheticCycles() string {
+	var b strings.Builder
+	for year := 2004; year <= 2024; year++ {
+		for month := 1; month <= 12; month++ {
+			date := float64(year) + (float64(month)-0.5)/12
+			phase := math.Mod(date-2008.9+11, 11) / 11
+			number := 180 * math.Pow(math.Sin(math.Pi*phase), 3)
+			fmt.Fprintf(&b, "%d;%02d;%.3f;%6.1f;%5.1f;%5d;1\n", year, month, date, number, 5.0, 900)
+		}
+	}
+	return b.String()
+}
+
+var _ = Describe("ParseMonthly", func() {
+	It("should read the SILSO monthly format with missing values", func() {
+		data := "1749;01;1749.042;  96.7; -1.0;   -1;1\n2024;12;2024.958; 154.5; 22.2; 1132;0\n"
+		series, err := ParseMonthly(strings.NewReader(data))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(series).To(HaveLen(2))
+		Expect(series[0].Month).To(Equal(time.January))
+		Expect(series[0].SunspotNumber).To(Equal(96.7))
+		Expect(math.IsNaN(series[0].Deviation)).To(BeTrue())
+		Expect(series[0].Observations).To(BeZero())
+		Expect(series[1].Definitive).To(BeFalse())
+		Expect(series[1].Observations).To(Equal(1132))
+	})
+
+	It("should report the line of a malformed record", func() {
+		_, err := ParseMonthly(strings.NewReader("2024;01;2024.042;10;1;1;1\n2024;13;2024.1;1;1;1;1\n"))
+		Expect(err).To(MatchError(ContainSubstring("line 2")))
+		_, err = ParseMonthly(strings.NewReader("2024;01;x;1\n"))
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Smoothing", func() {
+	It("should apply the 13-month running mean", func() {
+		var series Series
+		for i := 0; i < 13; i++ {
+			series = append(series, Month{Date: float64(i), SunspotNumber: float64(i)})
+		}
+		smoothed := series.Smoothed()
+		Expect(smoothed[6].SunspotNumber).To(BeNumerically("~", 6, 1e-12))
+		Expect(math.IsNaN(smoothed[5].SunspotNumber)).To(BeTrue())
+		Expect(series[6].SunspotNumber).To(Equal(6.0))
+
+		series[0].SunspotNumber = 24
+		Expect(series.Smoothed()[6].SunspotNumber).To(BeNumerically("~", 7, 1e-12))
+		series[1].SunspotNumber = math.NaN()
+		Expect(math.IsNaN(series.Smoothed()[6].SunspotNumber)).To(BeTrue())
+	})
+})
+
+var _ = Describe("Cycle phase", func() {
+	series, err := ParseMonthly(strings.NewReader(syntheticCycles()))
+
+	It("should find the smoothed minima", func() {
+		Expect(err).NotTo(HaveOccurred())
+		minima := series.Minima()
+		Expect(minima).To(HaveLen(2))
+		Expect(minima[0].Date).To(BeNumerically("~", 2008.9, 0.1))
+		Expect(minima[1].Date).To(BeNumerically("~", 2019.9, 0.1))
+	})
+
+	It("should place a date in the declining phase of cycle 24", func() {
+		p, err := series.Phase(2016.5)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p.Cycle).To(Equal(24))
+		Expect(p.Elapsed).To(BeNumerically("~", 7.6, 0.1))
+		Expect(p.Rising()).To(BeFalse())
+		Expect(p.Maximum).To(BeNumerically("~", 2014.4, 0.1))
+	})
+
+	It("should place a date early in cycle 25 as rising", func() {
+		p, err := series.Phase(2021.5)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p.Cycle).To(Equal(25))
+		Expect(p.Fraction).To(BeNumerically("~", 1.6/11, 0.01))
+		Expect(p.Rising()).To(BeTrue())
+		Expect(p.Smoothed).To(BeNumerically(">", 0))
+	})
+
+	It("should fail before the first minimum", func() {
+		_, err := series.Phase(2007)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should number cycles beyond the table", func() {
+		Expect(CycleNumber(1996.0)).To(Equal(23))
+		Expect(CycleNumber(2030.8)).To(Equal(26))
+		Expect(CycleNumber(1744.5)).To(Equal(0))
+	})
+})
@@ -0,0 +1,231 @@
+package spatial
+
+import (
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/vectors"
+	"math"
+	"sort"
+)
+
+// Dimensions of the direction vectors stored in the index
+const dimensions = 3
+
+// Point is an indexed direction with a caller-supplied identifier
+type Point struct {
+	ID        int
+	Direction vectors.Vector3D
+}
+
+// Match is a search result with its angular separation from the query point
+type Match struct {
+	Point
+	Separation float64 // degrees
+}
+
+// Index performs cone searches over directions on the unit sphere
+type Index interface {
+	// ConeSearch returns all points within radius degrees of center
+	ConeSearch(center vectors.Vector3D, radius float64) []Match
+	// Nearest returns the point closest to center
+	Nearest(center vectors.Vector3D) (Match, bool)
+	// Len returns the number of indexed points
+	Len() int
+}
+
+// KDTree is a static 3-d tree over unit direction vectors stored in an implicit layout
+type KDTree struct {
+	points []Point
+}
+
+// NewKDTree builds a KD-tree from the given points. Directions are normalized, and the
+// input slice is not modified.
+func NewKDTree(points []Point) *KDTree {
+	tree := &KDTree{points: make([]Point, len(points))}
+	for i, p := range points {
+		tree.points[i] = Point{ID: p.ID, Direction: p.Direction.Normalize()}
+	}
+	tree.build(0, len(tree.points), 0)
+	return tree
+}
+
+// NewEquatorialKDTree builds a KD-tree from right ascension and declination pairs in degrees.
+// Point IDs are the positions in the input slices.
+func NewEquatorialKDTree(ra, dec []float64) *KDTree {
+	n := len(ra)
+	if len(dec) < n {
+		n = len(dec)
+	}
+	points := make([]Point, n)
+	for i := 0; i < n; i++ {
+		points[i] = Point{ID: i, Direction: DirectionFromEquatorial(ra[i], dec[i])}
+	}
+	return NewKDTree(points)
+}
+
+// DirectionFromEquatorial converts right ascension and declination in degrees to a unit vector
+func DirectionFromEquatorial(ra, dec float64) vectors.Vector3D {
+	raRad := ra * constants.Rad
+	decRad := dec * constants.Rad
+	cosDec := math.Cos(decRad)
+	return vectors.Vector3D{X: cosDec * math.Cos(raRad), Y: cosDec * math.Sin(raRad), Z: math.Sin(decRad)}
+}
+
+// EquatorialFromDirection converts a direction vector to right ascension and declination in degrees
+func EquatorialFromDirection(v vectors.Vector3D) (ra, dec float64) {
+	ra = math.Atan2(v.Y, v.X) * constants.Deg
+	if ra < 0 {
+		ra += 360.0
+	}
+	dec = math.Atan2(v.Z, math.Hypot(v.X, v.Y)) * constants.Deg
+	return ra, dec
+}
+
+// ChordLength converts an angular separation in degrees to the straight-line distance
+// between two points on the unit sphere
+func ChordLength(separation float64) float64 {
+	if separation >= 180.0 {
+		return 2.0
+	}
+	return 2.0 * math.Sin(separation*constants.Rad/2.0)
+}
+
+// SeparationFromChord converts a chord length on the unit sphere to an angular separation in degrees
+func SeparationFromChord(chord float64) float64 {
+	if chord >= 2.0 {
+		return 180.0
+	}
+	return 2.0 * math.Asin(chord/2.0) * constants.Deg
+}
+
+// Len returns the number of indexed points
+func (t *KDTree) Len() int {
+	return len(t.points)
+}
+
+// ConeSearch returns all points within radius degrees of center, ordered by separation
+func (t *KDTree) ConeSearch(center vectors.Vector3D, radius float64) []Match {
+	center = center.Normalize()
+	chord := ChordLength(radius)
+	var matches []Match
+	t.search(0, len(t.points), 0, center, chord*chord, &matches)
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].Separation < matches[j].Separation
+	})
+	return matches
+}
+
+// Nearest returns the point closest to center; ok is false for an empty tree
+func (t *KDTree) Nearest(center vectors.Vector3D) (match Match, ok bool) {
+	if len(t.points) == 0 {
+		return Match{}, false
+	}
+	center = center.Normalize()
+	best := -1
+	bestDist := math.Inf(1)
+	t.nearest(0, len(t.points), 0, center, &best, &bestDist)
+	point := t.points[best]
+	return Match{Point: point, Separation: SeparationFromChord(math.Sqrt(bestDist))}, true
+}
+
+// build recursively arranges points[lo:hi] so the median on the split axis sits at the midpoint
+func (t *KDTree) build(lo, hi, depth int) {
+	if hi-lo <= 1 {
+		return
+	}
+	axis := depth % dimensions
+	segment := t.points[lo:hi]
+	sort.Slice(segment, func(i, j int) bool {
+		return component(segment[i].Direction, axis) < component(segment[j].Direction, axis)
+	})
+	mid := lo + (hi-lo)/2
+	t.build(lo, mid, depth+1)
+	t.build(mid+1, hi, depth+1)
+}
+
+// search collects points within squared chord distance limit of center
+func (t *KDTree) search(lo, hi, depth int, center vectors.Vector3D, limit float64, matches *[]Match) {
+	if lo >= hi {
+		return
+	}
+	mid := lo + (hi-lo)/2
+	point := t.points[mid]
+	if dist := squaredDistance(point.Direction, center); dist <= limit {
+		*matches = append(*matches, Match{Point: point, Separation: SeparationFromChord(math.Sqrt(dist))})
+	}
+
+	axis := depth % dimensions
+	delta := component(center, axis) - component(point.Direction, axis)
+	if delta <= 0 || delta*delta <= limit {
+		t.search(lo, mid, depth+1, center, limit, matches)
+	}
+	if delta >= 0 || delta*delta <= limit {
+		t.search(mid+1, hi, depth+1, center, limit, matches)
+	}
+}
+
+// nearest finds the point with the smallest squared distance to center
+func (t *KDTree) nearest(lo, hi, depth int, center vectors.Vector3D, best *int, bestDist *float64) {
+	if lo >= hi {
+		return
+	}
+	mid := lo + (hi-lo)/2
+	if dist := squaredDistance(t.points[mid].Direction, center); dist < *bestDist {
+		*best = mid
+		*bestDist = dist
+	}
+
+	axis := depth % dimensions
+	delta := component(center, axis) - component(t.points[mid].Direction, axis)
+	first, second := [2]int{lo, mid}, [2]int{mid + 1, hi}
+	if delta > 0 {
+		first, second = second, first
+	}
+	t.nearest(first[0], first[1], depth+1, center, best, bestDist)
+	if delta*delta < *bestDist {
+		t.nearest(second[0], second[1], depth+1, center, best, bestDist)
+	}
+}
+
+// component returns the coordinate of v along the given axis
+func component(v vectors.Vector3D, axis int) float64 {
+	switch axis {
+	case 0:
+		return v.X
+	case 1:
+		return v.Y
+	default:
+		return v.Z
+	}
+}
+
+// squaredDistance returns the squared Euclidean distance between two vectors
+func squaredDistance(a, b vectors.Vector3D) float64 {
+	d := a.Subtract(b)
+	return d.DotProduct(d)
+}
+
+// BoundingBox is an axis-aligned box enclosing a set of directions
+type BoundingBox struct {
+	Min, Max vectors.Vector3D
+}
+
+// ConeBoundingBox returns the axis-aligned box enclosing the spherical cap of the given
+// radius in degrees around center, useful for coarse prefiltering in external stores
+func ConeBoundingBox(center vectors.Vector3D, radius float64) BoundingBox {
+	center = center.Normalize()
+	chord := ChordLength(radius)
+	box := BoundingBox{
+		Min: vectors.Vector3D{X: center.X - chord, Y: center.Y - chord, Z: center.Z - chord},
+		Max: vectors.Vector3D{X: center.X + chord, Y: center.Y + chord, Z: center.Z + chord},
+	}
+	box.Min = vectors.Vector3D{X: math.Max(box.Min.X, -1), Y: math.Max(box.Min.Y, -1), Z: math.Max(box.Min.Z, -1)}
+	box.Max = vectors.Vector3D{X: math.Min(box.Max.X, 1), Y: math.Min(box.Max.Y, 1), Z: math.Min(box.Max.Z, 1)}
+	return box
+}
+
+// Contains reports whether v lies inside the box
+func (b BoundingBox) Contains(v vectors.Vector3D) bool {
+	return v.X >= b.Min.X && v.X <= b.Max.X &&
+		v.Y >= b.Min.Y && v.Y <= b.Max.Y &&
+		v.Z >= b.Min.Z && v.Z <= b.Max.Z
+}
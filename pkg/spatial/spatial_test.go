@@ -0,0 +1,136 @@
+package spatial
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/ocrosby/astronomy/pkg/vectors"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// randomSky returns n reproducible random directions distributed uniformly on the sphere
+func randomSky(n int) []Point {
+	rng := rand.New(rand.NewSource(42))
+	entries := make([]Point, n)
+	for i := range entries {
+		ra := rng.Float64() * 360.0
+		dec := math.Asin(2*rng.Float64()-1) * 180.0 / math.Pi
+		entries[i] = Point{ID: i, Direction: DirectionFromEquatorial(ra, dec)}
+	}
+	return entries
+}
+
+// bruteForce returns the IDs of entries within radius degrees of center
+func bruteForce(entries []Point, center vectors.Vector3D, radius float64) []int {
+	var ids []int
+	for _, e := range entries {
+		if vectors.Angle3D(e.Direction, center)*180.0/math.Pi <= radius {
+			ids = append(ids, e.ID)
+		}
+	}
+	return ids
+}
+
+var _ = Describe("Spatial", func() {
+	Describe("DirectionFromEquatorial", func() {
+		It("should round-trip through EquatorialFromDirection", func() {
+			ra, dec := EquatorialFromDirection(DirectionFromEquatorial(279.23473, 38.78369))
+			Expect(ra).To(BeNumerically("~", 279.23473, 1e-9))
+			Expect(dec).To(BeNumerically("~", 38.78369, 1e-9))
+		})
+
+		It("should map the north celestial pole to +Z", func() {
+			v := DirectionFromEquatorial(123.0, 90.0)
+			Expect(v.Z).To(BeNumerically("~", 1.0, 1e-12))
+		})
+	})
+
+	Describe("chord conversions", func() {
+		DescribeTable("round-trip separations",
+			func(separation float64) {
+				Expect(SeparationFromChord(ChordLength(separation))).To(BeNumerically("~", separation, 1e-9))
+			},
+			Entry("one arcsecond", 1.0/3600.0),
+			Entry("one degree", 1.0),
+			Entry("right angle", 90.0),
+			Entry("antipode", 180.0),
+		)
+	})
+
+	Describe("KDTree", func() {
+		entries := randomSky(2000)
+		tree := NewKDTree(entries)
+
+		It("should index every entry", func() {
+			Expect(tree.Len()).To(Equal(2000))
+		})
+
+		DescribeTable("cone search agrees with brute force",
+			func(ra, dec, radius float64) {
+				center := DirectionFromEquatorial(ra, dec)
+				var ids []int
+				for _, m := range tree.ConeSearch(center, radius) {
+					ids = append(ids, m.ID)
+					Expect(m.Separation).To(BeNumerically("<=", radius+1e-9))
+				}
+				sort.Ints(ids)
+				Expect(ids).To(Equal(bruteForce(entries, center, radius)))
+			},
+			Entry("small cone", 10.0, 20.0, 3.0),
+			Entry("cone across RA=0", 359.5, -5.0, 8.0),
+			Entry("polar cap", 0.0, 89.0, 10.0),
+			Entry("hemisphere", 180.0, 0.0, 90.0),
+			Entry("whole sky", 42.0, 42.0, 180.0),
+		)
+
+		It("should order matches by separation", func() {
+			matches := tree.ConeSearch(DirectionFromEquatorial(100, 10), 15.0)
+			Expect(len(matches)).To(BeNumerically(">", 1))
+			for i := 1; i < len(matches); i++ {
+				Expect(matches[i].Separation).To(BeNumerically(">=", matches[i-1].Separation))
+			}
+		})
+
+		It("should find the nearest entry", func() {
+			center := DirectionFromEquatorial(200.0, -30.0)
+			match, ok := tree.Nearest(center)
+			Expect(ok).To(BeTrue())
+
+			best := math.Inf(1)
+			for _, e := range entries {
+				best = math.Min(best, vectors.Angle3D(e.Direction, center)*180.0/math.Pi)
+			}
+			Expect(match.Separation).To(BeNumerically("~", best, 1e-9))
+		})
+
+		It("should handle an empty tree", func() {
+			empty := NewKDTree(nil)
+			_, ok := empty.Nearest(vectors.Vector3D{X: 1})
+			Expect(ok).To(BeFalse())
+			Expect(empty.ConeSearch(vectors.Vector3D{X: 1}, 10)).To(BeEmpty())
+		})
+
+		It("should build from equatorial coordinates", func() {
+			eq := NewEquatorialKDTree([]float64{0, 90, 180}, []float64{0, 0, 0})
+			matches := eq.ConeSearch(DirectionFromEquatorial(91, 0), 2)
+			Expect(matches).To(HaveLen(1))
+			Expect(matches[0].ID).To(Equal(1))
+			Expect(matches[0].Separation).To(BeNumerically("~", 1.0, 1e-9))
+		})
+	})
+
+	Describe("ConeBoundingBox", func() {
+		It("should contain every point of the cone", func() {
+			center := DirectionFromEquatorial(45, 45)
+			box := ConeBoundingBox(center, 5)
+			for _, e := range randomSky(500) {
+				if vectors.Angle3D(e.Direction, center)*180.0/math.Pi <= 5 {
+					Expect(box.Contains(e.Direction)).To(BeTrue())
+				}
+			}
+			Expect(box.Contains(DirectionFromEquatorial(225, -45))).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,35 @@
+package spectroscopy
+
+import (
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/geodesy"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	"math"
+	"time"
+)
+
+// BarycentricVelocityCorrection returns the velocity in km/s of the observer relative to the
+// solar system barycenter projected toward a target with J2000 coordinates, combining the
+// Earth's orbital motion with the observer's rotation about the Earth's axis. Adding it to a
+// measured radial velocity gives the barycentric radial velocity to first order in v/c. The
+// orbital part comes from the low-precision solar theory and is good to about 0.02 km/s.
+func BarycentricVelocityCorrection(target coordinates.Equatorial, obs observer.Observer, t time.Time) float64 {
+	orbital := astrotime.EarthBarycentricVelocity(t).DotProduct(target.Vector())
+	return orbital + RotationalVelocityCorrection(target, obs, t)
+}
+
+// RotationalVelocityCorrection returns the observer's velocity in km/s due to the Earth's
+// rotation projected toward a target with J2000 coordinates, at most 0.47 km/s on the equator
+func RotationalVelocityCorrection(target coordinates.Equatorial, obs observer.Observer, t time.Time) float64 {
+	date := coordinates.Precess(target, 0, julian.Centuries(astrotime.TT(t)))
+	lst := sidereal.LocalApparentSiderealTime(julian.FromTime(t), obs.Longitude)
+	hourAngle := (lst - date.RA) * constants.Rad
+
+	axis, _ := geodesy.Geocentric(obs.Latitude, obs.Elevation)
+	// the observer moves east, toward targets rising at negative hour angles
+	return -constants.EarthRotationRate * axis / 1000 * math.Cos(date.Dec*constants.Rad) * math.Sin(hourAngle)
+}
@@ -0,0 +1,56 @@
+package spectroscopy
+
+import (
+	"math"
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Barycentric velocity correction", func() {
+	t := time.Date(2024, 3, 20, 3, 0, 0, 0, time.UTC)
+	equator := observer.NewObserver(0, 0)
+	pole := observer.NewObserver(90, 0)
+	eclipticPole := coordinates.Ecliptic{Latitude: 90}.ToEquatorial(astrotime.ObliquityJ2000)
+
+	It("should follow the Earth's orbit over a year", func() {
+		target := coordinates.Ecliptic{Longitude: 0}.ToEquatorial(astrotime.ObliquityJ2000)
+		high, low := math.Inf(-1), math.Inf(1)
+		for day := 0; day < 366; day += 3 {
+			v := BarycentricVelocityCorrection(target, pole, t.AddDate(0, 0, day))
+			high, low = math.Max(high, v), math.Min(low, v)
+		}
+		Expect(high).To(BeNumerically("~", 29.8, 0.6))
+		Expect(low).To(BeNumerically("~", -29.8, 0.6))
+	})
+
+	It("should nearly vanish toward the ecliptic pole", func() {
+		Expect(math.Abs(BarycentricVelocityCorrection(eclipticPole, pole, t))).To(BeNumerically("<", 0.6))
+	})
+
+	It("should agree with the rate of change of the barycentric light time", func() {
+		vega := coordinates.Equatorial{RA: 279.2347, Dec: 38.7837}
+		step := time.Hour
+		rate := (astrotime.BarycentricCorrection(t.Add(step), vega) - astrotime.BarycentricCorrection(t.Add(-step), vega)) *
+			julian.SecondsPerDay * constants.SpeedOfLight / (2 * step.Seconds())
+		Expect(BarycentricVelocityCorrection(vega, pole, t)).To(BeNumerically("~", rate, 0.01))
+	})
+
+	It("should add the observer's rotation toward rising targets", func() {
+		lst := sidereal.LocalApparentSiderealTime(julian.FromTime(t), 0)
+		rising := coordinates.Precess(coordinates.Equatorial{RA: lst + 90}, julian.Centuries(astrotime.TT(t)), 0)
+		setting := coordinates.Precess(coordinates.Equatorial{RA: lst - 90}, julian.Centuries(astrotime.TT(t)), 0)
+		Expect(RotationalVelocityCorrection(rising, equator, t)).To(BeNumerically("~", 0.4651, 1e-3))
+		Expect(RotationalVelocityCorrection(setting, equator, t)).To(BeNumerically("~", -0.4651, 1e-3))
+		Expect(RotationalVelocityCorrection(rising, pole, t)).To(BeNumerically("~", 0, 1e-12))
+		Expect(BarycentricVelocityCorrection(rising, equator, t) - BarycentricVelocityCorrection(rising, pole, t)).To(
+			BeNumerically("~", 0.4651, 1e-3))
+	})
+})
@@ -0,0 +1,63 @@
+package spectroscopy
+
+import (
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"math"
+)
+
+// DefaultHubbleConstant is a round value of the Hubble constant in km/s/Mpc, between the
+// early-universe and distance-ladder measurements
+const DefaultHubbleConstant = 70.0
+
+// DecelerationParameter is q0 for a flat universe with a matter density of 0.3 and the rest
+// in a cosmological constant
+const DecelerationParameter = -0.55
+
+// Redshift returns z for a line observed at one wavelength whose rest wavelength is another,
+// in any common unit; blueshifts are negative
+func Redshift(observed, rest float64) float64 {
+	return observed/rest - 1
+}
+
+// ObservedWavelength returns the wavelength at which a line emitted at rest is observed at
+// redshift z
+func ObservedWavelength(rest, z float64) float64 {
+	return rest * (1 + z)
+}
+
+// WavelengthShift returns the observed minus the rest wavelength at redshift z
+func WavelengthShift(rest, z float64) float64 {
+	return rest * z
+}
+
+// ClassicalVelocity returns the recession velocity cz in km/s, valid for |z| well below 0.1
+func ClassicalVelocity(z float64) float64 {
+	return constants.SpeedOfLight * z
+}
+
+// RedshiftFromClassicalVelocity returns v/c for a velocity in km/s
+func RedshiftFromClassicalVelocity(v float64) float64 {
+	return v / constants.SpeedOfLight
+}
+
+// RelativisticVelocity returns the line-of-sight velocity in km/s that gives redshift z by the
+// special relativistic Doppler effect, always below c
+func RelativisticVelocity(z float64) float64 {
+	s := (1 + z) * (1 + z)
+	return constants.SpeedOfLight * (s - 1) / (s + 1)
+}
+
+// RedshiftFromRelativisticVelocity returns the redshift of a source receding at v km/s by the
+// special relativistic Doppler effect
+func RedshiftFromRelativisticVelocity(v float64) float64 {
+	beta := v / constants.SpeedOfLight
+	return math.Sqrt((1+beta)/(1-beta)) - 1
+}
+
+// ComovingDistance returns the comoving distance in Mpc to a galaxy at redshift z for a Hubble
+// constant in km/s/Mpc, from the expansion (c/H0)(z - (1 + q0) z²/2). It is within 1% of the
+// flat ΛCDM value below z = 0.1; cosmological redshifts are not Doppler shifts, so use it rather
+// than the velocity conversions for distant galaxies.
+func ComovingDistance(z, hubbleConstant float64) float64 {
+	return constants.SpeedOfLight / hubbleConstant * z * (1 - (1+DecelerationParameter)*z/2)
+}
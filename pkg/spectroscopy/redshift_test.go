@@ -0,0 +1,37 @@
+package spectroscopy
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Redshift", func() {
+	const hAlpha = 656.28
+
+	It("should convert between wavelengths and redshift", func() {
+		Expect(Redshift(662.84, hAlpha)).To(BeNumerically("~", 0.009996, 1e-6))
+		Expect(Redshift(650, hAlpha)).To(BeNumerically("<", 0))
+		Expect(ObservedWavelength(hAlpha, 0.5)).To(BeNumerically("~", 984.42, 1e-9))
+		Expect(WavelengthShift(hAlpha, 0.01)).To(BeNumerically("~", 6.5628, 1e-12))
+	})
+
+	It("should convert classical velocities", func() {
+		Expect(ClassicalVelocity(0.01)).To(BeNumerically("~", 2997.92458, 1e-6))
+		Expect(RedshiftFromClassicalVelocity(ClassicalVelocity(0.0042))).To(BeNumerically("~", 0.0042, 1e-15))
+	})
+
+	It("should keep relativistic velocities below c", func() {
+		Expect(RelativisticVelocity(1)).To(BeNumerically("~", 0.6*299792.458, 1e-6))
+		Expect(RelativisticVelocity(1000)).To(BeNumerically("<", 299792.458))
+		Expect(RelativisticVelocity(0.001)).To(BeNumerically("~", ClassicalVelocity(0.001), 0.5))
+		Expect(RedshiftFromRelativisticVelocity(RelativisticVelocity(2.5))).To(BeNumerically("~", 2.5, 1e-9))
+		Expect(RedshiftFromRelativisticVelocity(-100)).To(BeNumerically("<", 0))
+	})
+
+	It("should give comoving distances for small redshifts", func() {
+		// flat ΛCDM with H0 = 70 and Ωm = 0.3 gives 418.5 Mpc at z = 0.1
+		Expect(ComovingDistance(0.1, DefaultHubbleConstant)).To(BeNumerically("~", 418.5, 1))
+		Expect(ComovingDistance(0.01, DefaultHubbleConstant)).To(BeNumerically("~", 42.73, 0.05))
+		Expect(ComovingDistance(0.01, 67.4) / ComovingDistance(0.01, 73)).To(BeNumerically("~", 73/67.4, 1e-12))
+	})
+})
@@ -0,0 +1,13 @@
+package spectroscopy_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSpectroscopy(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Spectroscopy Suite")
+}
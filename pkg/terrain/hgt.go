@@ -0,0 +1,171 @@
+package terrain
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// hgtVoid marks a missing sample in SRTM data
+const hgtVoid = -32768
+
+// Tile is one SRTM HGT tile: a square of signed big-endian 16-bit heights in metres covering
+// one degree of latitude and longitude, in rows from north to south, 1201 samples a side at 3"
+// spacing or 3601 at 1". Neighbouring tiles share their edge rows and columns.
+type Tile struct {
+	Latitude  int // of the south-west corner
+	Longitude int // of the south-west corner
+	size      int
+	samples   []int16
+}
+
+// TileName returns the file name of the tile containing a position, e.g. "N37W123.hgt"
+func TileName(latitude, longitude float64) string {
+	lat, lon := int(math.Floor(latitude)), int(math.Floor(longitude))
+	ns, ew := 'N', 'E'
+	if lat < 0 {
+		ns, lat = 'S', -lat
+	}
+	if lon < 0 {
+		ew, lon = 'W', -lon
+	}
+	return fmt.Sprintf("%c%02d%c%03d.hgt", ns, lat, ew, lon)
+}
+
+// ParseTileName returns the south-west corner encoded in a tile file name such as
+// "N37W123.hgt", with or without a directory and in any letter case
+func ParseTileName(name string) (latitude, longitude int, err error) {
+	base := strings.ToUpper(filepath.Base(name))
+	base = strings.TrimSuffix(base, ".HGT")
+	if len(base) != 7 || (base[0] != 'N' && base[0] != 'S') || (base[3] != 'E' && base[3] != 'W') {
+		return 0, 0, fmt.Errorf("invalid HGT tile name '%s'", name)
+	}
+	latitude, err = strconv.Atoi(base[1:3])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid HGT tile name '%s'", name)
+	}
+	longitude, err = strconv.Atoi(base[4:7])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid HGT tile name '%s'", name)
+	}
+	if base[0] == 'S' {
+		latitude = -latitude
+	}
+	if base[3] == 'W' {
+		longitude = -longitude
+	}
+	return latitude, longitude, nil
+}
+
+// ReadHGT reads a tile whose corner is given by its file name, inferring the resolution from
+// the amount of data
+func ReadHGT(name string, r io.Reader) (*Tile, error) {
+	latitude, longitude, err := ParseTileName(name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	size := int(math.Round(math.Sqrt(float64(len(data) / 2))))
+	if size < 2 || 2*size*size != len(data) {
+		return nil, fmt.Errorf("HGT tile %s has %d bytes, not a square of 16-bit samples", name, len(data))
+	}
+	samples := make([]int16, size*size)
+	for i := range samples {
+		samples[i] = int16(binary.BigEndian.Uint16(data[2*i:]))
+	}
+	return &Tile{Latitude: latitude, Longitude: longitude, size: size, samples: samples}, nil
+}
+
+// OpenHGT reads a tile from a file
+func OpenHGT(path string) (*Tile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadHGT(path, f)
+}
+
+// Size returns the number of samples along each side of the tile
+func (t *Tile) Size() int {
+	return t.size
+}
+
+// Elevation interpolates the height bilinearly between the four samples around a position,
+// returning ErrNoData outside the tile or next to a void
+func (t *Tile) Elevation(latitude, longitude float64) (float64, error) {
+	x := (longitude - float64(t.Longitude)) * float64(t.size-1)
+	y := (float64(t.Latitude+1) - latitude) * float64(t.size-1)
+	if !(x >= 0 && y >= 0 && x <= float64(t.size-1) && y <= float64(t.size-1)) {
+		return 0, fmt.Errorf("%w at %v, %v outside tile %s", ErrNoData, latitude, longitude,
+			TileName(float64(t.Latitude), float64(t.Longitude)))
+	}
+	col, row := min(int(x), t.size-2), min(int(y), t.size-2)
+	fx, fy := x-float64(col), y-float64(row)
+
+	var corners [4]float64
+	for i, offset := range [4]int{0, 1, t.size, t.size + 1} {
+		sample := t.samples[row*t.size+col+offset]
+		if sample == hgtVoid {
+			return 0, fmt.Errorf("%w: void at %v, %v", ErrNoData, latitude, longitude)
+		}
+		corners[i] = float64(sample)
+	}
+	north := corners[0] + fx*(corners[1]-corners[0])
+	south := corners[2] + fx*(corners[3]-corners[2])
+	return north + fy*(south-north), nil
+}
+
+// HGTDirectory is a TerrainProvider over a directory of HGT tiles named as TileName gives,
+// loaded on first use and kept in memory. Tiles absent from the directory, such as the open
+// sea that SRTM omits, give ErrNoData.
+type HGTDirectory struct {
+	dir   string
+	mu    sync.Mutex
+	tiles map[[2]int]*Tile
+}
+
+// NewHGTDirectory returns a provider reading tiles from dir
+func NewHGTDirectory(dir string) *HGTDirectory {
+	return &HGTDirectory{dir: dir, tiles: make(map[[2]int]*Tile)}
+}
+
+// Elevation returns the height from the tile containing the position, loading it if needed
+func (d *HGTDirectory) Elevation(latitude, longitude float64) (float64, error) {
+	tile, err := d.tile(latitude, longitude)
+	if err != nil {
+		return 0, err
+	}
+	return tile.Elevation(latitude, longitude)
+}
+
+// tile returns the cached tile containing a position, remembering missing ones
+func (d *HGTDirectory) tile(latitude, longitude float64) (*Tile, error) {
+	key := [2]int{int(math.Floor(latitude)), int(math.Floor(longitude))}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	tile, seen := d.tiles[key]
+	if !seen {
+		var err error
+		tile, err = OpenHGT(filepath.Join(d.dir, TileName(latitude, longitude)))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		d.tiles[key] = tile
+	}
+	if tile == nil {
+		return nil, fmt.Errorf("%w: no tile %s", ErrNoData, TileName(latitude, longitude))
+	}
+	return tile, nil
+}
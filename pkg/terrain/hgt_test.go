@@ -0,0 +1,73 @@
+package terrain
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// planeTile encodes an 11×11 tile whose height rises 100 m per column eastwards and 10 m per
+// row southwards, so that bilinear interpolation is exact
+func planeTile() []byte {
+	var buf bytes.Buffer
+	for row := 0; row < 11; row++ {
+		for col := 0; col < 11; col++ {
+			binary.Write(&buf, binary.BigEndian, int16(100*col+10*row))
+		}
+	}
+	return buf.Bytes()
+}
+
+var _ = Describe("HGT tiles", func() {
+	It("should name tiles by their south-west corner", func() {
+		Expect(TileName(37.5, -122.3)).To(Equal("N37W123.hgt"))
+		Expect(TileName(-33.9, 18.4)).To(Equal("S34E018.hgt"))
+		Expect(TileName(0.5, 0.5)).To(Equal("N00E000.hgt"))
+
+		lat, lon, err := ParseTileName("/data/srtm/s34e018.HGT")
+		Expect(err).NotTo(HaveOccurred())
+		Expect([]int{lat, lon}).To(Equal([]int{-34, 18}))
+		_, _, err = ParseTileName("N37-123.hgt")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should interpolate between samples", func() {
+		tile, err := ReadHGT("N46E007.hgt", bytes.NewReader(planeTile()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tile.Size()).To(Equal(11))
+
+		Expect(tile.Elevation(47, 7)).To(BeNumerically("~", 0, 1e-9))
+		Expect(tile.Elevation(46, 8)).To(BeNumerically("~", 1100, 1e-9))
+		Expect(tile.Elevation(46.75, 7.25)).To(BeNumerically("~", 250+25, 1e-9))
+
+		_, err = tile.Elevation(45.9, 7.5)
+		Expect(err).To(MatchError(ErrNoData))
+	})
+
+	It("should report voids and malformed data", func() {
+		data := planeTile()
+		binary.BigEndian.PutUint16(data[2*(5*11+5):], 0x8000)
+		tile, err := ReadHGT("N46E007.hgt", bytes.NewReader(data))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = tile.Elevation(46.5, 7.5)
+		Expect(err).To(MatchError(ErrNoData))
+		Expect(tile.Elevation(46.05, 7.05)).To(BeNumerically("~", 50+95, 1e-9))
+
+		_, err = ReadHGT("N46E007.hgt", bytes.NewReader(data[:100]))
+		Expect(err).To(MatchError(ContainSubstring("not a square")))
+	})
+
+	It("should load tiles from a directory on demand", func() {
+		dir := GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(dir, "N46E007.hgt"), planeTile(), 0o644)).To(Succeed())
+
+		provider := NewHGTDirectory(dir)
+		Expect(provider.Elevation(46.5, 7.5)).To(BeNumerically("~", 550, 1e-9))
+		_, err := provider.Elevation(10.5, 7.5)
+		Expect(err).To(MatchError(ErrNoData))
+	})
+})
@@ -0,0 +1,146 @@
+package terrain
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ocrosby/astronomy/pkg/angles"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"math"
+)
+
+// Profile defaults
+const (
+	DefaultAzimuthStep = 1.0  // degrees
+	DefaultMaxDistance = 100  // km
+	DefaultSpacing     = 90   // metres, the 3" SRTM grid
+	DefaultRefraction  = 0.13 // terrestrial refraction coefficient
+	minimumEyeHeight   = 2.0  // metres above the modelled ground
+)
+
+// Profile is the skyline around an observer: the altitude in degrees of the top of the terrain
+// at azimuths 0, Step, 2 Step and so on, measured from north through east. It satisfies
+// riseset.Horizon.
+type Profile struct {
+	Step      float64
+	Altitudes []float64
+}
+
+// Altitude returns the skyline altitude at an azimuth in degrees, interpolated linearly
+// between the sampled azimuths
+func (p Profile) Altitude(azimuth float64) float64 {
+	if len(p.Altitudes) == 0 {
+		return 0
+	}
+	x := angles.NormalizeDegrees(azimuth) / p.Step
+	i := int(x) % len(p.Altitudes)
+	f := x - math.Floor(x)
+	return p.Altitudes[i] + f*(p.Altitudes[(i+1)%len(p.Altitudes)]-p.Altitudes[i])
+}
+
+// profileOptions holds the settings of BuildProfile
+type profileOptions struct {
+	step        float64
+	maxDistance float64
+	spacing     float64
+	refraction  float64
+}
+
+// ProfileOption configures BuildProfile
+type ProfileOption func(*profileOptions)
+
+// WithAzimuthStep sets the spacing of the skyline samples in degrees, which should divide 360
+func WithAzimuthStep(degrees float64) ProfileOption {
+	return func(o *profileOptions) { o.step = degrees }
+}
+
+// WithMaxDistance sets how far in km each ray looks for terrain
+func WithMaxDistance(km float64) ProfileOption {
+	return func(o *profileOptions) { o.maxDistance = km }
+}
+
+// WithSpacing sets the distance in metres between elevation samples near the observer, best
+// matched to the resolution of the model; the spacing widens to 0.5% of the distance further
+// out
+func WithSpacing(metres float64) ProfileOption {
+	return func(o *profileOptions) { o.spacing = metres }
+}
+
+// WithRefractionCoefficient sets the terrestrial refraction coefficient, the ratio of the
+// Earth's radius to that of a ray's curvature; 0 treats rays as straight
+func WithRefractionCoefficient(k float64) ProfileOption {
+	return func(o *profileOptions) { o.refraction = k }
+}
+
+// BuildProfile ray-marches a terrain model outwards from an observer at every azimuth step
+// and returns the highest altitude the ground reaches, allowing for the curvature of the Earth
+// and terrestrial refraction. The eye is at the observer's Elevation, or 2 m above the
+// modelled ground where that is higher. Positions without data are taken to be at sea level,
+// as SRTM leaves the open sea out.
+func BuildProfile(obs observer.Observer, provider TerrainProvider, opts ...ProfileOption) (Profile, error) {
+	o := profileOptions{
+		step:        DefaultAzimuthStep,
+		maxDistance: DefaultMaxDistance,
+		spacing:     DefaultSpacing,
+		refraction:  DefaultRefraction,
+	}
+	for _, apply := range opts {
+		apply(&o)
+	}
+	if o.step <= 0 || o.spacing <= 0 || o.maxDistance <= 0 {
+		return Profile{}, fmt.Errorf("terrain: azimuth step, spacing and distance must be positive")
+	}
+
+	ground, err := elevation(provider, obs.Latitude, obs.Longitude)
+	if err != nil {
+		return Profile{}, err
+	}
+	eye := math.Max(obs.Elevation, ground+minimumEyeHeight)
+
+	n := int(math.Round(360 / o.step))
+	profile := Profile{Step: 360 / float64(n), Altitudes: make([]float64, n)}
+	for i := range profile.Altitudes {
+		profile.Altitudes[i], err = o.skyline(provider, obs.Latitude, obs.Longitude, eye, float64(i)*profile.Step)
+		if err != nil {
+			return Profile{}, err
+		}
+	}
+	return profile, nil
+}
+
+// skyline returns the highest altitude in degrees that the terrain reaches along one azimuth
+// seen from a position at a height in metres
+func (o profileOptions) skyline(provider TerrainProvider, latitude, longitude, eye, azimuth float64) (float64, error) {
+	radius := constants.EarthRadius * 1000
+	highest := math.Inf(-1)
+	for d := o.spacing; d <= o.maxDistance*1000; d += math.Max(o.spacing, d*0.005) {
+		lat, lon := destination(latitude, longitude, azimuth, d/radius)
+		h, err := elevation(provider, lat, lon)
+		if err != nil {
+			return 0, err
+		}
+		drop := d * d * (1 - o.refraction) / (2 * radius)
+		highest = math.Max(highest, math.Atan2(h-eye-drop, d)*constants.Deg)
+	}
+	return highest, nil
+}
+
+// elevation returns the provider's elevation, taking positions without data to be at sea level
+func elevation(provider TerrainProvider, latitude, longitude float64) (float64, error) {
+	h, err := provider.Elevation(latitude, longitude)
+	if errors.Is(err, ErrNoData) {
+		return 0, nil
+	}
+	return h, err
+}
+
+// destination returns the point reached by travelling an angle delta in radians along the
+// great circle leaving a position in degrees at an azimuth in degrees
+func destination(latitude, longitude, azimuth, delta float64) (float64, float64) {
+	sinLat, cosLat := math.Sincos(latitude * constants.Rad)
+	sinDelta, cosDelta := math.Sincos(delta)
+	sinAz, cosAz := math.Sincos(azimuth * constants.Rad)
+	sinLat2 := sinLat*cosDelta + cosLat*sinDelta*cosAz
+	lon2 := longitude*constants.Rad + math.Atan2(sinAz*sinDelta*cosLat, cosDelta-sinLat*sinLat2)
+	return math.Asin(sinLat2) * constants.Deg, angles.WrapSigned(lon2 * constants.Deg)
+}
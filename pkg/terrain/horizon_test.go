@@ -0,0 +1,62 @@
+package terrain
+
+import (
+	"errors"
+	"math"
+
+	"github.com/ocrosby/astronomy/pkg/observer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildProfile", func() {
+	flat := ProviderFunc(func(float64, float64) (float64, error) { return 0, nil })
+
+	It("should see the dip of a level horizon from eye height", func() {
+		p, err := BuildProfile(observer.Observer{Latitude: 10}, flat, WithAzimuthStep(45))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p.Altitudes).To(HaveLen(8))
+		dip := math.Sqrt(2*minimumEyeHeight*(1-DefaultRefraction)/6371e3) * 180 / math.Pi
+		for _, altitude := range p.Altitudes {
+			Expect(altitude).To(BeNumerically("~", -dip, 0.002))
+		}
+	})
+
+	It("should raise the skyline towards a mountain", func() {
+		// a 1000 m cone 10 km east of the observer on the equator
+		mountain := ProviderFunc(func(latitude, longitude float64) (float64, error) {
+			d := math.Hypot(latitude, longitude-10/111.195) * 111195
+			return math.Max(0, 1000-d/2), nil
+		})
+		p, err := BuildProfile(observer.Observer{}, mountain, WithAzimuthStep(10), WithSpacing(50))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p.Altitude(90)).To(BeNumerically("~", math.Atan2(998-7, 10000)*180/math.Pi, 0.05))
+		Expect(p.Altitude(270)).To(BeNumerically("<", 0))
+		Expect(p.Altitude(85)).To(BeNumerically("~", (p.Altitude(80)+p.Altitude(90))/2, 1e-9))
+	})
+
+	It("should treat missing data as sea level and pass other errors on", func() {
+		ocean := ProviderFunc(func(float64, float64) (float64, error) { return 0, ErrNoData })
+		_, err := BuildProfile(observer.Observer{}, ocean, WithAzimuthStep(90))
+		Expect(err).NotTo(HaveOccurred())
+
+		broken := ProviderFunc(func(float64, float64) (float64, error) { return 0, errors.New("disk") })
+		_, err = BuildProfile(observer.Observer{}, broken)
+		Expect(err).To(MatchError("disk"))
+		_, err = BuildProfile(observer.Observer{}, flat, WithAzimuthStep(0))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should wrap azimuths around north", func() {
+		p := Profile{Step: 90, Altitudes: []float64{4, 0, 0, 2}}
+		Expect(p.Altitude(315)).To(BeNumerically("~", 3, 1e-9))
+		Expect(p.Altitude(-45)).To(BeNumerically("~", 3, 1e-9))
+		Expect(Profile{}.Altitude(10)).To(Equal(0.0))
+	})
+
+	It("should travel along great circles", func() {
+		lat, lon := destination(0, 179.5, 90, 1*math.Pi/180)
+		Expect(lat).To(BeNumerically("~", 0, 1e-9))
+		Expect(lon).To(BeNumerically("~", -179.5, 1e-9))
+	})
+})
@@ -0,0 +1,134 @@
+package terrain
+
+import (
+	"fmt"
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/julian"
+	"github.com/ocrosby/astronomy/pkg/observer"
+	"github.com/ocrosby/astronomy/pkg/sidereal"
+	"github.com/ocrosby/astronomy/pkg/solar"
+	"math"
+	"time"
+)
+
+// metresPerDegree is the length of a degree of latitude on the mean sphere
+const metresPerDegree = constants.EarthRadius * 1000 * constants.Rad
+
+// Area is a region bounded by parallels and meridians in degrees
+type Area struct {
+	South, West, North, East float64
+}
+
+// IlluminationGrid is the direct sunlight on the terrain of an area at one time, sampled every
+// Resolution degrees of latitude and longitude in rows from north to south and columns from
+// west to east
+type IlluminationGrid struct {
+	Area         Area
+	Resolution   float64
+	Rows, Cols   int
+	SunAltitude  float64   // apparent altitude of the Sun at the centre of the area in degrees
+	SunAzimuth   float64   // azimuth of the Sun at the centre of the area in degrees
+	Illumination []float64 // hillshade: cosine of the Sun's incidence on the slope, 0 in shadow
+	Shadow       []bool    // whether the Sun is hidden by terrain or below the horizon
+}
+
+// Position returns the latitude and longitude of a grid point in degrees
+func (g *IlluminationGrid) Position(row, col int) (latitude, longitude float64) {
+	return g.Area.North - float64(row)*g.Resolution, g.Area.West + float64(col)*g.Resolution
+}
+
+// At returns the illumination of a grid point and whether it is in shadow
+func (g *IlluminationGrid) At(row, col int) (float64, bool) {
+	i := row*g.Cols + col
+	return g.Illumination[i], g.Shadow[i]
+}
+
+// Illumination returns the hillshade and shadow mask of an area at t: how squarely the Sun
+// strikes each slope, and which points lie in the shadow cast by surrounding terrain. Shadows
+// are found by ray-marching towards the Sun as BuildProfile does, so the ProfileOptions
+// WithMaxDistance, WithSpacing and WithRefractionCoefficient apply; a low Sun needs long rays,
+// which dominate the cost.
+func Illumination(provider TerrainProvider, t time.Time, area Area, resolution float64, opts ...ProfileOption) (*IlluminationGrid, error) {
+	if !(resolution > 0) || !(area.North > area.South) || !(area.East > area.West) {
+		return nil, fmt.Errorf("terrain: invalid area %+v or resolution %v", area, resolution)
+	}
+	o := profileOptions{maxDistance: DefaultMaxDistance, spacing: DefaultSpacing, refraction: DefaultRefraction}
+	for _, apply := range opts {
+		apply(&o)
+	}
+	if o.spacing <= 0 || o.maxDistance <= 0 {
+		return nil, fmt.Errorf("terrain: spacing and distance must be positive")
+	}
+
+	jd := julian.FromTime(t)
+	sun := solar.ApparentPosition(julian.Centuries(astrotime.TT(t)))
+	gast := sidereal.GAST(jd)
+	sunAt := func(latitude, longitude float64) (altitude, azimuth float64) {
+		h := sun.ToHorizontal(latitude, gast+longitude)
+		return h.Altitude + observer.Observer{}.RefractionAt(h.Altitude), h.Azimuth
+	}
+
+	rows := int(math.Floor((area.North-area.South)/resolution+1e-9)) + 1
+	cols := int(math.Floor((area.East-area.West)/resolution+1e-9)) + 1
+	grid := &IlluminationGrid{
+		Area:         area,
+		Resolution:   resolution,
+		Rows:         rows,
+		Cols:         cols,
+		Illumination: make([]float64, rows*cols),
+		Shadow:       make([]bool, rows*cols),
+	}
+	grid.SunAltitude, grid.SunAzimuth = sunAt((area.North+area.South)/2, (area.East+area.West)/2)
+
+	for row := 0; row < rows; row++ {
+		for col := 0; col < cols; col++ {
+			i := row*cols + col
+			latitude, longitude := grid.Position(row, col)
+			altitude, azimuth := sunAt(latitude, longitude)
+			if altitude <= 0 {
+				grid.Shadow[i] = true
+				continue
+			}
+
+			ground, err := elevation(provider, latitude, longitude)
+			if err != nil {
+				return nil, err
+			}
+			skyline, err := o.skyline(provider, latitude, longitude, ground, azimuth)
+			if err != nil {
+				return nil, err
+			}
+			if skyline > altitude {
+				grid.Shadow[i] = true
+				continue
+			}
+			grid.Illumination[i], err = hillshade(provider, latitude, longitude, resolution, altitude, azimuth)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return grid, nil
+}
+
+// hillshade returns the cosine of the angle between the normal of the slope at a position,
+// found by central differences over step degrees, and the direction of the Sun, 0 when the
+// slope faces away
+func hillshade(provider TerrainProvider, latitude, longitude, step, altitude, azimuth float64) (float64, error) {
+	var h [4]float64
+	for i, offset := range [4][2]float64{{0, step}, {0, -step}, {step, 0}, {-step, 0}} {
+		var err error
+		if h[i], err = elevation(provider, latitude+offset[0], longitude+offset[1]); err != nil {
+			return 0, err
+		}
+	}
+	dx := 2 * step * metresPerDegree * math.Cos(latitude*constants.Rad)
+	dy := 2 * step * metresPerDegree
+	east, north := -(h[0]-h[1])/dx, -(h[2]-h[3])/dy
+
+	sinAlt, cosAlt := math.Sincos(altitude * constants.Rad)
+	sinAz, cosAz := math.Sincos(azimuth * constants.Rad)
+	cos := (east*sinAz*cosAlt + north*cosAz*cosAlt + sinAlt) / math.Sqrt(east*east+north*north+1)
+	return math.Max(0, cos), nil
+}
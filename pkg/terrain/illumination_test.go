@@ -0,0 +1,70 @@
+package terrain
+
+import (
+	"math"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Illumination", func() {
+	flat := ProviderFunc(func(float64, float64) (float64, error) { return 0, nil })
+	// a 1000 m ridge along the meridian 0.05°E with 20% slopes on either side
+	ridge := ProviderFunc(func(_, longitude float64) (float64, error) {
+		return math.Max(0, 1000-math.Abs(longitude-0.05)*metresPerDegree*0.2), nil
+	})
+	area := Area{South: -0.01, West: 0, North: 0.01, East: 0.1}
+	equinox := time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)
+
+	It("should light level ground by the sine of the Sun's altitude", func() {
+		grid, err := Illumination(flat, equinox.Add(9*time.Hour), area, 0.05, WithMaxDistance(5))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(grid.Rows).To(Equal(1))
+		Expect(grid.Cols).To(Equal(3))
+		Expect(grid.SunAzimuth).To(BeNumerically("~", 90, 5))
+		for col := 0; col < grid.Cols; col++ {
+			lit, shadow := grid.At(0, col)
+			Expect(shadow).To(BeFalse())
+			Expect(lit).To(BeNumerically("~", math.Sin(grid.SunAltitude*math.Pi/180), 0.005))
+		}
+	})
+
+	It("should be dark everywhere at night", func() {
+		grid, err := Illumination(flat, equinox, area, 0.05, WithMaxDistance(5))
+		Expect(err).NotTo(HaveOccurred())
+		for i := range grid.Shadow {
+			Expect(grid.Shadow[i]).To(BeTrue())
+			Expect(grid.Illumination[i]).To(Equal(0.0))
+		}
+	})
+
+	It("should cast the shadow of a ridge across the valley in the morning", func() {
+		grid, err := Illumination(ridge, equinox.Add(6*time.Hour+30*time.Minute), area, 0.01, WithMaxDistance(20))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(grid.SunAltitude).To(BeNumerically("~", 5.5, 1.5))
+		Expect(grid.Rows).To(Equal(3))
+		Expect(grid.Cols).To(Equal(11))
+
+		// west of the ridge, and on its western slope, the Sun is hidden
+		_, shadow := grid.At(1, 0)
+		Expect(shadow).To(BeTrue())
+		_, shadow = grid.At(1, 4)
+		Expect(shadow).To(BeTrue())
+
+		// the eastern slope faces the low Sun and is lit more strongly than level ground
+		lit, shadow := grid.At(1, 7)
+		Expect(shadow).To(BeFalse())
+		Expect(lit).To(BeNumerically(">", 2*math.Sin(grid.SunAltitude*math.Pi/180)))
+		latitude, longitude := grid.Position(1, 7)
+		Expect(latitude).To(BeNumerically("~", 0, 1e-12))
+		Expect(longitude).To(BeNumerically("~", 0.07, 1e-12))
+	})
+
+	It("should reject empty areas", func() {
+		_, err := Illumination(flat, equinox, Area{South: 1, North: 0, West: 0, East: 1}, 0.1)
+		Expect(err).To(HaveOccurred())
+		_, err = Illumination(flat, equinox, area, 0)
+		Expect(err).To(HaveOccurred())
+	})
+})
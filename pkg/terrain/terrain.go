@@ -0,0 +1,26 @@
+// Package terrain supplies ground elevations from digital elevation models and builds from
+// them the skyline seen by an observer, for rising and setting behind mountains.
+package terrain
+
+import (
+	"errors"
+)
+
+// ErrNoData is returned for positions a model does not cover or where its data are void
+var ErrNoData = errors.New("terrain: no elevation data")
+
+// TerrainProvider is a source of ground elevations: a set of SRTM tiles, another model held in
+// memory or a remote service
+type TerrainProvider interface {
+	// Elevation returns the height of the ground above sea level in metres at a position in
+	// degrees, or an error wrapping ErrNoData where there is none
+	Elevation(latitude, longitude float64) (float64, error)
+}
+
+// ProviderFunc adapts a function to a TerrainProvider
+type ProviderFunc func(latitude, longitude float64) (float64, error)
+
+// Elevation calls f
+func (f ProviderFunc) Elevation(latitude, longitude float64) (float64, error) {
+	return f(latitude, longitude)
+}
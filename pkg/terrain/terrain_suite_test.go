@@ -0,0 +1,13 @@
+package terrain_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestTerrain(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Terrain Suite")
+}
@@ -0,0 +1,113 @@
+// Package reference embeds published values from worked examples of the standard references,
+// so that tests across packages check algorithms against the same independent numbers rather
+// than against values they produced themselves. Each value records its source.
+package reference
+
+import (
+	_ "embed"
+	"encoding/json"
+	"math"
+	"sync"
+)
+
+//go:embed reference.json
+var referenceJSON []byte
+
+// Position is a published geocentric position of a body at one instant. Values the source does
+// not give are NaN.
+type Position struct {
+	Name      string
+	Source    string
+	Body      string  // "Sun", "Moon" or a planet name
+	JDE       float64 // Julian ephemeris day (TT)
+	RA        float64 // apparent right ascension in degrees
+	Dec       float64 // apparent declination in degrees
+	Longitude float64 // geometric ecliptic longitude of date in degrees
+	Latitude  float64 // geometric ecliptic latitude of date in degrees
+	Distance  float64 // AU, or km for the Moon
+}
+
+// Centuries returns the instant as Julian centuries (TT) since J2000.0
+func (p Position) Centuries() float64 {
+	return (p.JDE - 2451545.0) / 36525
+}
+
+// SiderealTime is a published Greenwich sidereal time. Values the source does not give are NaN.
+type SiderealTime struct {
+	Name   string
+	Source string
+	JD     float64 // Julian date (UT)
+	GMST   float64 // mean sidereal time in degrees
+	GAST   float64 // apparent sidereal time in degrees
+}
+
+// record mirrors the JSON, where values a source does not give are null
+type record struct {
+	Name      string   `json:"name"`
+	Source    string   `json:"source"`
+	Body      string   `json:"body"`
+	JDE       float64  `json:"jde"`
+	JD        float64  `json:"jd"`
+	RA        *float64 `json:"ra"`
+	Dec       *float64 `json:"dec"`
+	Longitude *float64 `json:"longitude"`
+	Latitude  *float64 `json:"latitude"`
+	Distance  *float64 `json:"distance"`
+	GMST      *float64 `json:"gmst"`
+	GAST      *float64 `json:"gast"`
+}
+
+var (
+	loadOnce      sync.Once
+	positions     []Position
+	siderealTimes []SiderealTime
+)
+
+// load decodes the embedded values; the data is part of the package, so a decoding error is a
+// programming error
+func load() {
+	loadOnce.Do(func() {
+		var data struct {
+			Positions     []record `json:"positions"`
+			SiderealTimes []record `json:"siderealTimes"`
+		}
+		if err := json.Unmarshal(referenceJSON, &data); err != nil {
+			panic("reference: invalid embedded data: " + err.Error())
+		}
+		for _, r := range data.Positions {
+			positions = append(positions, Position{Name: r.Name, Source: r.Source, Body: r.Body, JDE: r.JDE,
+				RA: value(r.RA), Dec: value(r.Dec), Longitude: value(r.Longitude), Latitude: value(r.Latitude),
+				Distance: value(r.Distance)})
+		}
+		for _, r := range data.SiderealTimes {
+			siderealTimes = append(siderealTimes, SiderealTime{Name: r.Name, Source: r.Source, JD: r.JD,
+				GMST: value(r.GMST), GAST: value(r.GAST)})
+		}
+	})
+}
+
+// value returns a published value, or NaN when it is absent
+func value(v *float64) float64 {
+	if v == nil {
+		return math.NaN()
+	}
+	return *v
+}
+
+// Positions returns the published positions of a body, such as "Sun", "Moon" or "Venus"
+func Positions(body string) []Position {
+	load()
+	var matches []Position
+	for _, p := range positions {
+		if p.Body == body {
+			matches = append(matches, p)
+		}
+	}
+	return matches
+}
+
+// SiderealTimes returns the published sidereal times
+func SiderealTimes() []SiderealTime {
+	load()
+	return append([]SiderealTime(nil), siderealTimes...)
+}
@@ -0,0 +1,32 @@
+package reference
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Reference values", func() {
+	It("should cite a source for every value", func() {
+		for _, body := range []string{"Sun", "Moon", "Venus"} {
+			Expect(Positions(body)).NotTo(BeEmpty())
+			for _, p := range Positions(body) {
+				Expect(p.Source).NotTo(BeEmpty())
+				Expect(p.JDE).To(BeNumerically(">", 2400000))
+				Expect(math.IsNaN(p.RA)).To(BeFalse())
+			}
+		}
+		for _, s := range SiderealTimes() {
+			Expect(s.Source).NotTo(BeEmpty())
+		}
+	})
+
+	It("should mark values a source does not give as NaN", func() {
+		venus := Positions("Venus")[0]
+		Expect(math.IsNaN(venus.Longitude)).To(BeTrue())
+		Expect(Positions("Sun")[0].Latitude).To(Equal(0.0))
+		Expect(Positions("Sun")[0].Centuries()).To(BeNumerically("~", -0.072183436, 1e-9))
+		Expect(Positions("Pluto")).To(BeEmpty())
+	})
+})
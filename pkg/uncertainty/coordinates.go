@@ -0,0 +1,90 @@
+package uncertainty
+
+import (
+	"github.com/ocrosby/astronomy/pkg/constants"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"math"
+)
+
+// Equatorial is an equatorial position in degrees with uncertainties
+type Equatorial struct {
+	RA  Value
+	Dec Value
+}
+
+// Ecliptic is an ecliptic position in degrees with uncertainties
+type Ecliptic struct {
+	Longitude Value
+	Latitude  Value
+}
+
+// Horizontal is a horizontal position in degrees with uncertainties
+type Horizontal struct {
+	Azimuth  Value
+	Altitude Value
+}
+
+// Nominal returns the position without uncertainties
+func (e Equatorial) Nominal() coordinates.Equatorial {
+	return coordinates.Equatorial{RA: e.RA.Mean, Dec: e.Dec.Mean}
+}
+
+// ToEcliptic converts the position to ecliptic coordinates for an obliquity in degrees
+func (e Equatorial) ToEcliptic(obliquity float64) Ecliptic {
+	out := PropagateAngles(func(x []float64) []float64 {
+		ecl := coordinates.Equatorial{RA: x[0], Dec: x[1]}.ToEcliptic(obliquity)
+		return []float64{ecl.Longitude, ecl.Latitude}
+	}, e.RA, e.Dec)
+	return Ecliptic{Longitude: out[0], Latitude: out[1]}
+}
+
+// ToHorizontal converts the position to horizontal coordinates for an observer's latitude and
+// local sidereal time in degrees
+func (e Equatorial) ToHorizontal(latitude, localSiderealTime float64) Horizontal {
+	out := PropagateAngles(func(x []float64) []float64 {
+		h := coordinates.Equatorial{RA: x[0], Dec: x[1]}.ToHorizontal(latitude, localSiderealTime)
+		return []float64{h.Azimuth, h.Altitude}
+	}, e.RA, e.Dec)
+	return Horizontal{Azimuth: out[0], Altitude: out[1]}
+}
+
+// Nominal returns the position without uncertainties
+func (e Ecliptic) Nominal() coordinates.Ecliptic {
+	return coordinates.Ecliptic{Longitude: e.Longitude.Mean, Latitude: e.Latitude.Mean}
+}
+
+// ToEquatorial converts the position to equatorial coordinates for an obliquity in degrees
+func (e Ecliptic) ToEquatorial(obliquity float64) Equatorial {
+	out := PropagateAngles(func(x []float64) []float64 {
+		eq := coordinates.Ecliptic{Longitude: x[0], Latitude: x[1]}.ToEquatorial(obliquity)
+		return []float64{eq.RA, eq.Dec}
+	}, e.Longitude, e.Latitude)
+	return Equatorial{RA: out[0], Dec: out[1]}
+}
+
+// Nominal returns the position without uncertainties
+func (h Horizontal) Nominal() coordinates.Horizontal {
+	return coordinates.Horizontal{Azimuth: h.Azimuth.Mean, Altitude: h.Altitude.Mean}
+}
+
+// Separation returns the angular distance in degrees between two positions with its
+// uncertainty. The first-order propagation vanishes as the positions coincide, where the
+// distance stops being differentiable, so the square of the distance, quadratic in the offsets
+// between the positions and so propagated exactly to second order, bounds the uncertainty
+// there: sigma is the lesser of sqrt(var d²) / 2d and the spread (var d²)^¼ of d about zero.
+func Separation(a, b Equatorial) Value {
+	sep := PropagateAngles(func(x []float64) []float64 {
+		return []float64{coordinates.Separation(
+			coordinates.Equatorial{RA: x[0], Dec: x[1]}, coordinates.Equatorial{RA: x[2], Dec: x[3]})}
+	}, a.RA, a.Dec, b.RA, b.Dec)[0]
+
+	cosDec := math.Cos((a.Dec.Mean + b.Dec.Mean) / 2 * constants.Rad)
+	varRA := (a.RA.Sigma*a.RA.Sigma + b.RA.Sigma*b.RA.Sigma) * cosDec * cosDec
+	varDec := a.Dec.Sigma*a.Dec.Sigma + b.Dec.Sigma*b.Dec.Sigma
+	variance := 4*sep.Mean*sep.Mean*sep.Sigma*sep.Sigma + 2*(varRA*varRA+varDec*varDec)
+	sep.Sigma = math.Sqrt(math.Sqrt(variance))
+	if sep.Mean > 0 {
+		sep.Sigma = math.Min(sep.Sigma, math.Sqrt(variance)/(2*sep.Mean))
+	}
+	return sep
+}
@@ -0,0 +1,134 @@
+package uncertainty
+
+import (
+	"fmt"
+	"math"
+)
+
+// Uncertainties are propagated to first order assuming the inputs of each operation are
+// independent, which holds for separate measurements but not for a value combined with itself.
+
+// Value is a quantity with a one-sigma uncertainty
+type Value struct {
+	Mean  float64
+	Sigma float64
+}
+
+// New creates a value with an uncertainty; the sign of sigma is ignored
+func New(mean, sigma float64) Value {
+	return Value{Mean: mean, Sigma: math.Abs(sigma)}
+}
+
+// Exact creates a value without uncertainty
+func Exact(mean float64) Value {
+	return Value{Mean: mean}
+}
+
+// String returns the value as "mean ± sigma"
+func (v Value) String() string {
+	return fmt.Sprintf("%g ± %g", v.Mean, v.Sigma)
+}
+
+// RelativeError returns sigma divided by the magnitude of the mean
+func (v Value) RelativeError() float64 {
+	return v.Sigma / math.Abs(v.Mean)
+}
+
+// Add returns v + other
+func (v Value) Add(other Value) Value {
+	return Value{Mean: v.Mean + other.Mean, Sigma: math.Hypot(v.Sigma, other.Sigma)}
+}
+
+// Subtract returns v - other
+func (v Value) Subtract(other Value) Value {
+	return Value{Mean: v.Mean - other.Mean, Sigma: math.Hypot(v.Sigma, other.Sigma)}
+}
+
+// Multiply returns v × other
+func (v Value) Multiply(other Value) Value {
+	return Value{Mean: v.Mean * other.Mean, Sigma: math.Hypot(v.Sigma*other.Mean, other.Sigma*v.Mean)}
+}
+
+// Divide returns v ÷ other
+func (v Value) Divide(other Value) Value {
+	mean := v.Mean / other.Mean
+	return Value{Mean: mean, Sigma: math.Hypot(v.Sigma/other.Mean, other.Sigma*mean/other.Mean)}
+}
+
+// Scale returns v multiplied by an exact factor
+func (v Value) Scale(k float64) Value {
+	return Value{Mean: v.Mean * k, Sigma: v.Sigma * math.Abs(k)}
+}
+
+// Sqrt returns the square root of v
+func Sqrt(v Value) Value {
+	mean := math.Sqrt(v.Mean)
+	return Value{Mean: mean, Sigma: v.Sigma / (2 * mean)}
+}
+
+// Sin returns the sine of v in radians
+func Sin(v Value) Value {
+	sin, cos := math.Sincos(v.Mean)
+	return Value{Mean: sin, Sigma: v.Sigma * math.Abs(cos)}
+}
+
+// Cos returns the cosine of v in radians
+func Cos(v Value) Value {
+	sin, cos := math.Sincos(v.Mean)
+	return Value{Mean: cos, Sigma: v.Sigma * math.Abs(sin)}
+}
+
+// Asin returns the arcsine of v in radians
+func Asin(v Value) Value {
+	return Value{Mean: math.Asin(v.Mean), Sigma: v.Sigma / math.Sqrt(1-v.Mean*v.Mean)}
+}
+
+// Atan2 returns the arctangent of y/x in radians, using the signs of both to find the quadrant
+func Atan2(y, x Value) Value {
+	r2 := x.Mean*x.Mean + y.Mean*y.Mean
+	return Value{Mean: math.Atan2(y.Mean, x.Mean), Sigma: math.Hypot(x.Mean*y.Sigma, y.Mean*x.Sigma) / r2}
+}
+
+// Propagate evaluates f at the means of the inputs and propagates their uncertainties through
+// the numerically differentiated Jacobian of f
+func Propagate(f func(x []float64) []float64, inputs ...Value) []Value {
+	return propagate(f, func(a, b float64) float64 { return a - b }, inputs)
+}
+
+// PropagateAngles is Propagate for functions returning angles in degrees, so that outputs
+// wrapping through 0°/360° do not produce spurious derivatives
+func PropagateAngles(f func(x []float64) []float64, inputs ...Value) []Value {
+	return propagate(f, func(a, b float64) float64 { return math.Remainder(a-b, 360) }, inputs)
+}
+
+// propagate differentiates f by central differences of one-thousandth of each input's sigma
+func propagate(f func(x []float64) []float64, difference func(a, b float64) float64, inputs []Value) []Value {
+	x := make([]float64, len(inputs))
+	for i, in := range inputs {
+		x[i] = in.Mean
+	}
+	nominal := f(x)
+	variance := make([]float64, len(nominal))
+
+	for i, in := range inputs {
+		if in.Sigma == 0 {
+			continue
+		}
+		step := in.Sigma * 1e-3
+		x[i] = in.Mean + step
+		above := f(x)
+		x[i] = in.Mean - step
+		below := f(x)
+		x[i] = in.Mean
+		for j := range variance {
+			derivative := difference(above[j], below[j]) / (2 * step)
+			variance[j] += derivative * derivative * in.Sigma * in.Sigma
+		}
+	}
+
+	outputs := make([]Value, len(nominal))
+	for j, mean := range nominal {
+		outputs[j] = Value{Mean: mean, Sigma: math.Sqrt(variance[j])}
+	}
+	return outputs
+}
@@ -0,0 +1,13 @@
+package uncertainty_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestUncertainty(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Uncertainty Suite")
+}
@@ -0,0 +1,87 @@
+package uncertainty
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Value", func() {
+	a, b := New(10, 0.3), New(4, -0.4)
+
+	It("should combine independent uncertainties in quadrature", func() {
+		Expect(a.Add(b)).To(Equal(Value{Mean: 14, Sigma: 0.5}))
+		Expect(a.Subtract(b)).To(Equal(Value{Mean: 6, Sigma: 0.5}))
+	})
+
+	It("should propagate relative errors through products and quotients", func() {
+		product := a.Multiply(b)
+		Expect(product.Mean).To(Equal(40.0))
+		Expect(product.RelativeError()).To(BeNumerically("~", math.Hypot(0.03, 0.1), 1e-12))
+		quotient := a.Divide(b)
+		Expect(quotient.Mean).To(Equal(2.5))
+		Expect(quotient.RelativeError()).To(BeNumerically("~", math.Hypot(0.03, 0.1), 1e-12))
+	})
+
+	It("should scale by exact factors", func() {
+		Expect(a.Scale(-2)).To(Equal(Value{Mean: -20, Sigma: 0.6}))
+		Expect(Exact(3).Sigma).To(BeZero())
+	})
+
+	It("should propagate through functions", func() {
+		Expect(Sqrt(New(16, 0.8)).Sigma).To(BeNumerically("~", 0.1, 1e-12))
+		Expect(Sin(New(0, 0.01)).Sigma).To(BeNumerically("~", 0.01, 1e-12))
+		Expect(Cos(New(0, 0.01)).Sigma).To(BeZero())
+		Expect(Asin(New(0, 0.01)).Sigma).To(BeNumerically("~", 0.01, 1e-12))
+		Expect(Atan2(New(1, 0.1), Exact(1)).Sigma).To(BeNumerically("~", 0.05, 1e-12))
+	})
+
+	It("should match analytic propagation numerically", func() {
+		out := Propagate(func(x []float64) []float64 { return []float64{x[0] * x[1]} }, a, b)
+		Expect(out[0].Mean).To(Equal(a.Multiply(b).Mean))
+		Expect(out[0].Sigma).To(BeNumerically("~", a.Multiply(b).Sigma, 1e-9))
+	})
+
+	It("should format as mean ± sigma", func() {
+		Expect(a.String()).To(Equal("10 ± 0.3"))
+	})
+})
+
+var _ = Describe("Positions", func() {
+	It("should propagate uncertainty through separations", func() {
+		a := Equatorial{RA: New(10, 0.01), Dec: Exact(0)}
+		b := Equatorial{RA: Exact(20), Dec: New(0, 0.01)}
+		sep := Separation(a, b)
+		Expect(sep.Mean).To(BeNumerically("~", 10, 1e-9))
+		Expect(sep.Sigma).To(BeNumerically("~", 0.01, 1e-6))
+	})
+
+	It("should keep the uncertainty of the separation of coincident positions", func() {
+		a := Equatorial{RA: New(10, 0.01), Dec: New(0, 0.01)}
+		sep := Separation(a, a)
+		Expect(sep.Mean).To(BeZero())
+		Expect(sep.Sigma).To(BeNumerically("~", 0.02, 1e-9))
+
+		coincident := Separation(a, Equatorial{RA: Exact(10), Dec: Exact(0)})
+		Expect(coincident.Sigma).To(BeNumerically("~", math.Sqrt2*0.01, 1e-9))
+		near := Separation(a, Equatorial{RA: Exact(10.001), Dec: Exact(0)})
+		Expect(near.Sigma).To(BeNumerically("~", coincident.Sigma, 1e-4))
+	})
+
+	It("should handle right ascensions wrapping through zero", func() {
+		e := Equatorial{RA: New(0, 0.05), Dec: New(30, 0.02)}
+		ecl := e.ToEcliptic(23.44)
+		Expect(ecl.Longitude.Sigma).To(BeNumerically("<", 0.1))
+		back := ecl.ToEquatorial(23.44)
+		Expect(back.Nominal().Dec).To(BeNumerically("~", 30, 1e-9))
+	})
+
+	It("should propagate to horizontal coordinates", func() {
+		e := Equatorial{RA: New(100, 0.01), Dec: New(30, 0.02)}
+		h := e.ToHorizontal(0, 100)
+		Expect(h.Nominal().Altitude).To(BeNumerically("~", 60, 1e-9))
+		Expect(h.Altitude.Sigma).To(BeNumerically("~", 0.02, 1e-6))
+		Expect(h.Azimuth.Sigma).To(BeNumerically(">", 0))
+	})
+})
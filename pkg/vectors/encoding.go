@@ -0,0 +1,237 @@
+package vectors
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Encoded sizes of vectors as packed little-endian float64 components
+const (
+	Vector2DSize = 16
+	Vector3DSize = 24
+)
+
+// MarshalBinary encodes the vector as packed little-endian float64 values
+func (v Vector2D) MarshalBinary() ([]byte, error) {
+	return v.AppendBinary(make([]byte, 0, Vector2DSize))
+}
+
+// AppendBinary appends the packed little-endian encoding of the vector to dst
+func (v Vector2D) AppendBinary(dst []byte) ([]byte, error) {
+	dst = binary.LittleEndian.AppendUint64(dst, math.Float64bits(v.X))
+	dst = binary.LittleEndian.AppendUint64(dst, math.Float64bits(v.Y))
+	return dst, nil
+}
+
+// UnmarshalBinary decodes a vector encoded by MarshalBinary
+func (v *Vector2D) UnmarshalBinary(data []byte) error {
+	if len(data) != Vector2DSize {
+		return fmt.Errorf("invalid Vector2D encoding: expected %d bytes, got %d", Vector2DSize, len(data))
+	}
+	v.X = math.Float64frombits(binary.LittleEndian.Uint64(data[0:8]))
+	v.Y = math.Float64frombits(binary.LittleEndian.Uint64(data[8:16]))
+	return nil
+}
+
+// MarshalText encodes the vector as "(x, y)" using the shortest exact representation
+func (v Vector2D) MarshalText() ([]byte, error) {
+	return formatComponents([]float64{v.X, v.Y}), nil
+}
+
+// UnmarshalText decodes a vector from "(x, y)" or "x, y"
+func (v *Vector2D) UnmarshalText(text []byte) error {
+	components, err := parseComponents(string(text), 2)
+	if err != nil {
+		return fmt.Errorf("invalid Vector2D text: %v", err)
+	}
+	v.X, v.Y = components[0], components[1]
+	return nil
+}
+
+// MarshalJSON encodes the vector as a JSON array [x, y]
+func (v Vector2D) MarshalJSON() ([]byte, error) {
+	return json.Marshal([2]float64{v.X, v.Y})
+}
+
+// UnmarshalJSON decodes a vector from a JSON array [x, y]
+func (v *Vector2D) UnmarshalJSON(data []byte) error {
+	var components []float64
+	if err := json.Unmarshal(data, &components); err != nil {
+		return fmt.Errorf("invalid Vector2D JSON: %v", err)
+	}
+	if len(components) != 2 {
+		return fmt.Errorf("invalid Vector2D JSON: expected 2 components, got %d", len(components))
+	}
+	v.X, v.Y = components[0], components[1]
+	return nil
+}
+
+// MarshalBinary encodes the vector as packed little-endian float64 values
+func (v Vector3D) MarshalBinary() ([]byte, error) {
+	return v.AppendBinary(make([]byte, 0, Vector3DSize))
+}
+
+// AppendBinary appends the packed little-endian encoding of the vector to dst
+func (v Vector3D) AppendBinary(dst []byte) ([]byte, error) {
+	dst = binary.LittleEndian.AppendUint64(dst, math.Float64bits(v.X))
+	dst = binary.LittleEndian.AppendUint64(dst, math.Float64bits(v.Y))
+	dst = binary.LittleEndian.AppendUint64(dst, math.Float64bits(v.Z))
+	return dst, nil
+}
+
+// UnmarshalBinary decodes a vector encoded by MarshalBinary
+func (v *Vector3D) UnmarshalBinary(data []byte) error {
+	if len(data) != Vector3DSize {
+		return fmt.Errorf("invalid Vector3D encoding: expected %d bytes, got %d", Vector3DSize, len(data))
+	}
+	v.X = math.Float64frombits(binary.LittleEndian.Uint64(data[0:8]))
+	v.Y = math.Float64frombits(binary.LittleEndian.Uint64(data[8:16]))
+	v.Z = math.Float64frombits(binary.LittleEndian.Uint64(data[16:24]))
+	return nil
+}
+
+// MarshalText encodes the vector as "(x, y, z)" using the shortest exact representation
+func (v Vector3D) MarshalText() ([]byte, error) {
+	return formatComponents([]float64{v.X, v.Y, v.Z}), nil
+}
+
+// UnmarshalText decodes a vector from "(x, y, z)" or "x, y, z"
+func (v *Vector3D) UnmarshalText(text []byte) error {
+	components, err := parseComponents(string(text), 3)
+	if err != nil {
+		return fmt.Errorf("invalid Vector3D text: %v", err)
+	}
+	v.X, v.Y, v.Z = components[0], components[1], components[2]
+	return nil
+}
+
+// MarshalJSON encodes the vector as a JSON array [x, y, z]
+func (v Vector3D) MarshalJSON() ([]byte, error) {
+	return json.Marshal([3]float64{v.X, v.Y, v.Z})
+}
+
+// UnmarshalJSON decodes a vector from a JSON array [x, y, z]
+func (v *Vector3D) UnmarshalJSON(data []byte) error {
+	var components []float64
+	if err := json.Unmarshal(data, &components); err != nil {
+		return fmt.Errorf("invalid Vector3D JSON: %v", err)
+	}
+	if len(components) != 3 {
+		return fmt.Errorf("invalid Vector3D JSON: expected 3 components, got %d", len(components))
+	}
+	v.X, v.Y, v.Z = components[0], components[1], components[2]
+	return nil
+}
+
+// formatComponents renders components as "(a, b, ...)"
+func formatComponents(components []float64) []byte {
+	buf := []byte{'('}
+	for i, c := range components {
+		if i > 0 {
+			buf = append(buf, ',', ' ')
+		}
+		buf = strconv.AppendFloat(buf, c, 'g', -1, 64)
+	}
+	return append(buf, ')')
+}
+
+// parseComponents parses a comma-separated list of n floats, optionally wrapped in parentheses
+func parseComponents(text string, n int) ([]float64, error) {
+	text = strings.TrimSpace(text)
+	text = strings.TrimSuffix(strings.TrimPrefix(text, "("), ")")
+	parts := strings.Split(text, ",")
+	if len(parts) != n {
+		return nil, fmt.Errorf("expected %d components, got %d", n, len(parts))
+	}
+
+	components := make([]float64, n)
+	for i, part := range parts {
+		value, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return nil, fmt.Errorf("component %d: %v", i+1, err)
+		}
+		components[i] = value
+	}
+	return components, nil
+}
+
+// MarshalVector2DSlice packs vectors into a contiguous little-endian float64 buffer
+func MarshalVector2DSlice(vectors []Vector2D) []byte {
+	buf := make([]byte, 0, len(vectors)*Vector2DSize)
+	for _, v := range vectors {
+		buf, _ = v.AppendBinary(buf)
+	}
+	return buf
+}
+
+// UnmarshalVector2DSlice unpacks a buffer produced by MarshalVector2DSlice
+func UnmarshalVector2DSlice(data []byte) ([]Vector2D, error) {
+	if len(data)%Vector2DSize != 0 {
+		return nil, fmt.Errorf("invalid Vector2D slice encoding: length %d is not a multiple of %d", len(data), Vector2DSize)
+	}
+	vectors := make([]Vector2D, len(data)/Vector2DSize)
+	for i := range vectors {
+		_ = vectors[i].UnmarshalBinary(data[i*Vector2DSize : (i+1)*Vector2DSize])
+	}
+	return vectors, nil
+}
+
+// MarshalVector3DSlice packs vectors into a contiguous little-endian float64 buffer
+func MarshalVector3DSlice(vectors []Vector3D) []byte {
+	buf := make([]byte, 0, len(vectors)*Vector3DSize)
+	for _, v := range vectors {
+		buf, _ = v.AppendBinary(buf)
+	}
+	return buf
+}
+
+// UnmarshalVector3DSlice unpacks a buffer produced by MarshalVector3DSlice
+func UnmarshalVector3DSlice(data []byte) ([]Vector3D, error) {
+	if len(data)%Vector3DSize != 0 {
+		return nil, fmt.Errorf("invalid Vector3D slice encoding: length %d is not a multiple of %d", len(data), Vector3DSize)
+	}
+	vectors := make([]Vector3D, len(data)/Vector3DSize)
+	for i := range vectors {
+		_ = vectors[i].UnmarshalBinary(data[i*Vector3DSize : (i+1)*Vector3DSize])
+	}
+	return vectors, nil
+}
+
+// WriteVector3Ds streams vectors to w as packed little-endian float64 values
+func WriteVector3Ds(w io.Writer, vectors []Vector3D) error {
+	bw := bufio.NewWriter(w)
+	var buf [Vector3DSize]byte
+	for _, v := range vectors {
+		encoded, _ := v.AppendBinary(buf[:0])
+		if _, err := bw.Write(encoded); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// ReadVector3Ds reads packed vectors from r until EOF
+func ReadVector3Ds(r io.Reader) ([]Vector3D, error) {
+	br := bufio.NewReader(r)
+	var vectors []Vector3D
+	var buf [Vector3DSize]byte
+	for {
+		_, err := io.ReadFull(br, buf[:])
+		if errors.Is(err, io.EOF) {
+			return vectors, nil
+		}
+		if err != nil {
+			return vectors, fmt.Errorf("reading Vector3D %d: %w", len(vectors), err)
+		}
+		var v Vector3D
+		_ = v.UnmarshalBinary(buf[:])
+		vectors = append(vectors, v)
+	}
+}
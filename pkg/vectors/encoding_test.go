@@ -0,0 +1,134 @@
+package vectors
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Encoding", func() {
+	Describe("binary", func() {
+		It("should round-trip a Vector2D", func() {
+			original := Vector2D{1.5, -math.Pi}
+			data, err := original.MarshalBinary()
+			Expect(err).To(BeNil())
+			Expect(data).To(HaveLen(Vector2DSize))
+
+			var decoded Vector2D
+			Expect(decoded.UnmarshalBinary(data)).To(Succeed())
+			Expect(decoded).To(Equal(original))
+		})
+
+		It("should round-trip a Vector3D", func() {
+			original := Vector3D{1e-300, -0.0, 149597870.7}
+			data, err := original.MarshalBinary()
+			Expect(err).To(BeNil())
+			Expect(data).To(HaveLen(Vector3DSize))
+
+			var decoded Vector3D
+			Expect(decoded.UnmarshalBinary(data)).To(Succeed())
+			Expect(decoded).To(Equal(original))
+		})
+
+		It("should encode components little-endian", func() {
+			data, _ := Vector2D{1, 0}.MarshalBinary()
+			Expect(data[:8]).To(Equal([]byte{0, 0, 0, 0, 0, 0, 0xf0, 0x3f}))
+		})
+
+		It("should reject buffers of the wrong size", func() {
+			var v Vector3D
+			err := v.UnmarshalBinary(make([]byte, 16))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("expected 24 bytes"))
+		})
+	})
+
+	Describe("text", func() {
+		It("should round-trip with full precision", func() {
+			original := Vector3D{0.1, 1.0 / 3.0, -2}
+			text, err := original.MarshalText()
+			Expect(err).To(BeNil())
+			Expect(string(text)).To(Equal("(0.1, 0.3333333333333333, -2)"))
+
+			var decoded Vector3D
+			Expect(decoded.UnmarshalText(text)).To(Succeed())
+			Expect(decoded).To(Equal(original))
+		})
+
+		It("should accept text without parentheses", func() {
+			var v Vector2D
+			Expect(v.UnmarshalText([]byte("3, 4"))).To(Succeed())
+			Expect(v).To(Equal(Vector2D{3, 4}))
+		})
+
+		It("should reject the wrong number of components", func() {
+			var v Vector2D
+			err := v.UnmarshalText([]byte("(1, 2, 3)"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("expected 2 components"))
+		})
+	})
+
+	Describe("JSON", func() {
+		It("should encode vectors as arrays", func() {
+			data, err := json.Marshal(struct {
+				Position Vector3D `json:"position"`
+			}{Vector3D{1, 2, 3}})
+			Expect(err).To(BeNil())
+			Expect(string(data)).To(Equal(`{"position":[1,2,3]}`))
+		})
+
+		It("should decode vectors from arrays", func() {
+			var v Vector2D
+			Expect(json.Unmarshal([]byte("[0.5, -1]"), &v)).To(Succeed())
+			Expect(v).To(Equal(Vector2D{0.5, -1}))
+		})
+
+		It("should reject arrays of the wrong length", func() {
+			var v Vector3D
+			Expect(json.Unmarshal([]byte("[1, 2]"), &v)).NotTo(Succeed())
+		})
+	})
+
+	Describe("slices", func() {
+		vectors := []Vector3D{{1, 2, 3}, {4, 5, 6}, {-7, 8.5, 1e10}}
+
+		It("should pack and unpack Vector3D slices", func() {
+			data := MarshalVector3DSlice(vectors)
+			Expect(data).To(HaveLen(len(vectors) * Vector3DSize))
+			decoded, err := UnmarshalVector3DSlice(data)
+			Expect(err).To(BeNil())
+			Expect(decoded).To(Equal(vectors))
+		})
+
+		It("should pack and unpack Vector2D slices", func() {
+			planar := []Vector2D{{1, 2}, {3, 4}}
+			decoded, err := UnmarshalVector2DSlice(MarshalVector2DSlice(planar))
+			Expect(err).To(BeNil())
+			Expect(decoded).To(Equal(planar))
+		})
+
+		It("should reject truncated buffers", func() {
+			_, err := UnmarshalVector3DSlice(make([]byte, 30))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should stream vectors through a writer and reader", func() {
+			var buf bytes.Buffer
+			Expect(WriteVector3Ds(&buf, vectors)).To(Succeed())
+			decoded, err := ReadVector3Ds(&buf)
+			Expect(err).To(BeNil())
+			Expect(decoded).To(Equal(vectors))
+		})
+
+		It("should report a truncated stream", func() {
+			data := MarshalVector3DSlice(vectors)
+			decoded, err := ReadVector3Ds(bytes.NewReader(data[:len(data)-4]))
+			Expect(err).To(HaveOccurred())
+			Expect(decoded).To(HaveLen(2))
+		})
+	})
+})
@@ -0,0 +1,351 @@
+package vectors
+
+import (
+	"math"
+)
+
+// parallelTolerance is the squared sine of the angle below which two directions are treated
+// as parallel
+const parallelTolerance = 1e-24
+
+// Plane is the set of points X with Normal·X = D. The normal need not be unit length; D is
+// then in units of its magnitude.
+type Plane struct {
+	Normal Vector3D
+	D      float64
+}
+
+// NewPlane returns the plane through a point with the given normal
+func NewPlane(point, normal Vector3D) Plane {
+	return Plane{Normal: normal, D: normal.DotProduct(point)}
+}
+
+// PlaneFromPoints returns the plane through three points, oriented so the normal follows the
+// right-hand rule from a to b to c. It reports false when the points are collinear.
+func PlaneFromPoints(a, b, c Vector3D) (Plane, bool) {
+	normal := b.Subtract(a).CrossProduct(c.Subtract(a))
+	if normal.Magnitude() == 0 {
+		return Plane{}, false
+	}
+	return NewPlane(a, normal.Normalize()), true
+}
+
+// Unit returns the same plane with a unit normal
+func (p Plane) Unit() Plane {
+	m := p.Normal.Magnitude()
+	if m == 0 {
+		return p
+	}
+	return Plane{Normal: p.Normal.ScalarMultiply(1 / m), D: p.D / m}
+}
+
+// SignedDistance returns the distance from the plane to a point, positive on the side the
+// normal points to
+func (p Plane) SignedDistance(point Vector3D) float64 {
+	return (p.Normal.DotProduct(point) - p.D) / p.Normal.Magnitude()
+}
+
+// DistanceToPoint returns the distance from the plane to a point
+func (p Plane) DistanceToPoint(point Vector3D) float64 {
+	return math.Abs(p.SignedDistance(point))
+}
+
+// Project returns the point of the plane closest to a point
+func (p Plane) Project(point Vector3D) Vector3D {
+	u := p.Unit()
+	return point.Subtract(u.Normal.ScalarMultiply(u.SignedDistance(point)))
+}
+
+// AngleTo returns the angle between two planes in radians, in [0, π]
+func (p Plane) AngleTo(other Plane) float64 {
+	return Angle3D(p.Normal, other.Normal)
+}
+
+// IntersectLine returns the point where the infinite line through a ray crosses the plane and
+// the ray parameter of that point, which may be negative. It reports false when the line is
+// parallel to the plane.
+func (p Plane) IntersectLine(r Ray) (Vector3D, float64, bool) {
+	denominator := p.Normal.DotProduct(r.Direction)
+	if denominator*denominator <= parallelTolerance*p.Normal.DotProduct(p.Normal)*r.Direction.DotProduct(r.Direction) {
+		return Vector3D{}, 0, false
+	}
+	t := (p.D - p.Normal.DotProduct(r.Origin)) / denominator
+	return r.At(t), t, true
+}
+
+// IntersectPlane returns the line where two planes meet, as a ray whose direction is
+// Normal × other.Normal starting from the point of the line closest to the origin. It reports
+// false when the planes are parallel.
+func (p Plane) IntersectPlane(other Plane) (Ray, bool) {
+	direction := p.Normal.CrossProduct(other.Normal)
+	length2 := direction.DotProduct(direction)
+	if length2 <= parallelTolerance*p.Normal.DotProduct(p.Normal)*other.Normal.DotProduct(other.Normal) {
+		return Ray{}, false
+	}
+	origin := other.Normal.CrossProduct(direction).ScalarMultiply(p.D).
+		Add(direction.CrossProduct(p.Normal).ScalarMultiply(other.D)).
+		ScalarMultiply(1 / length2)
+	return Ray{Origin: origin, Direction: direction}, true
+}
+
+// IntersectPlanes returns the single point common to three planes. It reports false when any
+// two of them are parallel or the three share a line.
+func IntersectPlanes(a, b, c Plane) (Vector3D, bool) {
+	bc := b.Normal.CrossProduct(c.Normal)
+	determinant := a.Normal.DotProduct(bc)
+	scale := a.Normal.Magnitude() * b.Normal.Magnitude() * c.Normal.Magnitude()
+	if math.Abs(determinant) <= math.Sqrt(parallelTolerance)*scale {
+		return Vector3D{}, false
+	}
+	point := bc.ScalarMultiply(a.D).
+		Add(c.Normal.CrossProduct(a.Normal).ScalarMultiply(b.D)).
+		Add(a.Normal.CrossProduct(b.Normal).ScalarMultiply(c.D))
+	return point.ScalarMultiply(1 / determinant), true
+}
+
+// Ray is the half-line Origin + t·Direction for t ≥ 0. The direction need not be unit length;
+// ray parameters are then in units of its magnitude.
+type Ray struct {
+	Origin    Vector3D
+	Direction Vector3D
+}
+
+// At returns the point at parameter t along the ray
+func (r Ray) At(t float64) Vector3D {
+	return r.Origin.Add(r.Direction.ScalarMultiply(t))
+}
+
+// ClosestPoint returns the point of the ray closest to a point
+func (r Ray) ClosestPoint(point Vector3D) Vector3D {
+	length2 := r.Direction.DotProduct(r.Direction)
+	if length2 == 0 {
+		return r.Origin
+	}
+	t := point.Subtract(r.Origin).DotProduct(r.Direction) / length2
+	return r.At(math.Max(t, 0))
+}
+
+// DistanceToPoint returns the distance from the ray to a point
+func (r Ray) DistanceToPoint(point Vector3D) float64 {
+	return point.Subtract(r.ClosestPoint(point)).Magnitude()
+}
+
+// IntersectPlane returns the point where the ray crosses a plane and its ray parameter. It
+// reports false when the ray is parallel to the plane or points away from it.
+func (r Ray) IntersectPlane(p Plane) (Vector3D, float64, bool) {
+	point, t, ok := p.IntersectLine(r)
+	if !ok || t < 0 {
+		return Vector3D{}, 0, false
+	}
+	return point, t, true
+}
+
+// LineDistance returns the shortest distance between the infinite lines through two rays
+func (r Ray) LineDistance(other Ray) float64 {
+	p, q, ok := ClosestPointsBetweenLines(r, other)
+	if !ok {
+		return distanceToLine(other.Origin, r)
+	}
+	return p.Subtract(q).Magnitude()
+}
+
+// AngleTo returns the acute angle in radians between the lines of two rays
+func (r Ray) AngleTo(other Ray) float64 {
+	cos := math.Abs(r.Direction.DotProduct(other.Direction)) / (r.Direction.Magnitude() * other.Direction.Magnitude())
+	return math.Acos(math.Min(cos, 1))
+}
+
+// ClosestPointsBetweenLines returns the points of closest approach of the infinite lines
+// through two rays, the first on a and the second on b. It reports false when the lines are
+// parallel, in which case every point is equally close.
+func ClosestPointsBetweenLines(a, b Ray) (Vector3D, Vector3D, bool) {
+	w := a.Origin.Subtract(b.Origin)
+	aa := a.Direction.DotProduct(a.Direction)
+	ab := a.Direction.DotProduct(b.Direction)
+	bb := b.Direction.DotProduct(b.Direction)
+	denominator := aa*bb - ab*ab
+	if denominator <= parallelTolerance*aa*bb {
+		return Vector3D{}, Vector3D{}, false
+	}
+	aw := a.Direction.DotProduct(w)
+	bw := b.Direction.DotProduct(w)
+	s := (ab*bw - bb*aw) / denominator
+	t := (aa*bw - ab*aw) / denominator
+	return a.At(s), b.At(t), true
+}
+
+// distanceToLine returns the distance from a point to the infinite line through a ray
+func distanceToLine(point Vector3D, r Ray) float64 {
+	return point.Subtract(r.Origin).CrossProduct(r.Direction).Magnitude() / r.Direction.Magnitude()
+}
+
+// AngleBetweenPlanes calculates the angle between two planes in radians
+//
+// Deprecated: use Plane.AngleTo.
+func AngleBetweenPlanes(n1, n2 Vector3D) float64 {
+	return Plane{Normal: n1}.AngleTo(Plane{Normal: n2})
+}
+
+// AngleBetweenLines calculates the acute angle between two lines with directions v1 and v2 in
+// radians; n1 and n2 are ignored
+//
+// Deprecated: use Ray.AngleTo.
+func AngleBetweenLines(v1, v2 Vector3D, n1, n2 Vector3D) float64 {
+	return Ray{Direction: v1}.AngleTo(Ray{Direction: v2})
+}
+
+// LineOfIntersection returns the direction and a point of the line where the planes
+// n1·X = d1 and n2·X = d2 meet
+//
+// Deprecated: use Plane.IntersectPlane.
+func LineOfIntersection(n1, n2 Vector3D, d1, d2 float64) (Vector3D, Vector3D) {
+	line, _ := Plane{Normal: n1, D: d1}.IntersectPlane(Plane{Normal: n2, D: d2})
+	return line.Direction, line.Origin
+}
+
+// DistanceBetweenLines calculates the distance between the line through v1 with direction n1
+// and the line through v2 with direction n2; d1 and d2 are ignored
+//
+// Deprecated: use Ray.LineDistance.
+func DistanceBetweenLines(v1, v2 Vector3D, n1, n2 Vector3D, d1, d2 float64) float64 {
+	return Ray{Origin: v1, Direction: n1}.LineDistance(Ray{Origin: v2, Direction: n2})
+}
+
+// DistanceToLine calculates the distance from a point p to the line through v with direction n;
+// d is ignored
+//
+// Deprecated: use Ray.DistanceToPoint, or Ray.ClosestPoint for the foot of the perpendicular.
+func DistanceToLine(p, v Vector3D, n Vector3D, d float64) float64 {
+	return distanceToLine(p, Ray{Origin: v, Direction: n})
+}
+
+// DistanceToPlane calculates the distance from a point to the plane n·X = d
+//
+// Deprecated: use Plane.DistanceToPoint.
+func DistanceToPlane(p Vector3D, n Vector3D, d float64) float64 {
+	return Plane{Normal: n, D: d}.DistanceToPoint(p)
+}
+
+// LineOfIntersectionBetweenPlanes returns the line where the first two planes meet; three
+// planes in general position meet in a point, so n3 and d3 are ignored
+//
+// Deprecated: use Plane.IntersectPlane, or IntersectPlanes for the common point of three planes.
+func LineOfIntersectionBetweenPlanes(n1, n2, n3 Vector3D, d1, d2, d3 float64) (Vector3D, Vector3D) {
+	return LineOfIntersection(n1, n2, d1, d2)
+}
+
+// PointOfIntersectionBetweenLines returns the point on the line through v1 with direction n1
+// closest to the line through v2 with direction n2; d1 and d2 are ignored
+//
+// Deprecated: use ClosestPointsBetweenLines.
+func PointOfIntersectionBetweenLines(v1, v2 Vector3D, n1, n2 Vector3D, d1, d2 float64) Vector3D {
+	p, _, _ := ClosestPointsBetweenLines(Ray{Origin: v1, Direction: n1}, Ray{Origin: v2, Direction: n2})
+	return p
+}
+
+// PointOfIntersectionBetweenPlaneAndLine returns the point where the line through p and q
+// crosses the plane n·X = d; v is ignored
+//
+// Deprecated: use Plane.IntersectLine or Ray.IntersectPlane.
+func PointOfIntersectionBetweenPlaneAndLine(v, n Vector3D, d float64, p, q Vector3D) Vector3D {
+	point, _, _ := Plane{Normal: n, D: d}.IntersectLine(Ray{Origin: p, Direction: q.Subtract(p)})
+	return point
+}
+
+// PointOfIntersectionBetweenPlanes calculates the point of intersection between three planes
+//
+// Deprecated: use IntersectPlanes.
+func PointOfIntersectionBetweenPlanes(n1, n2, n3 Vector3D, d1, d2, d3 float64) Vector3D {
+	point, _ := IntersectPlanes(Plane{Normal: n1, D: d1}, Plane{Normal: n2, D: d2}, Plane{Normal: n3, D: d3})
+	return point
+}
+
+// Sphere is a solid ball of the given radius about a center
+type Sphere struct {
+	Center Vector3D
+	Radius float64
+}
+
+// Contains reports whether a point lies inside or on the sphere
+func (s Sphere) Contains(point Vector3D) bool {
+	return point.Subtract(s.Center).Magnitude() <= s.Radius
+}
+
+// Chord is the part of a ray or segment that lies inside a sphere. Near and Far are the
+// parameters of the entry and exit points; a path that starts or ends inside the sphere has
+// its entry or exit at that end.
+type Chord struct {
+	Entry, Exit Vector3D
+	Near, Far   float64
+}
+
+// Length returns the distance between the entry and exit points
+func (c Chord) Length() float64 {
+	return c.Exit.Subtract(c.Entry).Magnitude()
+}
+
+// Segment is the straight path from Start to End
+type Segment struct {
+	Start, End Vector3D
+}
+
+// Ray returns the ray from Start through End, whose parameters 0 and 1 are the two ends
+func (s Segment) Ray() Ray {
+	return Ray{Origin: s.Start, Direction: s.End.Subtract(s.Start)}
+}
+
+// Length returns the length of the segment
+func (s Segment) Length() float64 {
+	return s.End.Subtract(s.Start).Magnitude()
+}
+
+// IntersectSphere returns the part of the segment inside a sphere. It reports false when the
+// segment does not reach the sphere.
+func (s Segment) IntersectSphere(sphere Sphere) (Chord, bool) {
+	return clipChord(s.Ray(), sphere, 1)
+}
+
+// IntersectSphere returns the part of the ray inside a sphere. It reports false when the ray
+// misses the sphere or points away from it.
+func (r Ray) IntersectSphere(sphere Sphere) (Chord, bool) {
+	return clipChord(r, sphere, math.Inf(1))
+}
+
+// clipChord intersects the line through a ray with a sphere and keeps the part with parameters
+// in [0, limit]
+func clipChord(r Ray, sphere Sphere, limit float64) (Chord, bool) {
+	near, far, ok := lineSphere(r, sphere)
+	if !ok {
+		return Chord{}, false
+	}
+	near, far = math.Max(near, 0), math.Min(far, limit)
+	if near > far {
+		return Chord{}, false
+	}
+	return Chord{Entry: r.At(near), Exit: r.At(far), Near: near, Far: far}, true
+}
+
+// lineSphere returns the parameters at which the infinite line through a ray crosses the
+// surface of a sphere, in increasing order. It reports false when the line misses the sphere.
+func lineSphere(r Ray, sphere Sphere) (float64, float64, bool) {
+	a := r.Direction.DotProduct(r.Direction)
+	if a == 0 {
+		return 0, 0, false
+	}
+	w := r.Origin.Subtract(sphere.Center)
+	b := r.Direction.DotProduct(w)
+	// Work with the perpendicular offset of the center rather than b² - ac, which cancels badly
+	// for a distant origin
+	offset := w.Subtract(r.Direction.ScalarMultiply(b / a))
+	discriminant := a * (sphere.Radius*sphere.Radius - offset.DotProduct(offset))
+	if discriminant < 0 {
+		return 0, 0, false
+	}
+	q := -b - math.Copysign(math.Sqrt(discriminant), b)
+	if q == 0 {
+		return 0, 0, true
+	}
+	t1, t2 := q/a, w.DotProduct(w)-sphere.Radius*sphere.Radius
+	t2 /= q
+	return math.Min(t1, t2), math.Max(t1, t2), true
+}
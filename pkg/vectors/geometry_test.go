@@ -0,0 +1,171 @@
+package vectors
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// expectVector compares two vectors component by component
+func expectVector(actual, expected Vector3D) {
+	ExpectWithOffset(1, actual.Subtract(expected).Magnitude()).To(BeNumerically("<", 1e-12), actual.String())
+}
+
+var _ = Describe("Geometry", func() {
+	Describe("Plane", func() {
+		ground := NewPlane(Vector3D{0, 0, 2}, Vector3D{0, 0, 4})
+
+		It("should measure signed and unsigned distances", func() {
+			Expect(ground.SignedDistance(Vector3D{1, 1, 5})).To(BeNumerically("~", 3, 1e-12))
+			Expect(ground.SignedDistance(Vector3D{1, 1, -1})).To(BeNumerically("~", -3, 1e-12))
+			Expect(ground.DistanceToPoint(Vector3D{1, 1, -1})).To(BeNumerically("~", 3, 1e-12))
+		})
+
+		It("should project a point onto the plane", func() {
+			expectVector(ground.Project(Vector3D{3, -1, 7}), Vector3D{3, -1, 2})
+		})
+
+		It("should be built from three points", func() {
+			p, ok := PlaneFromPoints(Vector3D{1, 0, 0}, Vector3D{0, 1, 0}, Vector3D{0, 0, 1})
+			Expect(ok).To(BeTrue())
+			Expect(p.DistanceToPoint(Vector3D{})).To(BeNumerically("~", 1/math.Sqrt(3), 1e-12))
+
+			_, ok = PlaneFromPoints(Vector3D{0, 0, 0}, Vector3D{1, 1, 1}, Vector3D{2, 2, 2})
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should meet another plane in a line", func() {
+			wall := Plane{Normal: Vector3D{1, 0, 0}, D: 3}
+			line, ok := ground.IntersectPlane(wall)
+			Expect(ok).To(BeTrue())
+			Expect(ground.DistanceToPoint(line.Origin)).To(BeNumerically("<", 1e-12))
+			Expect(wall.DistanceToPoint(line.Origin)).To(BeNumerically("<", 1e-12))
+			Expect(ground.DistanceToPoint(line.At(5))).To(BeNumerically("<", 1e-12))
+			expectVector(line.Origin, Vector3D{3, 0, 2})
+
+			_, ok = ground.IntersectPlane(Plane{Normal: Vector3D{0, 0, 1}})
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should find the common point of three planes", func() {
+			point, ok := IntersectPlanes(
+				Plane{Normal: Vector3D{1, 0, 0}, D: 1},
+				Plane{Normal: Vector3D{0, 2, 0}, D: 4},
+				Plane{Normal: Vector3D{1, 1, 1}, D: 6},
+			)
+			Expect(ok).To(BeTrue())
+			expectVector(point, Vector3D{1, 2, 3})
+
+			_, ok = IntersectPlanes(ground, ground, Plane{Normal: Vector3D{1, 0, 0}})
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should measure the angle between planes", func() {
+			Expect(ground.AngleTo(Plane{Normal: Vector3D{0, 1, 1}})).To(BeNumerically("~", math.Pi/4, 1e-12))
+		})
+	})
+
+	Describe("Ray", func() {
+		ray := Ray{Origin: Vector3D{0, 0, 10}, Direction: Vector3D{0, 0, -2}}
+
+		It("should hit a plane in front of it", func() {
+			point, t, ok := ray.IntersectPlane(Plane{Normal: Vector3D{0, 0, 1}, D: 2})
+			Expect(ok).To(BeTrue())
+			Expect(t).To(BeNumerically("~", 4, 1e-12))
+			expectVector(point, Vector3D{0, 0, 2})
+		})
+
+		It("should miss a plane behind it or parallel to it", func() {
+			_, _, ok := ray.IntersectPlane(Plane{Normal: Vector3D{0, 0, 1}, D: 12})
+			Expect(ok).To(BeFalse())
+			_, _, ok = ray.IntersectPlane(Plane{Normal: Vector3D{1, 0, 0}, D: 1})
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should measure the distance to a point", func() {
+			Expect(ray.DistanceToPoint(Vector3D{3, 4, 0})).To(BeNumerically("~", 5, 1e-12))
+			Expect(ray.DistanceToPoint(Vector3D{0, 3, 14})).To(BeNumerically("~", 5, 1e-12))
+		})
+
+		It("should find the closest approach of skew lines", func() {
+			a := Ray{Origin: Vector3D{0, 0, 0}, Direction: Vector3D{1, 0, 0}}
+			b := Ray{Origin: Vector3D{5, -3, 2}, Direction: Vector3D{0, 1, 0}}
+			p, q, ok := ClosestPointsBetweenLines(a, b)
+			Expect(ok).To(BeTrue())
+			expectVector(p, Vector3D{5, 0, 0})
+			expectVector(q, Vector3D{5, 0, 2})
+			Expect(a.LineDistance(b)).To(BeNumerically("~", 2, 1e-12))
+			Expect(a.AngleTo(b)).To(BeNumerically("~", math.Pi/2, 1e-12))
+		})
+
+		It("should measure the distance between parallel lines", func() {
+			a := Ray{Direction: Vector3D{0, 0, 1}}
+			b := Ray{Origin: Vector3D{3, 4, 7}, Direction: Vector3D{0, 0, -1}}
+			Expect(a.LineDistance(b)).To(BeNumerically("~", 5, 1e-12))
+		})
+	})
+
+	Describe("sphere intersection", func() {
+		sphere := Sphere{Center: Vector3D{0, 0, 10}, Radius: 2}
+
+		It("should enter and leave a sphere ahead of a ray", func() {
+			chord, ok := Ray{Direction: Vector3D{0, 0, 1}}.IntersectSphere(sphere)
+			Expect(ok).To(BeTrue())
+			expectVector(chord.Entry, Vector3D{0, 0, 8})
+			expectVector(chord.Exit, Vector3D{0, 0, 12})
+			Expect(chord.Near).To(BeNumerically("~", 8, 1e-12))
+			Expect(chord.Length()).To(BeNumerically("~", 4, 1e-12))
+		})
+
+		It("should start the chord at the origin of a ray inside the sphere", func() {
+			chord, ok := Ray{Origin: Vector3D{0, 0, 11}, Direction: Vector3D{0, 0, -3}}.IntersectSphere(sphere)
+			Expect(ok).To(BeTrue())
+			expectVector(chord.Entry, Vector3D{0, 0, 11})
+			expectVector(chord.Exit, Vector3D{0, 0, 8})
+			Expect(chord.Far).To(BeNumerically("~", 1, 1e-12))
+		})
+
+		It("should miss a sphere beside or behind a ray", func() {
+			_, ok := Ray{Origin: Vector3D{3, 0, 0}, Direction: Vector3D{0, 0, 1}}.IntersectSphere(sphere)
+			Expect(ok).To(BeFalse())
+			_, ok = Ray{Direction: Vector3D{0, 0, -1}}.IntersectSphere(sphere)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should graze a sphere tangentially", func() {
+			chord, ok := Ray{Origin: Vector3D{2, 0, 0}, Direction: Vector3D{0, 0, 1}}.IntersectSphere(sphere)
+			Expect(ok).To(BeTrue())
+			Expect(chord.Length()).To(BeNumerically("<", 1e-6))
+		})
+
+		It("should clip a chord to a segment", func() {
+			chord, ok := Segment{Start: Vector3D{0, 0, 0}, End: Vector3D{0, 0, 9}}.IntersectSphere(sphere)
+			Expect(ok).To(BeTrue())
+			expectVector(chord.Entry, Vector3D{0, 0, 8})
+			expectVector(chord.Exit, Vector3D{0, 0, 9})
+
+			_, ok = Segment{Start: Vector3D{0, 0, 0}, End: Vector3D{0, 0, 7}}.IntersectSphere(sphere)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should stay accurate for a distant origin", func() {
+			earth := Sphere{Radius: 6378}
+			chord, ok := Ray{Origin: Vector3D{0, 1e9, 0}, Direction: Vector3D{0, -1, 0}}.IntersectSphere(earth)
+			Expect(ok).To(BeTrue())
+			Expect(chord.Length()).To(BeNumerically("~", 2*6378, 1e-6))
+			Expect(earth.Contains(chord.Entry.ScalarMultiply(0.999))).To(BeTrue())
+		})
+	})
+
+	Describe("deprecated free functions", func() {
+		It("should delegate to the plane and ray types", func() {
+			Expect(DistanceToPlane(Vector3D{0, 0, 5}, Vector3D{0, 0, 1}, 2)).To(BeNumerically("~", 3, 1e-12))
+			Expect(DistanceToLine(Vector3D{3, 4, 9}, Vector3D{}, Vector3D{0, 0, 1}, 0)).To(BeNumerically("~", 5, 1e-12))
+			Expect(DistanceBetweenLines(Vector3D{}, Vector3D{5, -3, 2}, Vector3D{1, 0, 0}, Vector3D{0, 1, 0}, 0, 0)).
+				To(BeNumerically("~", 2, 1e-12))
+			expectVector(PointOfIntersectionBetweenPlaneAndLine(Vector3D{}, Vector3D{0, 0, 1}, 2,
+				Vector3D{1, 1, 0}, Vector3D{1, 1, 1}), Vector3D{1, 1, 2})
+		})
+	})
+})
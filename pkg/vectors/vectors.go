@@ -78,6 +78,32 @@ func (v Vector2D) Normalize() Vector2D {
 	return Vector2D{v.X / mag, v.Y / mag}
 }
 
+// Reflect reflects the vector off a surface with the given normal, which need not be unit length
+func (v Vector2D) Reflect(normal Vector2D) Vector2D {
+	n := normal.Normalize()
+	return v.Subtract(n.ScalarMultiply(2 * v.DotProduct(n)))
+}
+
+// ComponentMin returns the component-wise minimum of two vectors
+func (v Vector2D) ComponentMin(other Vector2D) Vector2D {
+	return Vector2D{math.Min(v.X, other.X), math.Min(v.Y, other.Y)}
+}
+
+// ComponentMax returns the component-wise maximum of two vectors
+func (v Vector2D) ComponentMax(other Vector2D) Vector2D {
+	return Vector2D{math.Max(v.X, other.X), math.Max(v.Y, other.Y)}
+}
+
+// Abs returns the vector with the absolute value of each component
+func (v Vector2D) Abs() Vector2D {
+	return Vector2D{math.Abs(v.X), math.Abs(v.Y)}
+}
+
+// Clamp limits each component to the range given by the matching components of lo and hi
+func (v Vector2D) Clamp(lo, hi Vector2D) Vector2D {
+	return v.ComponentMax(lo).ComponentMin(hi)
+}
+
 // Vector3D represents a 3-dimensional vector
 type Vector3D struct {
 	X, Y, Z float64
@@ -131,6 +157,32 @@ func (v Vector3D) Normalize() Vector3D {
 	return Vector3D{v.X / mag, v.Y / mag, v.Z / mag}
 }
 
+// Reflect reflects the vector off a surface with the given normal, which need not be unit length
+func (v Vector3D) Reflect(normal Vector3D) Vector3D {
+	n := normal.Normalize()
+	return v.Subtract(n.ScalarMultiply(2 * v.DotProduct(n)))
+}
+
+// ComponentMin returns the component-wise minimum of two vectors
+func (v Vector3D) ComponentMin(other Vector3D) Vector3D {
+	return Vector3D{math.Min(v.X, other.X), math.Min(v.Y, other.Y), math.Min(v.Z, other.Z)}
+}
+
+// ComponentMax returns the component-wise maximum of two vectors
+func (v Vector3D) ComponentMax(other Vector3D) Vector3D {
+	return Vector3D{math.Max(v.X, other.X), math.Max(v.Y, other.Y), math.Max(v.Z, other.Z)}
+}
+
+// Abs returns the vector with the absolute value of each component
+func (v Vector3D) Abs() Vector3D {
+	return Vector3D{math.Abs(v.X), math.Abs(v.Y), math.Abs(v.Z)}
+}
+
+// Clamp limits each component to the range given by the matching components of lo and hi
+func (v Vector3D) Clamp(lo, hi Vector3D) Vector3D {
+	return v.ComponentMax(lo).ComponentMin(hi)
+}
+
 // CrossProduct calculates the cross product of two vectors
 func CrossProduct(v1, v2 Vector2D) float64 {
 	return v1.X*v2.Y - v1.Y*v2.X
@@ -168,6 +220,11 @@ func Angle(v1, v2 Vector2D) float64 {
 	return math.Acos(DotProduct(v1, v2) / (Magnitude(v1) * Magnitude(v2)))
 }
 
+// Reflect reflects a vector off a surface with the given normal using method
+func Reflect(v, normal Vector2D) Vector2D {
+	return v.Reflect(normal)
+}
+
 // Project projects a vector onto another vector
 func Project(v1, v2 Vector2D) Vector2D {
 	return ScalarMultiply(v2, DotProduct(v1, v2)/Magnitude(v2))
@@ -377,6 +434,11 @@ func ScalarMultiply3D(v Vector3D, s float64) Vector3D {
 	return v.ScalarMultiply(s)
 }
 
+// Reflect3D reflects a 3D vector off a surface with the given normal using method
+func Reflect3D(v, normal Vector3D) Vector3D {
+	return v.Reflect(normal)
+}
+
 // Project3D projects a 3D vector onto another 3D vector using methods
 func Project3D(v1, v2 Vector3D) Vector3D {
 	return v2.ScalarMultiply(v1.DotProduct(v2) / v2.Magnitude())
@@ -416,58 +478,3 @@ func Rotate3D(v Vector3D, axis Vector3D, angle float64) Vector3D {
 		(cos1*x*z-sin*y)*v.X + (cos1*y*z+sin*x)*v.Y + (cos+cos1*z*z)*v.Z,
 	}
 }
-
-// AngleBetweenPlanes calculates the angle between two planes in radians
-func AngleBetweenPlanes(n1, n2 Vector3D) float64 {
-	return math.Acos(DotProduct3D(n1, n2) / (Magnitude3D(n1) * Magnitude3D(n2)))
-}
-
-// AngleBetweenLines calculates the angle between two lines in radians
-func AngleBetweenLines(v1, v2 Vector3D, n1, n2 Vector3D) float64 {
-	return math.Acos(math.Abs(DotProduct3D(v1, v2)) / (Magnitude3D(v1) * Magnitude3D(v2)))
-}
-
-// LineOfIntersection calculates the line of intersection between two planes
-func LineOfIntersection(n1, n2 Vector3D, d1, d2 float64) (Vector3D, Vector3D) {
-	line := CrossProduct3D(n1, n2)
-	return line, Add3D(ScalarMultiply3D(n1, d2), ScalarMultiply3D(n2, d1))
-}
-
-// DistanceBetweenLines calculates the distance between two lines
-func DistanceBetweenLines(v1, v2 Vector3D, n1, n2 Vector3D, d1, d2 float64) float64 {
-	return math.Abs(DotProduct3D(Subtract3D(v1, v2), CrossProduct3D(n1, n2))) / Magnitude3D(CrossProduct3D(n1, n2))
-}
-
-// DistanceToLine calculates the distance from a point to a line
-func DistanceToLine(p, v Vector3D, n Vector3D, d float64) float64 {
-	return math.Abs(DotProduct3D(Subtract3D(p, v), n)) / Magnitude3D(n)
-}
-
-// DistanceToPlane calculates the distance from a point to a plane
-func DistanceToPlane(p Vector3D, n Vector3D, d float64) float64 {
-	return math.Abs(DotProduct3D(p, n)-d) / Magnitude3D(n)
-}
-
-// LineOfIntersectionBetweenPlanes calculates the line of intersection between three planes
-func LineOfIntersectionBetweenPlanes(n1, n2, n3 Vector3D, d1, d2, d3 float64) (Vector3D, Vector3D) {
-	line := CrossProduct3D(n1, n2)
-	return line, Add3D(Add3D(ScalarMultiply3D(n1, d2), ScalarMultiply3D(n2, d1)), ScalarMultiply3D(n3, d3))
-}
-
-// PointOfIntersectionBetweenLines calculates the point of intersection between two lines
-func PointOfIntersectionBetweenLines(v1, v2 Vector3D, n1, n2 Vector3D, d1, d2 float64) Vector3D {
-	line, _ := LineOfIntersection(n1, n2, d1, d2)
-	return Add3D(v1, Project3D(Subtract3D(v2, v1), line))
-}
-
-// PointOfIntersectionBetweenPlaneAndLine calculates the point of intersection between a plane and a line
-func PointOfIntersectionBetweenPlaneAndLine(v, n Vector3D, d float64, p, q Vector3D) Vector3D {
-	t := (d - DotProduct3D(v, n)) / DotProduct3D(Subtract3D(p, q), n)
-	return Add3D(p, ScalarMultiply3D(Subtract3D(q, p), t))
-}
-
-// PointOfIntersectionBetweenPlanes calculates the point of intersection between three planes
-func PointOfIntersectionBetweenPlanes(n1, n2, n3 Vector3D, d1, d2, d3 float64) Vector3D {
-	line, point := LineOfIntersectionBetweenPlanes(n1, n2, n3, d1, d2, d3)
-	return Add3D(point, ScalarMultiply3D(line, d1))
-}
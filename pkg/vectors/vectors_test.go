@@ -0,0 +1,48 @@
+package vectors
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Vectors", func() {
+	Describe("Reflect", func() {
+		It("should mirror a 2D vector off a line", func() {
+			Expect(Reflect(Vector2D{1, -1}, Vector2D{0, 1})).To(Equal(Vector2D{1, 1}))
+		})
+
+		It("should accept a normal that is not unit length", func() {
+			Expect(Vector3D{1, 2, -3}.Reflect(Vector3D{0, 0, 5})).To(Equal(Vector3D{1, 2, 3}))
+		})
+
+		It("should preserve the magnitude", func() {
+			v := Reflect3D(Vector3D{3, -4, 12}, Vector3D{1, 1, 1})
+			Expect(v.Magnitude()).To(BeNumerically("~", 13, 1e-12))
+		})
+
+		It("should reverse a vector along the normal", func() {
+			Expect(Vector3D{0, 0, -2}.Reflect(Vector3D{0, 0, 1})).To(Equal(Vector3D{0, 0, 2}))
+		})
+	})
+
+	Describe("component-wise operations", func() {
+		It("should take the component-wise minimum and maximum", func() {
+			a, b := Vector3D{1, 5, -2}, Vector3D{3, -1, -2}
+			Expect(a.ComponentMin(b)).To(Equal(Vector3D{1, -1, -2}))
+			Expect(a.ComponentMax(b)).To(Equal(Vector3D{3, 5, -2}))
+			Expect(Vector2D{1, 5}.ComponentMin(Vector2D{3, -1})).To(Equal(Vector2D{1, -1}))
+			Expect(Vector2D{1, 5}.ComponentMax(Vector2D{3, -1})).To(Equal(Vector2D{3, 5}))
+		})
+
+		It("should take the absolute value of each component", func() {
+			Expect(Vector2D{-1.5, 2}.Abs()).To(Equal(Vector2D{1.5, 2}))
+			Expect(Vector3D{-1, 0, -3}.Abs()).To(Equal(Vector3D{1, 0, 3}))
+		})
+
+		It("should clamp each component to its range", func() {
+			lo, hi := Vector3D{0, 0, 0}, Vector3D{1, 2, 3}
+			Expect(Vector3D{-1, 1, 5}.Clamp(lo, hi)).To(Equal(Vector3D{0, 1, 3}))
+			Expect(Vector2D{0.5, 9}.Clamp(Vector2D{0, 0}, Vector2D{1, 1})).To(Equal(Vector2D{0.5, 1}))
+		})
+	})
+})
@@ -0,0 +1,175 @@
+package astronomy
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/lunar"
+	"github.com/ocrosby/astronomy/pkg/planets"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"time"
+)
+
+// modulePath is the import path whose version is recorded in provenance
+const modulePath = "github.com/ocrosby/astronomy"
+
+// ErrNotReproducible reports a computation that no longer matches the provenance pinned for it
+var ErrNotReproducible = errors.New("astronomy: result would not reproduce the pinned provenance")
+
+// ErrUnnamedDeltaT reports a ΔT source without the name that provenance records for it
+var ErrUnnamedDeltaT = errors.New("astronomy: the ΔT source has no name to record")
+
+// Provenance records how a result was produced: the function that computed it, the models it
+// used, the time scale inputs and the library version. Two results with equal fingerprints were
+// computed the same way, so a change of fingerprint after an upgrade flags outputs to
+// re-validate.
+type Provenance struct {
+	Algorithm     string   // function that computed the result, e.g. "lunar.Positions"
+	Models        []string // theories and models it used, e.g. "coordinates.Classical"
+	DeltaT        string   // name of the ΔT source, e.g. "astrotime.TTMinusUTC"
+	DeltaTVersion string   // version of the ΔT source
+	LeapSeconds   string   // version of the leap-second table, empty when DeltaT does not read it
+	Library       string   // module version, "(devel)" when built from a working tree
+}
+
+// Traced is a result with the provenance of its computation attached
+type Traced[T any] struct {
+	Value      T
+	Provenance Provenance
+}
+
+// Pin returns a copy of c in reproducibility mode: a computation by the algorithm of one of the
+// recorded provenances fails with ErrNotReproducible unless it would carry the same
+// fingerprint, so that an upgrade changing a model, the ΔT or the leap-second table cannot
+// silently change results being reproduced
+func (c Config) Pin(recorded ...Provenance) Config {
+	pinned := make(map[string]string, len(c.pinned)+len(recorded))
+	for algorithm, fingerprint := range c.pinned {
+		pinned[algorithm] = fingerprint
+	}
+	for _, p := range recorded {
+		pinned[p.Algorithm] = p.Fingerprint()
+	}
+	c.pinned = pinned
+	return c
+}
+
+// MoonPositions returns lunar.Positions at times, converted to TT with the configured ΔT and
+// nutated with the configured model, and the provenance of the computation. In reproducibility
+// mode it returns ErrNotReproducible, with the provenance the positions would have had, instead
+// of computing them.
+func (c Config) MoonPositions(times []time.Time, opts ...lunar.BatchOption) (Traced[[]coordinates.Equatorial], error) {
+	p, err := c.provenance(lunar.Positions, lunar.Accuracy().Name, c.modelName())
+	if err != nil {
+		return Traced[[]coordinates.Equatorial]{Provenance: p}, err
+	}
+	opts = append(opts[:len(opts):len(opts)], lunar.WithDeltaT(c.deltaT().Func), lunar.WithModel(c.Model))
+	return Traced[[]coordinates.Equatorial]{Value: lunar.Positions(times, opts...), Provenance: p}, nil
+}
+
+// PlanetPositions returns planets.Positions of a planet at times, converted to TT with the
+// configured ΔT and precessed and nutated with the configured model, and the provenance of the
+// computation. In reproducibility mode it returns ErrNotReproducible, with the provenance the
+// positions would have had, instead of computing them.
+func (c Config) PlanetPositions(planet planets.Planet, times []time.Time, opts ...planets.BatchOption) (Traced[[]coordinates.Equatorial], error) {
+	p, err := c.provenance(planets.Positions, planet.Accuracy().Name, c.modelName())
+	if err != nil {
+		return Traced[[]coordinates.Equatorial]{Provenance: p}, err
+	}
+	opts = append(opts[:len(opts):len(opts)], planets.WithDeltaT(c.deltaT().Func), planets.WithModel(c.Model))
+	return Traced[[]coordinates.Equatorial]{Value: planets.Positions(planet, times, opts...), Provenance: p}, nil
+}
+
+// modelName names the configured precession-nutation model as provenance records it
+func (c Config) modelName() string {
+	return "coordinates." + c.Model.String()
+}
+
+// provenance returns the provenance of a computation by algorithm with the given models under
+// this configuration, ErrUnnamedDeltaT when its ΔT source cannot be recorded and
+// ErrNotReproducible when it differs from the one pinned for algorithm
+func (c Config) provenance(algorithm any, models ...string) (Provenance, error) {
+	deltaT := c.deltaT()
+	p := Provenance{
+		Algorithm:     funcName(algorithm),
+		Models:        models,
+		DeltaT:        deltaT.Name,
+		DeltaTVersion: deltaT.Version,
+		Library:       LibraryVersion(),
+	}
+	if deltaT.LeapSeconds {
+		p.LeapSeconds = astrotime.LeapSecondTableVersion
+	}
+	if deltaT.Name == "" {
+		return p, ErrUnnamedDeltaT
+	}
+	if pinned, ok := c.pinned[p.Algorithm]; ok && pinned != p.Fingerprint() {
+		return p, fmt.Errorf("%w: %s", ErrNotReproducible, p)
+	}
+	return p, nil
+}
+
+// funcName returns the name of one of the library's functions relative to its pkg directory
+func funcName(f any) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(f).Pointer())
+	if fn == nil {
+		return "unknown"
+	}
+	return strings.TrimPrefix(fn.Name(), modulePath+"/pkg/")
+}
+
+// Fingerprint returns a short stable hash of the provenance; the order of the models does not
+// matter
+func (p Provenance) Fingerprint() string {
+	models := append([]string(nil), p.Models...)
+	sort.Strings(models)
+	fields := []string{p.Algorithm, strings.Join(models, ","), p.DeltaT, p.DeltaTVersion, p.LeapSeconds, p.Library}
+	sum := sha256.Sum256([]byte(strings.Join(fields, "\x00")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// String returns a one-line summary for logs and file headers
+func (p Provenance) String() string {
+	var b strings.Builder
+	b.WriteString(p.Algorithm)
+	if len(p.Models) > 0 {
+		b.WriteString(" [" + strings.Join(p.Models, ", ") + "]")
+	}
+	b.WriteString("; ΔT " + p.DeltaT)
+	if p.DeltaTVersion != "" {
+		b.WriteString(" " + p.DeltaTVersion)
+	}
+	if p.LeapSeconds != "" {
+		b.WriteString("; leap seconds " + p.LeapSeconds)
+	}
+	b.WriteString("; " + modulePath + " " + p.Library)
+	return b.String()
+}
+
+// LibraryVersion returns the version of this module in the running binary, "(devel)" when it
+// is the main module built from a working tree and "unknown" without build information
+func LibraryVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+	if info.Main.Path == modulePath {
+		return info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == modulePath {
+			if dep.Replace != nil {
+				return dep.Replace.Version
+			}
+			return dep.Version
+		}
+	}
+	return "unknown"
+}
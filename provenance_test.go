@@ -0,0 +1,99 @@
+package astronomy
+
+import (
+	"time"
+
+	"github.com/ocrosby/astronomy/pkg/astrotime"
+	"github.com/ocrosby/astronomy/pkg/coordinates"
+	"github.com/ocrosby/astronomy/pkg/lunar"
+	"github.com/ocrosby/astronomy/pkg/planets"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Provenance", func() {
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	times := []time.Time{start, start.Add(time.Hour)}
+
+	It("should record the functions that computed a result", func() {
+		traced, err := DefaultConfig().MoonPositions(times)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(traced.Value).To(Equal(lunar.Positions(times, lunar.WithDeltaT(astrotime.TTMinusUT))))
+		p := traced.Provenance
+		Expect(p.Algorithm).To(Equal("lunar.Positions"))
+		Expect(p.Models).To(Equal([]string{lunar.Accuracy().Name, "coordinates.Classical"}))
+		Expect(p.DeltaT).To(Equal("astrotime.TTMinusUT"))
+		Expect(p.DeltaTVersion).To(Equal(DefaultDeltaT.Version))
+		Expect(p.LeapSeconds).To(Equal(astrotime.LeapSecondTableVersion))
+		Expect(p.Library).To(Equal(LibraryVersion()))
+		Expect(p.String()).To(HavePrefix("lunar.Positions [ELP-2000/82 (Meeus), coordinates.Classical]; ΔT astrotime.TTMinusUT 1; leap seconds "))
+	})
+
+	It("should compute with the configured ΔT and model and record them", func() {
+		c := DefaultConfig()
+		c.DeltaT = DeltaTSource{Name: "constant", Version: "69.2 s", Func: func(time.Time) float64 { return 69.2 }}
+		c.Model = coordinates.IAU2006
+		traced, err := c.PlanetPositions(planets.Mars, times, planets.WithWorkers(2))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(traced.Value).To(Equal(planets.Positions(planets.Mars, times,
+			planets.WithDeltaT(c.DeltaT.Func), planets.WithModel(coordinates.IAU2006))))
+		Expect(traced.Provenance.Algorithm).To(Equal("planets.Positions"))
+		Expect(traced.Provenance.Models).To(Equal([]string{"Mars mean elements", "coordinates.IAU2006"}))
+		Expect(traced.Provenance.DeltaT).To(Equal("constant"))
+		Expect(traced.Provenance.DeltaTVersion).To(Equal("69.2 s"))
+		Expect(traced.Provenance.LeapSeconds).To(BeEmpty())
+		Expect(traced.Provenance.String()).NotTo(ContainSubstring("leap seconds"))
+	})
+
+	It("should refuse a ΔT source it cannot record", func() {
+		c := DefaultConfig()
+		c.DeltaT = DeltaTSource{Func: func(time.Time) float64 { return 69.2 }}
+		_, err := c.MoonPositions(times)
+		Expect(err).To(MatchError(ErrUnnamedDeltaT))
+	})
+
+	It("should fingerprint deterministically", func() {
+		a := Provenance{Algorithm: "x", Models: []string{"m1", "m2"}, DeltaT: "d"}
+		b := Provenance{Algorithm: "x", Models: []string{"m2", "m1"}, DeltaT: "d"}
+		Expect(a.Fingerprint()).To(Equal(b.Fingerprint()))
+		Expect(a.Fingerprint()).To(HaveLen(16))
+		b.LeapSeconds = astrotime.LeapSecondTableVersion
+		Expect(a.Fingerprint()).NotTo(Equal(b.Fingerprint()))
+
+		first, _ := DefaultConfig().MoonPositions(times)
+		second, _ := DefaultConfig().MoonPositions(times[:1])
+		Expect(first.Provenance.Fingerprint()).To(Equal(second.Provenance.Fingerprint()))
+	})
+
+	It("should refuse to compute what would not reproduce a pinned provenance", func() {
+		recorded, _ := DefaultConfig().MoonPositions(times)
+		pinned := DefaultConfig().Pin(recorded.Provenance)
+		again, err := pinned.MoonPositions(times)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(again).To(Equal(recorded))
+
+		upgraded := recorded.Provenance
+		upgraded.LeapSeconds = "2012-07-01"
+		_, err = DefaultConfig().Pin(upgraded).MoonPositions(times)
+		Expect(err).To(MatchError(ErrNotReproducible))
+
+		for _, deltaT := range []DeltaTSource{
+			{Name: "constant", Version: "69.2 s", Func: func(time.Time) float64 { return 69.2 }},
+			{Name: "astrotime.TTMinusUT", Version: "2", LeapSeconds: true, Func: astrotime.TTMinusUT},
+			LeapSecondDeltaT,
+		} {
+			changed := pinned
+			changed.DeltaT = deltaT
+			traced, err := changed.MoonPositions(times)
+			Expect(err).To(MatchError(ErrNotReproducible), deltaT.Name)
+			Expect(traced.Value).To(BeNil())
+			_, err = changed.PlanetPositions(planets.Mars, times)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		remodelled := pinned
+		remodelled.Model = coordinates.IAU2006
+		_, err = remodelled.MoonPositions(times)
+		Expect(err).To(MatchError(ErrNotReproducible))
+	})
+})